@@ -31,7 +31,13 @@ var StartTime = time.Now()
 func main() {
 	godotenv.Load()
 
-	exitCode := mainRun()
+	exitCode := exitOK
+	switch {
+	case len(os.Args) > 1 && os.Args[1] == "purge":
+		exitCode = mainPurge()
+	default:
+		exitCode = mainRun()
+	}
 	os.Exit(int(exitCode))
 }
 
@@ -75,9 +81,13 @@ func mainRun() exitCode {
 		return exitError
 	}
 
-	svc := service.New(ds.Database, au.OAuth)
+	svc, err := service.New(ds.Database, au.OAuth, cfg.Service)
+	if err != nil {
+		log.ErrMessage(err, "Service initialization failed.")
+		return exitError
+	}
 
-	ctr := controller.New(svc, au.OAuth, cfg.General.Controller, log)
+	ctr := controller.New(svc, au.OAuth, ds.Redis, cfg.General.Controller, log)
 
 	svr, err := server.New(ctr, cfg.Server, log.WithName("Server"))
 	if err != nil {
@@ -96,3 +106,36 @@ func mainRun() exitCode {
 
 	return exitOK
 }
+
+// mainPurge deletes the rows that have been in the trash for longer than the
+// retention period and exits.
+func mainPurge() exitCode {
+	log := logger.New()
+
+	cfg, err := config.New()
+	if err != nil {
+		log.ErrMessage(err, "Config initialization failed.")
+		return exitError
+	}
+
+	ctx := context.Background()
+	ds, err := datastore.New(ctx, cfg.Datastore)
+	if err != nil {
+		log.ErrMessage(err, "Datastore initialization failed.")
+		return exitError
+	}
+	defer func() {
+		if err := ds.Stop(); err != nil {
+			log.ErrMessage(err, "Datastore stop failed.")
+		}
+	}()
+
+	count, err := ds.Database.PurgeTrash(ctx)
+	if err != nil {
+		log.ErrMessage(err, "Purge trash failed.")
+		return exitError
+	}
+
+	log.Message("Trash purged.", "count", count, "retention", cfg.Datastore.Database.TrashRetention)
+	return exitOK
+}
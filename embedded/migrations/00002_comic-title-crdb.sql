@@ -0,0 +1,38 @@
+-- +goose Up
+
+-- Comic Title
+
+CREATE TABLE bagicore.comic_title (
+    id              bigint                      PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+    updated_at      timestamp with time zone,
+
+    comic_id        bigint                      NOT NULL,
+    rid             text                        NOT NULL,
+    language_id     bigint                      NOT NULL,
+    title           text                        NOT NULL,
+    is_primary      boolean                     NOT NULL DEFAULT false,
+    romanized       boolean                     NOT NULL DEFAULT false
+);
+
+ALTER TABLE ONLY bagicore.comic_title ADD CONSTRAINT comic_title_comic_id_fkey
+    FOREIGN KEY (comic_id) REFERENCES bagicore.comic(id) ON DELETE CASCADE;
+ALTER TABLE ONLY bagicore.comic_title ADD CONSTRAINT comic_title_language_id_fkey
+    FOREIGN KEY (language_id) REFERENCES bagicore.language(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY bagicore.comic_title ADD CONSTRAINT comic_title_comic_id_rid_key
+    UNIQUE (comic_id, rid);
+ALTER TABLE ONLY bagicore.comic_title ADD CONSTRAINT comic_title_comic_id_language_id_title_key
+    UNIQUE (comic_id, language_id, title);
+
+CREATE UNIQUE INDEX comic_title_comic_id_language_id_primary_key
+    ON bagicore.comic_title (comic_id, language_id) WHERE is_primary;
+
+ALTER TABLE ONLY bagicore.comic_title ADD CONSTRAINT comic_title_rid_check
+    CHECK (length(rid) = 5);
+ALTER TABLE ONLY bagicore.comic_title ADD CONSTRAINT comic_title_title_check
+    CHECK (title <> '' AND length(title) <= 255);
+
+-- +goose Down
+
+DROP TABLE bagicore.comic_title;
@@ -0,0 +1,22 @@
+-- +goose Up
+
+-- Comic
+
+ALTER TABLE ONLY bagicore.comic ADD COLUMN status text
+    CONSTRAINT comic_status_check CHECK (status IN ('ongoing', 'completed', 'hiatus', 'cancelled'));
+ALTER TABLE ONLY bagicore.comic ADD COLUMN type text
+    CONSTRAINT comic_type_check CHECK (type IN ('manga', 'manhwa', 'manhua', 'webtoon', 'original'));
+ALTER TABLE ONLY bagicore.comic ADD COLUMN start_year integer
+    CONSTRAINT comic_start_year_check CHECK (start_year >= 1800);
+ALTER TABLE ONLY bagicore.comic ADD COLUMN synopsis jsonb
+    CONSTRAINT comic_synopsis_check CHECK (jsonb_typeof(synopsis) = 'object');
+ALTER TABLE ONLY bagicore.comic ADD COLUMN cover_url text
+    CONSTRAINT comic_cover_url_check CHECK (cover_url <> '' AND length(cover_url) <= 255);
+
+-- +goose Down
+
+ALTER TABLE ONLY bagicore.comic DROP COLUMN cover_url;
+ALTER TABLE ONLY bagicore.comic DROP COLUMN synopsis;
+ALTER TABLE ONLY bagicore.comic DROP COLUMN start_year;
+ALTER TABLE ONLY bagicore.comic DROP COLUMN type;
+ALTER TABLE ONLY bagicore.comic DROP COLUMN status;
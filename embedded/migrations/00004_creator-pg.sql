@@ -0,0 +1,67 @@
+-- +goose Up
+
+-- Creator
+
+CREATE TABLE bagicore.creator (
+    id              bigint                      PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+    updated_at      timestamp with time zone,
+
+    slug            text                        NOT NULL,
+    name            text                        NOT NULL,
+    alternate_names text[]
+);
+
+ALTER TABLE ONLY bagicore.creator ADD CONSTRAINT creator_slug_key
+    UNIQUE (slug);
+
+ALTER TABLE ONLY bagicore.creator ADD CONSTRAINT creator_slug_check
+    CHECK (slug <> '' AND length(slug) <= 64);
+ALTER TABLE ONLY bagicore.creator ADD CONSTRAINT creator_name_check
+    CHECK (name <> '' AND length(name) <= 128);
+
+-- Creator Link
+
+CREATE TABLE bagicore.creator_link (
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+    updated_at      timestamp with time zone,
+
+    creator_id      bigint,
+    link_id         bigint
+);
+
+ALTER TABLE ONLY bagicore.creator_link ADD CONSTRAINT creator_link_pkey
+    PRIMARY KEY (creator_id, link_id);
+
+ALTER TABLE ONLY bagicore.creator_link ADD CONSTRAINT creator_link_creator_id_fkey
+    FOREIGN KEY (creator_id) REFERENCES bagicore.creator(id) ON DELETE CASCADE;
+ALTER TABLE ONLY bagicore.creator_link ADD CONSTRAINT creator_link_link_id_fkey
+    FOREIGN KEY (link_id) REFERENCES bagicore.link(id) ON DELETE CASCADE;
+
+-- Comic Creator
+
+CREATE TABLE bagicore.comic_creator (
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+    updated_at      timestamp with time zone,
+
+    comic_id        bigint,
+    creator_id      bigint,
+    role            text
+);
+
+ALTER TABLE ONLY bagicore.comic_creator ADD CONSTRAINT comic_creator_pkey
+    PRIMARY KEY (comic_id, creator_id, role);
+
+ALTER TABLE ONLY bagicore.comic_creator ADD CONSTRAINT comic_creator_comic_id_fkey
+    FOREIGN KEY (comic_id) REFERENCES bagicore.comic(id) ON DELETE CASCADE;
+ALTER TABLE ONLY bagicore.comic_creator ADD CONSTRAINT comic_creator_creator_id_fkey
+    FOREIGN KEY (creator_id) REFERENCES bagicore.creator(id) ON DELETE CASCADE;
+
+ALTER TABLE ONLY bagicore.comic_creator ADD CONSTRAINT comic_creator_role_check
+    CHECK (role IN ('story', 'art', 'original-work', 'translator'));
+
+-- +goose Down
+
+DROP TABLE bagicore.comic_creator;
+DROP TABLE bagicore.creator_link;
+DROP TABLE bagicore.creator;
@@ -0,0 +1,49 @@
+-- +goose Up
+
+-- Tag
+
+CREATE TABLE bagicore.tag (
+    id              bigint                      PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+    updated_at      timestamp with time zone,
+
+    namespace       text                        NOT NULL,
+    slug            text                        NOT NULL,
+    names           jsonb
+);
+
+ALTER TABLE ONLY bagicore.tag ADD CONSTRAINT tag_namespace_slug_key
+    UNIQUE (namespace, slug);
+
+ALTER TABLE ONLY bagicore.tag ADD CONSTRAINT tag_namespace_check
+    CHECK (namespace IN ('genre', 'theme', 'demographic', 'content-warning'));
+ALTER TABLE ONLY bagicore.tag ADD CONSTRAINT tag_slug_check
+    CHECK (slug <> '' AND length(slug) <= 64);
+ALTER TABLE ONLY bagicore.tag ADD CONSTRAINT tag_names_check
+    CHECK (jsonb_typeof(names) = 'object');
+
+-- Comic Tag
+
+CREATE TABLE bagicore.comic_tag (
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+    updated_at      timestamp with time zone,
+
+    comic_id        bigint,
+    tag_id          bigint
+);
+
+ALTER TABLE ONLY bagicore.comic_tag ADD CONSTRAINT comic_tag_pkey
+    PRIMARY KEY (comic_id, tag_id);
+
+ALTER TABLE ONLY bagicore.comic_tag ADD CONSTRAINT comic_tag_comic_id_fkey
+    FOREIGN KEY (comic_id) REFERENCES bagicore.comic(id) ON DELETE CASCADE;
+ALTER TABLE ONLY bagicore.comic_tag ADD CONSTRAINT comic_tag_tag_id_fkey
+    FOREIGN KEY (tag_id) REFERENCES bagicore.tag(id) ON DELETE CASCADE;
+
+CREATE INDEX comic_tag_tag_id_idx
+    ON bagicore.comic_tag (tag_id);
+
+-- +goose Down
+
+DROP TABLE bagicore.comic_tag;
+DROP TABLE bagicore.tag;
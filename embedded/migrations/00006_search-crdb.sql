@@ -0,0 +1,34 @@
+-- +goose Up
+
+-- On CockroachDB search skips tsvector ranking and relies on trigram
+-- similarity only, served by the inverted indexes below.
+
+-- Comic
+
+CREATE INVERTED INDEX comic_code_trgm_idx
+    ON bagicore.comic (code gin_trgm_ops);
+
+-- Comic Title
+
+CREATE INVERTED INDEX comic_title_title_trgm_idx
+    ON bagicore.comic_title (title gin_trgm_ops);
+
+-- Website
+
+CREATE INVERTED INDEX website_domain_trgm_idx
+    ON bagicore.website (domain gin_trgm_ops);
+CREATE INVERTED INDEX website_name_trgm_idx
+    ON bagicore.website (name gin_trgm_ops);
+
+-- Link
+
+CREATE INVERTED INDEX link_relative_url_trgm_idx
+    ON bagicore.link (relative_url gin_trgm_ops);
+
+-- +goose Down
+
+DROP INDEX bagicore.link@link_relative_url_trgm_idx;
+DROP INDEX bagicore.website@website_name_trgm_idx;
+DROP INDEX bagicore.website@website_domain_trgm_idx;
+DROP INDEX bagicore.comic_title@comic_title_title_trgm_idx;
+DROP INDEX bagicore.comic@comic_code_trgm_idx;
@@ -0,0 +1,39 @@
+-- +goose Up
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Comic
+
+CREATE INDEX comic_code_trgm_idx
+    ON bagicore.comic USING gin (code gin_trgm_ops);
+
+-- Comic Title
+
+CREATE INDEX comic_title_title_trgm_idx
+    ON bagicore.comic_title USING gin (title gin_trgm_ops);
+CREATE INDEX comic_title_title_tsv_idx
+    ON bagicore.comic_title USING gin (to_tsvector('simple', title));
+
+-- Website
+
+CREATE INDEX website_domain_trgm_idx
+    ON bagicore.website USING gin (domain gin_trgm_ops);
+CREATE INDEX website_name_trgm_idx
+    ON bagicore.website USING gin (name gin_trgm_ops);
+CREATE INDEX website_name_tsv_idx
+    ON bagicore.website USING gin (to_tsvector('simple', name));
+
+-- Link
+
+CREATE INDEX link_relative_url_trgm_idx
+    ON bagicore.link USING gin (relative_url gin_trgm_ops);
+
+-- +goose Down
+
+DROP INDEX bagicore.link_relative_url_trgm_idx;
+DROP INDEX bagicore.website_name_tsv_idx;
+DROP INDEX bagicore.website_name_trgm_idx;
+DROP INDEX bagicore.website_domain_trgm_idx;
+DROP INDEX bagicore.comic_title_title_tsv_idx;
+DROP INDEX bagicore.comic_title_title_trgm_idx;
+DROP INDEX bagicore.comic_code_trgm_idx;
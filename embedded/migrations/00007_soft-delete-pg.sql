@@ -0,0 +1,49 @@
+-- +goose Up
+
+-- Language
+
+ALTER TABLE ONLY bagicore.language ADD COLUMN deleted_at timestamp with time zone;
+
+CREATE INDEX language_deleted_at_idx
+    ON bagicore.language (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Website
+
+ALTER TABLE ONLY bagicore.website ADD COLUMN deleted_at timestamp with time zone;
+
+CREATE INDEX website_deleted_at_idx
+    ON bagicore.website (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Link
+
+ALTER TABLE ONLY bagicore.link ADD COLUMN deleted_at timestamp with time zone;
+
+CREATE INDEX link_deleted_at_idx
+    ON bagicore.link (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Comic
+
+ALTER TABLE ONLY bagicore.comic ADD COLUMN deleted_at timestamp with time zone;
+
+CREATE INDEX comic_deleted_at_idx
+    ON bagicore.comic (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- Comic Chapter
+
+ALTER TABLE ONLY bagicore.comic_chapter ADD COLUMN deleted_at timestamp with time zone;
+
+CREATE INDEX comic_chapter_deleted_at_idx
+    ON bagicore.comic_chapter (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose Down
+
+DROP INDEX bagicore.comic_chapter_deleted_at_idx;
+DROP INDEX bagicore.comic_deleted_at_idx;
+DROP INDEX bagicore.link_deleted_at_idx;
+DROP INDEX bagicore.website_deleted_at_idx;
+DROP INDEX bagicore.language_deleted_at_idx;
+ALTER TABLE ONLY bagicore.comic_chapter DROP COLUMN deleted_at;
+ALTER TABLE ONLY bagicore.comic DROP COLUMN deleted_at;
+ALTER TABLE ONLY bagicore.link DROP COLUMN deleted_at;
+ALTER TABLE ONLY bagicore.website DROP COLUMN deleted_at;
+ALTER TABLE ONLY bagicore.language DROP COLUMN deleted_at;
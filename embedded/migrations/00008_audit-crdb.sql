@@ -0,0 +1,28 @@
+-- +goose Up
+
+-- Audit Event
+
+CREATE TABLE bagicore.audit_event (
+    id              bigint                      PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+
+    actor           text,
+    operation       text                        NOT NULL,
+    entity          text                        NOT NULL,
+    entity_sid      text                        NOT NULL,
+    comic_id        bigint,
+    before_data     jsonb,
+    after_data      jsonb
+);
+
+ALTER TABLE ONLY bagicore.audit_event ADD CONSTRAINT audit_event_operation_check
+    CHECK (operation IN ('add', 'update', 'delete', 'restore'));
+
+CREATE INDEX audit_event_entity_entity_sid_idx
+    ON bagicore.audit_event (entity, entity_sid);
+CREATE INDEX audit_event_comic_id_idx
+    ON bagicore.audit_event (comic_id);
+
+-- +goose Down
+
+DROP TABLE bagicore.audit_event;
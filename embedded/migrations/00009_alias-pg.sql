@@ -0,0 +1,36 @@
+-- +goose Up
+
+-- Website Domain Alias
+
+CREATE TABLE bagicore.website_domain_alias (
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+
+    domain          text                        PRIMARY KEY,
+    website_id      bigint                      NOT NULL
+);
+
+ALTER TABLE ONLY bagicore.website_domain_alias ADD CONSTRAINT website_domain_alias_website_id_fkey
+    FOREIGN KEY (website_id) REFERENCES bagicore.website(id) ON DELETE CASCADE;
+
+CREATE INDEX website_domain_alias_website_id_idx
+    ON bagicore.website_domain_alias (website_id);
+
+-- Comic Code Alias
+
+CREATE TABLE bagicore.comic_code_alias (
+    created_at      timestamp with time zone    NOT NULL DEFAULT timezone('UTC', now()),
+
+    code            text                        PRIMARY KEY,
+    comic_id        bigint                      NOT NULL
+);
+
+ALTER TABLE ONLY bagicore.comic_code_alias ADD CONSTRAINT comic_code_alias_comic_id_fkey
+    FOREIGN KEY (comic_id) REFERENCES bagicore.comic(id) ON DELETE CASCADE;
+
+CREATE INDEX comic_code_alias_comic_id_idx
+    ON bagicore.comic_code_alias (comic_id);
+
+-- +goose Down
+
+DROP TABLE bagicore.comic_code_alias;
+DROP TABLE bagicore.website_domain_alias;
@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- Comic Chapter
+
+ALTER TABLE ONLY bagicore.comic_chapter ADD COLUMN number double precision;
+
+-- The numbers are backfilled by the Go migration 00012 through the same parser
+-- as the application.
+
+CREATE INDEX comic_chapter_comic_id_number_idx
+    ON bagicore.comic_chapter (comic_id, number);
+
+-- +goose Down
+
+DROP INDEX bagicore.comic_chapter_comic_id_number_idx;
+ALTER TABLE ONLY bagicore.comic_chapter DROP COLUMN number;
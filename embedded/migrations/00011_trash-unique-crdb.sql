@@ -0,0 +1,52 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+
+-- The unique keys below leave the rows in the trash out, so a deleted entity
+-- can be added again while the old one waits in the trash. CockroachDB keeps
+-- unique constraints as indexes, they are dropped as such.
+
+-- Language
+
+DROP INDEX bagicore.language@language_ietf_key CASCADE;
+
+CREATE UNIQUE INDEX language_ietf_key
+    ON bagicore.language (ietf) WHERE deleted_at IS NULL;
+
+-- Website
+
+DROP INDEX bagicore.website@website_domain_key CASCADE;
+
+CREATE UNIQUE INDEX website_domain_key
+    ON bagicore.website (domain) WHERE deleted_at IS NULL;
+
+-- Link
+
+DROP INDEX bagicore.link@link_website_id_relative_url_key CASCADE;
+
+CREATE UNIQUE INDEX link_website_id_relative_url_key
+    ON bagicore.link (website_id, relative_url) WHERE deleted_at IS NULL;
+
+-- Comic
+
+DROP INDEX bagicore.comic@comic_code_key CASCADE;
+
+CREATE UNIQUE INDEX comic_code_key
+    ON bagicore.comic (code) WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX bagicore.comic@comic_code_key;
+ALTER TABLE ONLY bagicore.comic ADD CONSTRAINT comic_code_key
+    UNIQUE (code);
+
+DROP INDEX bagicore.link@link_website_id_relative_url_key;
+ALTER TABLE ONLY bagicore.link ADD CONSTRAINT link_website_id_relative_url_key
+    UNIQUE (website_id, relative_url);
+
+DROP INDEX bagicore.website@website_domain_key;
+ALTER TABLE ONLY bagicore.website ADD CONSTRAINT website_domain_key
+    UNIQUE (domain);
+
+DROP INDEX bagicore.language@language_ietf_key;
+ALTER TABLE ONLY bagicore.language ADD CONSTRAINT language_ietf_key
+    UNIQUE (ietf);
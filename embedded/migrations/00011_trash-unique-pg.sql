@@ -0,0 +1,50 @@
+-- +goose Up
+
+-- The unique keys below leave the rows in the trash out, so a deleted entity
+-- can be added again while the old one waits in the trash.
+
+-- Language
+
+ALTER TABLE ONLY bagicore.language DROP CONSTRAINT language_ietf_key;
+
+CREATE UNIQUE INDEX language_ietf_key
+    ON bagicore.language (ietf) WHERE deleted_at IS NULL;
+
+-- Website
+
+ALTER TABLE ONLY bagicore.website DROP CONSTRAINT website_domain_key;
+
+CREATE UNIQUE INDEX website_domain_key
+    ON bagicore.website (domain) WHERE deleted_at IS NULL;
+
+-- Link
+
+ALTER TABLE ONLY bagicore.link DROP CONSTRAINT link_website_id_relative_url_key;
+
+CREATE UNIQUE INDEX link_website_id_relative_url_key
+    ON bagicore.link (website_id, relative_url) WHERE deleted_at IS NULL;
+
+-- Comic
+
+ALTER TABLE ONLY bagicore.comic DROP CONSTRAINT comic_code_key;
+
+CREATE UNIQUE INDEX comic_code_key
+    ON bagicore.comic (code) WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX bagicore.comic_code_key;
+ALTER TABLE ONLY bagicore.comic ADD CONSTRAINT comic_code_key
+    UNIQUE (code);
+
+DROP INDEX bagicore.link_website_id_relative_url_key;
+ALTER TABLE ONLY bagicore.link ADD CONSTRAINT link_website_id_relative_url_key
+    UNIQUE (website_id, relative_url);
+
+DROP INDEX bagicore.website_domain_key;
+ALTER TABLE ONLY bagicore.website ADD CONSTRAINT website_domain_key
+    UNIQUE (domain);
+
+DROP INDEX bagicore.language_ietf_key;
+ALTER TABLE ONLY bagicore.language ADD CONSTRAINT language_ietf_key
+    UNIQUE (ietf);
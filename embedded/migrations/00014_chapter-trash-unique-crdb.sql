@@ -0,0 +1,25 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+
+-- The unique key of the comic chapter leaves the rows in the trash out as
+-- those of 00011 do, so a deleted chapter can be added again.
+
+-- Comic Chapter
+
+DROP INDEX bagicore.comic_chapter@comic_chapter_comic_id_chapter_version_key CASCADE;
+
+-- The key is kept on plain columns so upserts can infer it, CockroachDB has
+-- no NULLS NOT DISTINCT and keys the chapters without a version apart.
+
+CREATE UNIQUE INDEX comic_chapter_comic_id_chapter_version_key
+    ON bagicore.comic_chapter (comic_id, chapter, version) WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX comic_chapter_comic_id_chapter_key
+    ON bagicore.comic_chapter (comic_id, chapter) WHERE deleted_at IS NULL AND version IS NULL;
+
+-- +goose Down
+
+DROP INDEX bagicore.comic_chapter@comic_chapter_comic_id_chapter_key;
+DROP INDEX bagicore.comic_chapter@comic_chapter_comic_id_chapter_version_key;
+CREATE UNIQUE INDEX comic_chapter_comic_id_chapter_version_key ON bagicore.comic_chapter
+    (comic_id, COALESCE(chapter, ''), COALESCE(version, ''));
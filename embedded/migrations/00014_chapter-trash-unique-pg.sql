@@ -0,0 +1,17 @@
+-- +goose Up
+
+-- The unique key of the comic chapter leaves the rows in the trash out as
+-- those of 00011 do, so a deleted chapter can be added again.
+
+-- Comic Chapter
+
+ALTER TABLE ONLY bagicore.comic_chapter DROP CONSTRAINT comic_chapter_comic_id_chapter_version_key;
+
+CREATE UNIQUE INDEX comic_chapter_comic_id_chapter_version_key
+    ON bagicore.comic_chapter (comic_id, chapter, version) NULLS NOT DISTINCT WHERE deleted_at IS NULL;
+
+-- +goose Down
+
+DROP INDEX bagicore.comic_chapter_comic_id_chapter_version_key;
+ALTER TABLE ONLY bagicore.comic_chapter ADD CONSTRAINT comic_chapter_comic_id_chapter_version_key
+    UNIQUE NULLS NOT DISTINCT (comic_id, chapter, version);
@@ -73,3 +73,13 @@ func (oa OAuth) HasPermissionContext(ctx context.Context, permission string) boo
 	}
 	return token.HasPermission(permission)
 }
+
+// TokenSubjectContext gives the subject of the access token in the context, it
+// is empty when the request has no token.
+func (oa OAuth) TokenSubjectContext(ctx context.Context) string {
+	token, err := oa.getAccessTokenContext(ctx)
+	if err != nil {
+		return ""
+	}
+	return token.Subject
+}
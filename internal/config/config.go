@@ -18,11 +18,13 @@ import (
 	"github.com/mahmudindes/orenocomic-bagicore/internal/controller"
 	"github.com/mahmudindes/orenocomic-bagicore/internal/datastore"
 	"github.com/mahmudindes/orenocomic-bagicore/internal/server"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/service"
 )
 
 type Config struct {
 	Auth      auth.Config      `conf:"auth"`
 	Datastore datastore.Config `conf:"datastore"`
+	Service   service.Config   `conf:"service"`
 	Server    server.Config    `conf:"server"`
 
 	General struct {
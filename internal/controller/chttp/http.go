@@ -3,6 +3,7 @@ package chttp
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	bagicore "github.com/mahmudindes/orenocomic-bagicore"
 	"github.com/mahmudindes/orenocomic-bagicore/internal/controller/chttp/hhypermedia"
@@ -20,20 +21,28 @@ type (
 	}
 
 	Config struct {
-		CORSOrigins []string `conf:"cors_origins"`
+		CORSOrigins         []string      `conf:"cors_origins"`
+		IdempotencyExpiry   time.Duration `conf:"idempotency_expiry"`
+		AliasFollowMutation bool          `conf:"alias_follow_mutation"`
 	}
 
 	Service interface {
 		rapi.Service
+		middleware.AliasService
 	}
 
 	OAuth interface {
 		middleware.AuthOAuth
+		middleware.IdempotencyOAuth
 		rapi.OAuth
 	}
+
+	Redis interface {
+		middleware.IdempotencyRedis
+	}
 )
 
-func New(svc Service, oa OAuth, cfg Config, log logger.Logger) (*HTTP, error) {
+func New(svc Service, oa OAuth, rdb Redis, cfg Config, log logger.Logger) (*HTTP, error) {
 	mux0 := router.NewMux()
 
 	hpmd, err := hhypermedia.New("./web/template", log.WithName("Hypermedia"))
@@ -103,14 +112,19 @@ func New(svc Service, oa OAuth, cfg Config, log logger.Logger) (*HTTP, error) {
 		mux1.Pre(middleware.CORS(func(opt *middleware.CORSOption) {
 			opt.AllowedOrigin = cfg.CORSOrigins
 			opt.AllowedMethod = append(opt.AllowedMethod, http.MethodPatch, http.MethodPost)
-			opt.AllowedMethod = append(opt.AllowedMethod, http.MethodDelete)
-			opt.ExposedHeader = append(opt.ExposedHeader, "X-Total-Count", "X-Pagination-Limit")
+			opt.AllowedMethod = append(opt.AllowedMethod, http.MethodPut, http.MethodDelete)
+			opt.AllowedHeader = append(opt.AllowedHeader, "Idempotency-Key", "If-Match", "If-None-Match")
+			opt.ExposedHeader = append(opt.ExposedHeader, "X-Total-Count", "X-Pagination-Limit", "ETag", "Link", "Location")
 			opt.AllowCredentials = true
 			opt.SkipOrigin = false
 		}), middleware.CORSProcess, middleware.Auth(oa))
 
 		iapi := rapi.NewAPI(svc, oa, log)
-		mapi := mux1.Underlying(rapi.Middleware(sapi, iapi.Authentication))
+		mapi := mux1.Underlying(
+			rapi.Middleware(sapi, iapi.Authentication),
+			middleware.Idempotency(oa, rdb, cfg.IdempotencyExpiry, log),
+			middleware.Alias(svc, cfg.AliasFollowMutation, log),
+		)
 		rapi.HandlerFromMuxWithBaseURL(iapi, mapi, "/v0")
 	})
 
@@ -0,0 +1,175 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/controller/chttp/router"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/controller/chttp/utilb"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/logger"
+)
+
+type AliasService interface {
+	ResolveComicCodeAlias(ctx context.Context, code string) (string, error)
+	ResolveWebsiteDomainAlias(ctx context.Context, domain string) (string, error)
+}
+
+// Alias sends requests naming a comic or a website by a code or domain it had
+// before a rename to the current one, GET and HEAD are redirected permanently
+// while other methods are refused with 409 unless follow is set, in which case
+// they are carried out on the current one. Reads only look an alias up once
+// they turn out not found, mutations look it up first. It has to run after
+// routing.
+func Alias(svc AliasService, follow bool, log logger.Logger) func(http.Handler) http.Handler {
+	aliases := []struct {
+		param   string
+		segment string
+		resolve func(ctx context.Context, v string) (string, error)
+	}{
+		{"code", "/comics/", svc.ResolveComicCodeAlias},
+		{"domain", "/websites/", svc.ResolveWebsiteDomainAlias},
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			rctx := router.RouteContext(ctx)
+			if rctx == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			named := false
+			for _, alias := range aliases {
+				if rctx.URLParam(alias.param) != "" {
+					named = true
+				}
+			}
+			if !named {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// A mutation through an old name would not find what it names and
+			// could pass as a no-op, so the alias is resolved up front.
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				for _, alias := range aliases {
+					old := rctx.URLParam(alias.param)
+					if old == "" {
+						continue
+					}
+
+					current, err := alias.resolve(ctx, old)
+					if err != nil {
+						utilb.ResponseJSONErr500(w)
+						log.WithContext(ctx).ErrMessage(err, "Resolve alias failed.")
+						return
+					}
+					if current == "" {
+						continue
+					}
+
+					if follow {
+						for i, key := range rctx.URLParams.Keys {
+							if key == alias.param {
+								rctx.URLParams.Values[i] = current
+							}
+						}
+						continue
+					}
+
+					w.Header().Set("Location", aliasLocation(r, alias.segment, old, current))
+					utilb.ResponseJSONErr(w, "Renamed, use the current one.", http.StatusConflict)
+					return
+				}
+
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			aw := &aliasResponseWriter{w: w, header: w.Header().Clone()}
+			next.ServeHTTP(aw, r)
+			if aw.status != http.StatusNotFound {
+				return
+			}
+
+			for _, alias := range aliases {
+				old := rctx.URLParam(alias.param)
+				if old == "" {
+					continue
+				}
+
+				current, err := alias.resolve(ctx, old)
+				if err != nil {
+					utilb.ResponseJSONErr500(w)
+					log.WithContext(ctx).ErrMessage(err, "Resolve alias failed.")
+					return
+				}
+				if current == "" {
+					continue
+				}
+
+				w.Header().Set("Location", aliasLocation(r, alias.segment, old, current))
+				w.WriteHeader(http.StatusMovedPermanently)
+				return
+			}
+
+			aw.flush()
+		})
+	}
+}
+
+func aliasLocation(r *http.Request, segment, old, current string) string {
+	location := *r.URL
+	location.Path = strings.Replace(location.Path, segment+old, segment+current, 1)
+	location.RawPath = ""
+	return location.RequestURI()
+}
+
+// aliasResponseWriter holds a not found response back until it is known no
+// alias applies, any other goes through as is.
+type aliasResponseWriter struct {
+	w      http.ResponseWriter
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (aw *aliasResponseWriter) Header() http.Header {
+	return aw.header
+}
+
+func (aw *aliasResponseWriter) WriteHeader(status int) {
+	if aw.status != 0 {
+		return
+	}
+	aw.status = status
+	if status == http.StatusNotFound {
+		return
+	}
+	wHeader := aw.w.Header()
+	for name, values := range aw.header {
+		wHeader[name] = values
+	}
+	aw.w.WriteHeader(status)
+}
+
+func (aw *aliasResponseWriter) Write(b []byte) (int, error) {
+	if aw.status == 0 {
+		aw.WriteHeader(http.StatusOK)
+	}
+	if aw.status == http.StatusNotFound {
+		return aw.body.Write(b)
+	}
+	return aw.w.Write(b)
+}
+
+func (aw *aliasResponseWriter) flush() {
+	wHeader := aw.w.Header()
+	for name, values := range aw.header {
+		wHeader[name] = values
+	}
+	aw.w.WriteHeader(aw.status)
+	aw.w.Write(aw.body.Bytes())
+}
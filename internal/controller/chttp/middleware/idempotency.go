@@ -0,0 +1,273 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	bagicore "github.com/mahmudindes/orenocomic-bagicore"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/controller/chttp/router"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/controller/chttp/utilb"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/logger"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+const (
+	IdempotencyKeyMax = 255
+	// IdempotencyLockExpiry is how long a request in progress holds its key,
+	// so one that never finishes does not hold it for the whole expiry.
+	IdempotencyLockExpiry = 1 * time.Minute
+)
+
+// idempotencyHeaders are the response headers kept for a replay, the others
+// such as those of CORS or the request id belong to the request replayed to.
+var idempotencyHeaders = []string{"Content-Type", "ETag", "Location"}
+
+type (
+	IdempotencyOAuth interface {
+		TokenSubjectContext(ctx context.Context) string
+	}
+
+	IdempotencyRedis interface {
+		GobGet(ctx context.Context, key string, v any) error
+		GobSet(ctx context.Context, key string, v any, exp time.Duration) error
+		GobSetNX(ctx context.Context, key string, v any, exp time.Duration) (bool, error)
+		Delete(ctx context.Context, key string) error
+	}
+
+	idempotencyStore interface {
+		// Reserve stores rec under key unless another record is there, which
+		// is returned instead. Save replaces it along with its expiry.
+		Reserve(ctx context.Context, key string, rec idempotencyRecord, exp time.Duration) (*idempotencyRecord, error)
+		Save(ctx context.Context, key string, rec idempotencyRecord, exp time.Duration) error
+		Release(ctx context.Context, key string) error
+	}
+
+	// idempotencyRecord is a request identified by its fingerprint, it holds
+	// the response once done.
+	idempotencyRecord struct {
+		Fingerprint string
+		Done        bool
+		Status      int
+		Header      http.Header
+		Body        []byte
+	}
+)
+
+// Idempotency replays the response of a POST or PATCH whose Idempotency-Key
+// was seen within exp, the key of another request is refused with 422 and
+// that of a request still running with 409. Keys are scoped by the token
+// subject, so it has to run after authentication. Responses are kept in Redis
+// if rdb is set and in memory otherwise, only successful ones so a failed
+// request can be retried with the same key.
+func Idempotency(oa IdempotencyOAuth, rdb IdempotencyRedis, exp time.Duration, log logger.Logger) func(http.Handler) http.Handler {
+	store := newIdempotencyStore(rdb)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get("Idempotency-Key")
+			if key == "" || (r.Method != http.MethodPost && r.Method != http.MethodPatch) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > IdempotencyKeyMax {
+				max := strconv.Itoa(IdempotencyKeyMax)
+				utilb.ResponseJSONErr(w, "Idempotency key must be at most "+max+" characters.", http.StatusBadRequest)
+				return
+			}
+
+			ctx := r.Context()
+			log := log.WithContext(ctx)
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				utilb.ResponseJSONErr(w, "Bad request body.", http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			subject := oa.TokenSubjectContext(ctx)
+			scope := sha256.Sum256([]byte(subject))
+			key = hex.EncodeToString(scope[:]) + ":" + key
+
+			hash := sha256.New()
+			hash.Write([]byte(subject + "\n" + r.Method + " " + r.URL.RequestURI() + "\n"))
+			hash.Write(body)
+			fingerprint := hex.EncodeToString(hash.Sum(nil))
+
+			rec, err := store.Reserve(ctx, key, idempotencyRecord{Fingerprint: fingerprint}, IdempotencyLockExpiry)
+			if err != nil {
+				utilb.ResponseJSONErr500(w)
+				log.ErrMessage(err, "Reserve idempotency key failed.")
+				return
+			}
+			if rec != nil {
+				switch {
+				case rec.Fingerprint != fingerprint:
+					msg := "Idempotency key is already used by another request."
+					utilb.ResponseJSONErr(w, msg, http.StatusUnprocessableEntity)
+				case !rec.Done:
+					msg := "Request with the same idempotency key is in progress."
+					utilb.ResponseJSONErr(w, msg, http.StatusConflict)
+				default:
+					wHeader := w.Header()
+					for _, name := range idempotencyHeaders {
+						if values := rec.Header.Values(name); len(values) > 0 {
+							wHeader[http.CanonicalHeaderKey(name)] = values
+						}
+					}
+					wHeader.Set("Idempotent-Replayed", "true")
+					w.WriteHeader(rec.Status)
+					w.Write(rec.Body)
+				}
+				return
+			}
+
+			var buf bytes.Buffer
+			ww := router.WrapResponseWritter(w, r.ProtoMajor)
+			ww.Tee(&buf)
+			next.ServeHTTP(ww, r)
+
+			ctx = context.WithoutCancel(ctx)
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if status < http.StatusOK || status >= http.StatusMultipleChoices {
+				if err := store.Release(ctx, key); err != nil {
+					log.ErrMessage(err, "Release idempotency key failed.")
+				}
+				return
+			}
+			header := http.Header{}
+			for _, name := range idempotencyHeaders {
+				if values := ww.Header().Values(name); len(values) > 0 {
+					header[http.CanonicalHeaderKey(name)] = slices.Clone(values)
+				}
+			}
+			if err := store.Save(ctx, key, idempotencyRecord{
+				Fingerprint: fingerprint,
+				Done:        true,
+				Status:      status,
+				Header:      header,
+				Body:        buf.Bytes(),
+			}, exp); err != nil {
+				log.ErrMessage(err, "Save idempotency key failed.")
+			}
+		})
+	}
+}
+
+func newIdempotencyStore(rdb IdempotencyRedis) idempotencyStore {
+	if rdb != nil && !reflect.ValueOf(rdb).IsNil() {
+		return idempotencyStoreRedis{rdb}
+	}
+	memory := &idempotencyStoreMemory{records: make(map[string]idempotencyMemoryRecord)}
+	go memory.BackgroundPurger()
+	return memory
+}
+
+type (
+	idempotencyStoreMemory struct {
+		records map[string]idempotencyMemoryRecord
+		mu      sync.Mutex
+	}
+
+	idempotencyMemoryRecord struct {
+		idempotencyRecord
+		Expiration time.Time
+	}
+)
+
+const idempotencyStorePurge = 1 * time.Hour
+
+func (ism *idempotencyStoreMemory) Reserve(ctx context.Context, key string, rec idempotencyRecord, exp time.Duration) (*idempotencyRecord, error) {
+	ism.mu.Lock()
+	defer ism.mu.Unlock()
+
+	if val, ok := ism.records[key]; ok && time.Now().Before(val.Expiration) {
+		return &val.idempotencyRecord, nil
+	}
+	ism.records[key] = idempotencyMemoryRecord{rec, time.Now().Add(exp)}
+	return nil, nil
+}
+
+func (ism *idempotencyStoreMemory) Save(ctx context.Context, key string, rec idempotencyRecord, exp time.Duration) error {
+	ism.mu.Lock()
+	defer ism.mu.Unlock()
+
+	ism.records[key] = idempotencyMemoryRecord{rec, time.Now().Add(exp)}
+	return nil
+}
+
+func (ism *idempotencyStoreMemory) Release(ctx context.Context, key string) error {
+	ism.mu.Lock()
+	defer ism.mu.Unlock()
+
+	delete(ism.records, key)
+	return nil
+}
+
+func (ism *idempotencyStoreMemory) BackgroundPurger() {
+	for {
+		ism.mu.Lock()
+		for key, val := range ism.records {
+			if time.Now().After(val.Expiration) {
+				delete(ism.records, key)
+			}
+		}
+		ism.mu.Unlock()
+		time.Sleep(idempotencyStorePurge)
+	}
+}
+
+type idempotencyStoreRedis struct {
+	rdb IdempotencyRedis
+}
+
+var idempotencyStoreRedisPrefix = bagicore.ID + ":idempotency:"
+
+func (isr idempotencyStoreRedis) Reserve(ctx context.Context, key string, rec idempotencyRecord, exp time.Duration) (*idempotencyRecord, error) {
+	// The record found may expire before it is read, then reserve again.
+	for i := 0; i < 2; i++ {
+		ok, err := isr.rdb.GobSetNX(ctx, idempotencyStoreRedisPrefix+key, rec, exp)
+		if err != nil {
+			return nil, model.CacheError(err)
+		}
+		if ok {
+			return nil, nil
+		}
+
+		var result idempotencyRecord
+		if err := isr.rdb.GobGet(ctx, idempotencyStoreRedisPrefix+key, &result); err != nil {
+			if errors.As(err, &model.ErrNotFound) {
+				continue
+			}
+			return nil, model.CacheError(err)
+		}
+		return &result, nil
+	}
+	return nil, model.CacheError(errors.New("idempotency key keeps expiring"))
+}
+
+func (isr idempotencyStoreRedis) Save(ctx context.Context, key string, rec idempotencyRecord, exp time.Duration) error {
+	if err := isr.rdb.GobSet(ctx, idempotencyStoreRedisPrefix+key, rec, exp); err != nil {
+		return model.CacheError(err)
+	}
+	return nil
+}
+
+func (isr idempotencyStoreRedis) Release(ctx context.Context, key string) error {
+	if err := isr.rdb.Delete(ctx, idempotencyStoreRedisPrefix+key); err != nil {
+		return model.CacheError(err)
+	}
+	return nil
+}
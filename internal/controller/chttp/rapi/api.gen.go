@@ -26,22 +26,89 @@ const (
 	BearerAuthScopes contextKey = "BearerAuth.Scopes"
 )
 
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	// Actor Subject of the token that made the mutation.
+	Actor *string `json:"actor"`
+
+	// After The object after the mutation, null for delete.
+	After *map[string]interface{} `json:"after"`
+
+	// Before The object before the mutation, null for add.
+	Before    *map[string]interface{} `json:"before"`
+	ComicID   *uint                   `json:"comicID"`
+	CreatedAt time.Time               `json:"createdAt"`
+	Entity    string                  `json:"entity"`
+	EntitySID string                  `json:"entitySID"`
+	ID        uint                    `json:"id"`
+	Operation string                  `json:"operation"`
+}
+
+// Batch defines model for Batch.
+type Batch struct {
+	Mode       *string          `json:"mode,omitempty"`
+	Operations []BatchOperation `json:"operations"`
+}
+
+// BatchOperation defines model for BatchOperation.
+type BatchOperation struct {
+	Action string `json:"action"`
+
+	// Chapter Chapter of comic chapter to delete.
+	Chapter      *string          `json:"chapter,omitempty"`
+	ComicChapter *NewComicChapter `json:"comicChapter,omitempty"`
+
+	// ComicCode Code of comic of chapter to add or delete.
+	ComicCode *string  `json:"comicCode,omitempty"`
+	Link      *NewLink `json:"link,omitempty"`
+
+	// LinkRelativeURL Relative URL of link to delete.
+	LinkRelativeURL *string `json:"linkRelativeURL,omitempty"`
+
+	// LinkWebsiteDomain Website domain of link to delete.
+	LinkWebsiteDomain *string `json:"linkWebsiteDomain,omitempty"`
+
+	// Version Version of comic chapter to delete.
+	Version *string `json:"version"`
+}
+
+// BatchResult defines model for BatchResult.
+type BatchResult struct {
+	Message *string `json:"message"`
+
+	// Status Done operations succeeded, failed ones have a message and aborted ones were rolled back or left unrun.
+	Status string `json:"status"`
+}
+
 // Comic defines model for Comic.
 type Comic struct {
 	Chapters  *[]ComicChapter `json:"chapters,omitempty"`
 	Code      string          `json:"code"`
+	CoverURL  *string         `json:"coverURL"`
 	CreatedAt time.Time       `json:"createdAt"`
+	Creators  *[]ComicCreator `json:"creators,omitempty"`
 	ID        uint            `json:"id"`
 	Links     *[]Link         `json:"links,omitempty"`
-	UpdatedAt *time.Time      `json:"updatedAt"`
+	StartYear *int            `json:"startYear"`
+	Status    *string         `json:"status"`
+
+	// Synopsis Synopsis keyed by language IETF.
+	Synopsis  map[string]string `json:"synopsis"`
+	Tags      *[]ComicTag       `json:"tags,omitempty"`
+	Titles    *[]ComicTitle     `json:"titles,omitempty"`
+	Type      *string           `json:"type"`
+	UpdatedAt *time.Time        `json:"updatedAt"`
 }
 
 // ComicChapter defines model for ComicChapter.
 type ComicChapter struct {
-	Chapter    string     `json:"chapter"`
-	CreatedAt  time.Time  `json:"createdAt"`
-	ID         uint       `json:"id"`
-	Links      *[]Link    `json:"links,omitempty"`
+	Chapter   string    `json:"chapter"`
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uint      `json:"id"`
+	Links     *[]Link   `json:"links,omitempty"`
+
+	// Number Sort key parsed from chapter, null when it has no number.
+	Number     *float64   `json:"number"`
 	ReleasedAt time.Time  `json:"releasedAt"`
 	UpdatedAt  *time.Time `json:"updatedAt"`
 	Version    *string    `json:"version"`
@@ -56,6 +123,18 @@ type ComicChapterLink struct {
 	UpdatedAt         *time.Time `json:"updatedAt"`
 }
 
+// ComicCreator defines model for ComicCreator.
+type ComicCreator struct {
+	ComicCode   string     `json:"comicCode"`
+	ComicID     uint       `json:"comicID"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	CreatorID   uint       `json:"creatorID"`
+	CreatorName string     `json:"creatorName"`
+	CreatorSlug string     `json:"creatorSlug"`
+	Role        string     `json:"role"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+}
+
 // ComicLink defines model for ComicLink.
 type ComicLink struct {
 	CreatedAt         time.Time  `json:"createdAt"`
@@ -65,6 +144,62 @@ type ComicLink struct {
 	UpdatedAt         *time.Time `json:"updatedAt"`
 }
 
+// ComicTag defines model for ComicTag.
+type ComicTag struct {
+	ComicCode string    `json:"comicCode"`
+	ComicID   uint      `json:"comicID"`
+	CreatedAt time.Time `json:"createdAt"`
+	TagID     uint      `json:"tagID"`
+
+	// TagNames Display names keyed by language IETF.
+	TagNames     map[string]string `json:"tagNames"`
+	TagNamespace string            `json:"tagNamespace"`
+	TagSlug      string            `json:"tagSlug"`
+	UpdatedAt    *time.Time        `json:"updatedAt"`
+}
+
+// ComicTitle defines model for ComicTitle.
+type ComicTitle struct {
+	CreatedAt    time.Time  `json:"createdAt"`
+	ID           uint       `json:"id"`
+	IsPrimary    bool       `json:"isPrimary"`
+	LanguageID   uint       `json:"languageID"`
+	LanguageIETF string     `json:"languageIETF"`
+	RID          string     `json:"rid"`
+	Romanized    bool       `json:"romanized"`
+	Title        string     `json:"title"`
+	UpdatedAt    *time.Time `json:"updatedAt"`
+}
+
+// Creator defines model for Creator.
+type Creator struct {
+	AlternateNames []string   `json:"alternateNames"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	ID             uint       `json:"id"`
+	Links          *[]Link    `json:"links,omitempty"`
+	Name           string     `json:"name"`
+	Slug           string     `json:"slug"`
+	UpdatedAt      *time.Time `json:"updatedAt"`
+}
+
+// CreatorLink defines model for CreatorLink.
+type CreatorLink struct {
+	CreatedAt         time.Time  `json:"createdAt"`
+	LinkID            uint       `json:"linkID"`
+	LinkRelativeURL   string     `json:"linkRelativeURL"`
+	LinkWebsiteDomain string     `json:"linkWebsiteDomain"`
+	UpdatedAt         *time.Time `json:"updatedAt"`
+}
+
+// DeleteImpact defines model for DeleteImpact.
+type DeleteImpact struct {
+	// Purged Count of rows by table that go for good along with the deleted row once the trash is purged, through cascading foreign keys.
+	Purged map[string]int `json:"purged"`
+
+	// Trashed Count of rows by table that the delete moves to the trash, the deleted row included. They are out of sight until restored.
+	Trashed map[string]int `json:"trashed"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Error struct {
@@ -104,14 +239,34 @@ type LinkTLLanguage struct {
 
 // NewComic defines model for NewComic.
 type NewComic struct {
-	Code string `form:"code" json:"code"`
+	// Chapters Chapters of comic along with their links.
+	Chapters []NewComicChapter `form:"chapters,omitempty" json:"chapters,omitempty"`
+
+	// Code Code of comic, generated when left out.
+	Code     string  `form:"code" json:"code,omitempty"`
+	CoverURL *string `form:"coverURL" json:"coverURL"`
+
+	// Links Links of comic, missing links are added.
+	Links     []NewNestedLink `form:"links,omitempty" json:"links,omitempty"`
+	StartYear *int            `form:"startYear" json:"startYear"`
+	Status    *string         `form:"status" json:"status"`
+
+	// Synopsis Synopsis keyed by language IETF.
+	Synopsis map[string]string `form:"synopsis" json:"synopsis"`
+
+	// Title Title the generated code is derived from in the hash mode, it is not kept.
+	Title *string `form:"title" json:"title"`
+	Type  *string `form:"type" json:"type"`
 }
 
 // NewComicChapter defines model for NewComicChapter.
 type NewComicChapter struct {
-	Chapter    string    `form:"chapter" json:"chapter"`
-	ReleasedAt time.Time `form:"releasedAt" json:"releasedAt"`
-	Version    *string   `form:"version" json:"version"`
+	Chapter string `form:"chapter" json:"chapter"`
+
+	// Links Links of comic chapter, missing links are added.
+	Links      []NewNestedLink `form:"links,omitempty" json:"links,omitempty"`
+	ReleasedAt time.Time       `form:"releasedAt" json:"releasedAt"`
+	Version    *string         `form:"version" json:"version"`
 }
 
 // NewComicChapterLink defines model for NewComicChapterLink.
@@ -121,6 +276,13 @@ type NewComicChapterLink struct {
 	LinkWebsiteDomain *string `form:"linkWebsiteDomain" json:"linkWebsiteDomain"`
 }
 
+// NewComicCreator defines model for NewComicCreator.
+type NewComicCreator struct {
+	CreatorID   *uint   `form:"creatorID" json:"creatorID"`
+	CreatorSlug *string `form:"creatorSlug" json:"creatorSlug"`
+	Role        string  `form:"role" json:"role"`
+}
+
 // NewComicLink defines model for NewComicLink.
 type NewComicLink struct {
 	LinkID            *uint   `form:"linkID" json:"linkID"`
@@ -128,6 +290,36 @@ type NewComicLink struct {
 	LinkWebsiteDomain *string `form:"linkWebsiteDomain" json:"linkWebsiteDomain"`
 }
 
+// NewComicTag defines model for NewComicTag.
+type NewComicTag struct {
+	TagID        *uint   `form:"tagID" json:"tagID"`
+	TagNamespace *string `form:"tagNamespace" json:"tagNamespace"`
+	TagSlug      *string `form:"tagSlug" json:"tagSlug"`
+}
+
+// NewComicTitle defines model for NewComicTitle.
+type NewComicTitle struct {
+	IsPrimary    *bool   `form:"isPrimary" json:"isPrimary"`
+	LanguageID   *uint   `form:"languageID" json:"languageID"`
+	LanguageIETF *string `form:"languageIETF" json:"languageIETF"`
+	Romanized    *bool   `form:"romanized" json:"romanized"`
+	Title        string  `form:"title" json:"title"`
+}
+
+// NewCreator defines model for NewCreator.
+type NewCreator struct {
+	AlternateNames []string `form:"alternateNames,omitempty" json:"alternateNames"`
+	Name           string   `form:"name" json:"name"`
+	Slug           string   `form:"slug" json:"slug"`
+}
+
+// NewCreatorLink defines model for NewCreatorLink.
+type NewCreatorLink struct {
+	LinkID            *uint   `form:"linkID" json:"linkID"`
+	LinkRelativeURL   *string `form:"linkRelativeURL" json:"linkRelativeURL"`
+	LinkWebsiteDomain *string `form:"linkWebsiteDomain" json:"linkWebsiteDomain"`
+}
+
 // NewLanguage defines model for NewLanguage.
 type NewLanguage struct {
 	IETF string `form:"ietf" json:"ietf"`
@@ -136,8 +328,11 @@ type NewLanguage struct {
 
 // NewLink defines model for NewLink.
 type NewLink struct {
-	MachineTL     *bool   `form:"machineTL" json:"machineTL"`
-	RelativeURL   string  `form:"relativeURL" json:"relativeURL"`
+	MachineTL   *bool  `form:"machineTL" json:"machineTL"`
+	RelativeURL string `form:"relativeURL" json:"relativeURL,omitempty"`
+
+	// Url Full URL of link in place of website and relative URL.
+	URL           *string `form:"url" json:"url"`
 	WebsiteDomain *string `form:"websiteDomain" json:"websiteDomain"`
 	WebsiteID     *uint   `form:"websiteID" json:"websiteID"`
 }
@@ -148,6 +343,21 @@ type NewLinkTLLanguage struct {
 	LanguageIETF *string `form:"languageIETF" json:"languageIETF"`
 }
 
+// NewNestedLink defines model for NewNestedLink.
+type NewNestedLink struct {
+	MachineTL     *bool  `form:"machineTL" json:"machineTL"`
+	RelativeURL   string `form:"relativeURL" json:"relativeURL"`
+	WebsiteDomain string `form:"websiteDomain" json:"websiteDomain"`
+}
+
+// NewTag defines model for NewTag.
+type NewTag struct {
+	// Names Display names keyed by language IETF.
+	Names     map[string]string `form:"names" json:"names"`
+	Namespace string            `form:"namespace" json:"namespace"`
+	Slug      string            `form:"slug" json:"slug"`
+}
+
 // NewWebsite defines model for NewWebsite.
 type NewWebsite struct {
 	Domain    string `form:"domain" json:"domain"`
@@ -168,9 +378,48 @@ type Object struct {
 	UpdatedAt *time.Time `json:"updatedAt"`
 }
 
+// PutComicChapter defines model for PutComicChapter.
+type PutComicChapter struct {
+	// Links Links of comic chapter, missing links are added.
+	Links      []NewNestedLink `form:"links,omitempty" json:"links,omitempty"`
+	ReleasedAt time.Time       `form:"releasedAt" json:"releasedAt"`
+}
+
+// PutLink defines model for PutLink.
+type PutLink struct {
+	// MachineTL Left as is on an existing link when missing.
+	MachineTL *bool `form:"machineTL" json:"machineTL"`
+}
+
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	ComicCode *string `json:"comicCode"`
+
+	// Field Matched field of object.
+	Field       string  `json:"field"`
+	ID          uint    `json:"id"`
+	Kind        string  `json:"kind"`
+	RelativeURL *string `json:"relativeURL"`
+
+	// Score Relevance score of result.
+	Score float64 `json:"score"`
+
+	// Text Matched text of field.
+	Text          string  `json:"text"`
+	WebsiteDomain *string `json:"websiteDomain"`
+}
+
 // SetComic defines model for SetComic.
 type SetComic struct {
-	Code *string `form:"code" json:"code"`
+	Code      *string  `form:"code" json:"code"`
+	CoverURL  *string  `form:"coverURL" json:"coverURL"`
+	SetNull   []string `form:"setNull,omitempty" json:"setNull,omitempty"`
+	StartYear *int     `form:"startYear" json:"startYear"`
+	Status    *string  `form:"status" json:"status"`
+
+	// Synopsis Synopsis keyed by language IETF.
+	Synopsis map[string]string `form:"synopsis" json:"synopsis"`
+	Type     *string           `form:"type" json:"type"`
 }
 
 // SetComicChapter defines model for SetComicChapter.
@@ -188,6 +437,13 @@ type SetComicChapterLink struct {
 	LinkWebsiteDomain *string `form:"linkWebsiteDomain" json:"linkWebsiteDomain"`
 }
 
+// SetComicCreator defines model for SetComicCreator.
+type SetComicCreator struct {
+	CreatorID   *uint   `form:"creatorID" json:"creatorID"`
+	CreatorSlug *string `form:"creatorSlug" json:"creatorSlug"`
+	Role        *string `form:"role" json:"role"`
+}
+
 // SetComicLink defines model for SetComicLink.
 type SetComicLink struct {
 	LinkID            *uint   `form:"linkID" json:"linkID"`
@@ -195,6 +451,37 @@ type SetComicLink struct {
 	LinkWebsiteDomain *string `form:"linkWebsiteDomain" json:"linkWebsiteDomain"`
 }
 
+// SetComicTag defines model for SetComicTag.
+type SetComicTag struct {
+	TagID        *uint   `form:"tagID" json:"tagID"`
+	TagNamespace *string `form:"tagNamespace" json:"tagNamespace"`
+	TagSlug      *string `form:"tagSlug" json:"tagSlug"`
+}
+
+// SetComicTitle defines model for SetComicTitle.
+type SetComicTitle struct {
+	IsPrimary    *bool   `form:"isPrimary" json:"isPrimary"`
+	LanguageID   *uint   `form:"languageID" json:"languageID"`
+	LanguageIETF *string `form:"languageIETF" json:"languageIETF"`
+	Romanized    *bool   `form:"romanized" json:"romanized"`
+	Title        *string `form:"title" json:"title"`
+}
+
+// SetCreator defines model for SetCreator.
+type SetCreator struct {
+	AlternateNames []string `form:"alternateNames,omitempty" json:"alternateNames"`
+	Name           *string  `form:"name" json:"name"`
+	SetNull        []string `form:"setNull,omitempty" json:"setNull,omitempty"`
+	Slug           *string  `form:"slug" json:"slug"`
+}
+
+// SetCreatorLink defines model for SetCreatorLink.
+type SetCreatorLink struct {
+	LinkID            *uint   `form:"linkID" json:"linkID"`
+	LinkRelativeURL   *string `form:"linkRelativeURL" json:"linkRelativeURL"`
+	LinkWebsiteDomain *string `form:"linkWebsiteDomain" json:"linkWebsiteDomain"`
+}
+
 // SetLanguage defines model for SetLanguage.
 type SetLanguage struct {
 	IETF *string `form:"ietf" json:"ietf"`
@@ -216,6 +503,15 @@ type SetLinkTLLanguage struct {
 	LanguageIETF *string `form:"languageIETF" json:"languageIETF"`
 }
 
+// SetTag defines model for SetTag.
+type SetTag struct {
+	// Names Display names keyed by language IETF.
+	Names     map[string]string `form:"names" json:"names"`
+	Namespace *string           `form:"namespace" json:"namespace"`
+	SetNull   []string          `form:"setNull,omitempty" json:"setNull,omitempty"`
+	Slug      *string           `form:"slug" json:"slug"`
+}
+
 // SetWebsite defines model for SetWebsite.
 type SetWebsite struct {
 	Domain    *string `form:"domain" json:"domain"`
@@ -229,6 +525,41 @@ type SetWebsiteTLLanguage struct {
 	LanguageIETF *string `form:"languageIETF" json:"languageIETF"`
 }
 
+// Tag defines model for Tag.
+type Tag struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        uint      `json:"id"`
+
+	// Names Display names keyed by language IETF.
+	Names     map[string]string `json:"names"`
+	Namespace string            `json:"namespace"`
+	Slug      string            `json:"slug"`
+	UpdatedAt *time.Time        `json:"updatedAt"`
+}
+
+// TagFacet defines model for TagFacet.
+type TagFacet struct {
+	ComicCount int       `json:"comicCount"`
+	CreatedAt  time.Time `json:"createdAt"`
+	ID         uint      `json:"id"`
+
+	// Names Display names keyed by language IETF.
+	Names     map[string]string `json:"names"`
+	Namespace string            `json:"namespace"`
+	Slug      string            `json:"slug"`
+	UpdatedAt *time.Time        `json:"updatedAt"`
+}
+
+// TrashItem defines model for TrashItem.
+type TrashItem struct {
+	DeletedAt time.Time `json:"deletedAt"`
+	ID        uint      `json:"id"`
+	Kind      string    `json:"kind"`
+
+	// Path The path to restore the object from.
+	Path string `json:"path"`
+}
+
 // Website defines model for Website.
 type Website struct {
 	CreatedAt   time.Time   `json:"createdAt"`
@@ -251,8 +582,80 @@ type WebsiteTLLanguage struct {
 // Default defines model for Default.
 type Default = Error
 
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = Error
+
+// ListAuditEventParams defines parameters for ListAuditEvent.
+type ListAuditEventParams struct {
+	// Entity Limit results to entity type.
+	Entity *string `form:"entity,omitempty" json:"entity,omitempty"`
+
+	// Operation Limit results to operation.
+	Operation *string `form:"operation,omitempty" json:"operation,omitempty"`
+
+	// Actor Limit results to subject of the token that made the mutation.
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Code Limit results to comic code, including the objects that belong to it.
+	Code *string `form:"code,omitempty" json:"code,omitempty"`
+
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListComicParams defines parameters for ListComic.
 type ListComicParams struct {
+	// Q Search text matched against code and titles of comic.
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Code Filter by code of comic.
+	Code *string `form:"code,omitempty" json:"code,omitempty"`
+
+	// Status Filter by status of comic.
+	Status *string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Type Filter by type of comic.
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
+
+	// StartYear Filter by start year of comic.
+	StartYear *int `form:"start_year,omitempty" json:"start_year,omitempty"`
+
+	// Tag Filter by tags in namespace:slug format.
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// ExcludeTag Exclude tags in namespace:slug format.
+	ExcludeTag *[]string `form:"exclude_tag,omitempty" json:"exclude_tag,omitempty"`
+
+	// TagMatch Whether comics must have all or any of the tags.
+	TagMatch *string `form:"tag_match,omitempty" json:"tag_match,omitempty"`
+
+	// CreatedAfter Filter by created after this time.
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// CreatedBefore Filter by created before this time.
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// Include Relations of comic to embed, defaults to all except links.tlLanguages.
+	Include *[]string `form:"include,omitempty" json:"include,omitempty"`
+
+	// IncludeLimit Maximum number of embedded results of every relation per comic, 10 by default.
+	IncludeLimit *int `form:"include_limit,omitempty" json:"include_limit,omitempty"`
+
+	// Fields Fields of comic to return, relations left out are not embedded.
+	Fields *[]string `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Cursor Opaque cursor of next page, takes over page number. Empty starts from first page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Page Page number of results.
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
@@ -263,20 +666,71 @@ type ListComicParams struct {
 	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
 }
 
+// GetComicParams defines parameters for GetComic.
+type GetComicParams struct {
+	// Include Relations of comic to embed, defaults to all except links.tlLanguages.
+	Include *[]string `form:"include,omitempty" json:"include,omitempty"`
+
+	// IncludeLimit Maximum number of embedded results of every relation per comic, 10 by default.
+	IncludeLimit *int `form:"include_limit,omitempty" json:"include_limit,omitempty"`
+
+	// Fields Fields of comic to return, relations left out are not embedded.
+	Fields *[]string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
+// UpdateComicParams defines parameters for UpdateComic.
+type UpdateComicParams struct {
+	// Include Relations of comic to embed, defaults to all except links.tlLanguages.
+	Include *[]string `form:"include,omitempty" json:"include,omitempty"`
+
+	// IncludeLimit Maximum number of embedded results of every relation per comic, 10 by default.
+	IncludeLimit *int `form:"include_limit,omitempty" json:"include_limit,omitempty"`
+
+	// Fields Fields of comic to return, relations left out are not embedded.
+	Fields *[]string `form:"fields,omitempty" json:"fields,omitempty"`
+}
+
 // ListComicChapterParams defines parameters for ListComicChapter.
 type ListComicChapterParams struct {
+	// Chapter Filter by chapter.
+	Chapter *string `form:"chapter,omitempty" json:"chapter,omitempty"`
+
+	// Version Filter by version of chapter.
+	Version *string `form:"version,omitempty" json:"version,omitempty"`
+
+	// ReleasedAfter Filter by released after this time.
+	ReleasedAfter *time.Time `form:"released_after,omitempty" json:"released_after,omitempty"`
+
+	// ReleasedBefore Filter by released before this time.
+	ReleasedBefore *time.Time `form:"released_before,omitempty" json:"released_before,omitempty"`
+
+	// CreatedAfter Filter by created after this time.
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// CreatedBefore Filter by created before this time.
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Cursor Opaque cursor of next page, takes over page number. Empty starts from first page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Page Page number of results.
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
 	// Limit Maximum number of results.
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	// OrderBy Sort results returned.
+	// OrderBy Sort results returned, defaults to number then released_at.
 	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
 }
 
-// ListLanguageParams defines parameters for ListLanguage.
-type ListLanguageParams struct {
+// ListComicChapterLinkParams defines parameters for ListComicChapterLink.
+type ListComicChapterLinkParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
 	// Page Page number of results.
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
@@ -287,8 +741,11 @@ type ListLanguageParams struct {
 	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
 }
 
-// ListLinkParams defines parameters for ListLink.
-type ListLinkParams struct {
+// ListComicCreatorParams defines parameters for ListComicCreator.
+type ListComicCreatorParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
 	// Page Page number of results.
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
@@ -299,8 +756,23 @@ type ListLinkParams struct {
 	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
 }
 
-// ListWebsiteParams defines parameters for ListWebsite.
-type ListWebsiteParams struct {
+// ListComicHistoryParams defines parameters for ListComicHistory.
+type ListComicHistoryParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListComicLinkParams defines parameters for ListComicLink.
+type ListComicLinkParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
 	// Page Page number of results.
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
@@ -311,508 +783,4321 @@ type ListWebsiteParams struct {
 	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
 }
 
-// AddComicJSONRequestBody defines body for AddComic for application/json ContentType.
-type AddComicJSONRequestBody = NewComic
+// ListComicTagParams defines parameters for ListComicTag.
+type ListComicTagParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
 
-// AddComicFormdataRequestBody defines body for AddComic for application/x-www-form-urlencoded ContentType.
-type AddComicFormdataRequestBody = NewComic
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
-// UpdateComicJSONRequestBody defines body for UpdateComic for application/json ContentType.
-type UpdateComicJSONRequestBody = SetComic
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-// UpdateComicFormdataRequestBody defines body for UpdateComic for application/x-www-form-urlencoded ContentType.
-type UpdateComicFormdataRequestBody = SetComic
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
 
-// AddComicChapterJSONRequestBody defines body for AddComicChapter for application/json ContentType.
-type AddComicChapterJSONRequestBody = NewComicChapter
+// ListComicTitleParams defines parameters for ListComicTitle.
+type ListComicTitleParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
 
-// AddComicChapterFormdataRequestBody defines body for AddComicChapter for application/x-www-form-urlencoded ContentType.
-type AddComicChapterFormdataRequestBody = NewComicChapter
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
-// UpdateComicChapterJSONRequestBody defines body for UpdateComicChapter for application/json ContentType.
-type UpdateComicChapterJSONRequestBody = SetComicChapter
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-// UpdateComicChapterFormdataRequestBody defines body for UpdateComicChapter for application/x-www-form-urlencoded ContentType.
-type UpdateComicChapterFormdataRequestBody = SetComicChapter
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
 
-// AddComicChapterLinkJSONRequestBody defines body for AddComicChapterLink for application/json ContentType.
-type AddComicChapterLinkJSONRequestBody = NewComicChapterLink
+// ListCreatorParams defines parameters for ListCreator.
+type ListCreatorParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
 
-// AddComicChapterLinkFormdataRequestBody defines body for AddComicChapterLink for application/x-www-form-urlencoded ContentType.
-type AddComicChapterLinkFormdataRequestBody = NewComicChapterLink
+	// Cursor Opaque cursor of next page, takes over page number. Empty starts from first page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-// UpdateComicChapterLinkJSONRequestBody defines body for UpdateComicChapterLink for application/json ContentType.
-type UpdateComicChapterLinkJSONRequestBody = SetComicChapterLink
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
-// UpdateComicChapterLinkFormdataRequestBody defines body for UpdateComicChapterLink for application/x-www-form-urlencoded ContentType.
-type UpdateComicChapterLinkFormdataRequestBody = SetComicChapterLink
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-// AddComicLinkJSONRequestBody defines body for AddComicLink for application/json ContentType.
-type AddComicLinkJSONRequestBody = NewComicLink
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
 
-// AddComicLinkFormdataRequestBody defines body for AddComicLink for application/x-www-form-urlencoded ContentType.
-type AddComicLinkFormdataRequestBody = NewComicLink
+// ListCreatorComicParams defines parameters for ListCreatorComic.
+type ListCreatorComicParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
 
-// UpdateComicLinkJSONRequestBody defines body for UpdateComicLink for application/json ContentType.
-type UpdateComicLinkJSONRequestBody = SetComicLink
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
-// UpdateComicLinkFormdataRequestBody defines body for UpdateComicLink for application/x-www-form-urlencoded ContentType.
-type UpdateComicLinkFormdataRequestBody = SetComicLink
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-// AddLanguageJSONRequestBody defines body for AddLanguage for application/json ContentType.
-type AddLanguageJSONRequestBody = NewLanguage
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
 
-// AddLanguageFormdataRequestBody defines body for AddLanguage for application/x-www-form-urlencoded ContentType.
-type AddLanguageFormdataRequestBody = NewLanguage
+// ListLanguageParams defines parameters for ListLanguage.
+type ListLanguageParams struct {
+	// Ietf Filter by IETF of language.
+	IETF *string `form:"ietf,omitempty" json:"ietf,omitempty"`
 
-// UpdateLanguageJSONRequestBody defines body for UpdateLanguage for application/json ContentType.
-type UpdateLanguageJSONRequestBody = SetLanguage
+	// Name Filter by name of language, case insensitive partial match.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
 
-// UpdateLanguageFormdataRequestBody defines body for UpdateLanguage for application/x-www-form-urlencoded ContentType.
-type UpdateLanguageFormdataRequestBody = SetLanguage
+	// CreatedAfter Filter by created after this time.
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
 
-// AddLinkJSONRequestBody defines body for AddLink for application/json ContentType.
-type AddLinkJSONRequestBody = NewLink
+	// CreatedBefore Filter by created before this time.
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
 
-// AddLinkFormdataRequestBody defines body for AddLink for application/x-www-form-urlencoded ContentType.
-type AddLinkFormdataRequestBody = NewLink
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
 
-// UpdateLinkJSONRequestBody defines body for UpdateLink for application/json ContentType.
-type UpdateLinkJSONRequestBody = SetLink
+	// Cursor Opaque cursor of next page, takes over page number. Empty starts from first page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 
-// UpdateLinkFormdataRequestBody defines body for UpdateLink for application/x-www-form-urlencoded ContentType.
-type UpdateLinkFormdataRequestBody = SetLink
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
-// AddLinkTLLanguageJSONRequestBody defines body for AddLinkTLLanguage for application/json ContentType.
-type AddLinkTLLanguageJSONRequestBody = NewLinkTLLanguage
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-// AddLinkTLLanguageFormdataRequestBody defines body for AddLinkTLLanguage for application/x-www-form-urlencoded ContentType.
-type AddLinkTLLanguageFormdataRequestBody = NewLinkTLLanguage
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
 
-// UpdateLinkTLLanguageJSONRequestBody defines body for UpdateLinkTLLanguage for application/json ContentType.
-type UpdateLinkTLLanguageJSONRequestBody = SetLinkTLLanguage
+// DeleteLanguageParams defines parameters for DeleteLanguage.
+type DeleteLanguageParams struct {
+	// DryRun Whether to only count the rows that go along with the language, nothing is deleted.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ListLanguageLinkParams defines parameters for ListLanguageLink.
+type ListLanguageLinkParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListLanguageWebsiteParams defines parameters for ListLanguageWebsite.
+type ListLanguageWebsiteParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListLinkParams defines parameters for ListLink.
+type ListLinkParams struct {
+	// WebsiteDomain Filter by domain of website.
+	WebsiteDomain *string `form:"website_domain,omitempty" json:"website_domain,omitempty"`
+
+	// RelativeUrl Filter by relative url of link, case insensitive partial match.
+	RelativeURL *string `form:"relative_url,omitempty" json:"relative_url,omitempty"`
+
+	// MachineTl Filter by machine translation, null for unknown.
+	MachineTL *string `form:"machine_tl,omitempty" json:"machine_tl,omitempty"`
+
+	// CreatedAfter Filter by created after this time.
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// CreatedBefore Filter by created before this time.
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Cursor Opaque cursor of next page, takes over page number. Empty starts from first page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ResolveLinkParams defines parameters for ResolveLink.
+type ResolveLinkParams struct {
+	// Url Full URL of link.
+	URL string `form:"url" json:"url"`
+}
+
+// ListLinkComicChapterParams defines parameters for ListLinkComicChapter.
+type ListLinkComicChapterParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListLinkComicParams defines parameters for ListLinkComic.
+type ListLinkComicParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListLinkTLLanguageParams defines parameters for ListLinkTLLanguage.
+type ListLinkTLLanguageParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	// Q Search text matched against comic codes, titles, website names, domains and link relative URLs.
+	Q string `form:"q" json:"q"`
+
+	// Kind Limit results to kinds of object.
+	Kind *[]string `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListTagParams defines parameters for ListTag.
+type ListTagParams struct {
+	// Namespace Namespace of tag.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Cursor Opaque cursor of next page, takes over page number. Empty starts from first page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListTagFacetParams defines parameters for ListTagFacet.
+type ListTagFacetParams struct {
+	// Namespace Namespace of tag.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Tag Filter by tags in namespace:slug format.
+	Tag *[]string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// ExcludeTag Exclude tags in namespace:slug format.
+	ExcludeTag *[]string `form:"exclude_tag,omitempty" json:"exclude_tag,omitempty"`
+
+	// TagMatch Whether comics must have all or any of the tags.
+	TagMatch *string `form:"tag_match,omitempty" json:"tag_match,omitempty"`
+
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListTrashParams defines parameters for ListTrash.
+type ListTrashParams struct {
+	// Kind Limit results to kinds of object.
+	Kind *[]string `form:"kind,omitempty" json:"kind,omitempty"`
+
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListWebsiteParams defines parameters for ListWebsite.
+type ListWebsiteParams struct {
+	// Domain Filter by domain of website.
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty"`
+
+	// Name Filter by name of website, case insensitive partial match.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// MachineTl Filter by machine translation, null for unknown.
+	MachineTL *string `form:"machine_tl,omitempty" json:"machine_tl,omitempty"`
+
+	// CreatedAfter Filter by created after this time.
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// CreatedBefore Filter by created before this time.
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Cursor Opaque cursor of next page, takes over page number. Empty starts from first page.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// DeleteWebsiteParams defines parameters for DeleteWebsite.
+type DeleteWebsiteParams struct {
+	// DryRun Whether to only count the rows that go along with the website, nothing is deleted.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ListWebsiteLinkParams defines parameters for ListWebsiteLink.
+type ListWebsiteLinkParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// ListWebsiteTLLanguageParams defines parameters for ListWebsiteTLLanguage.
+type ListWebsiteTLLanguageParams struct {
+	// Count Whether to count total results, false skips the count query.
+	Count *bool `form:"count,omitempty" json:"count,omitempty"`
+
+	// Page Page number of results.
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Maximum number of results.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// OrderBy Sort results returned.
+	OrderBy *[]string `form:"order_by,omitempty" json:"order_by,omitempty"`
+}
+
+// RunBatchJSONRequestBody defines body for RunBatch for application/json ContentType.
+type RunBatchJSONRequestBody = Batch
+
+// AddComicJSONRequestBody defines body for AddComic for application/json ContentType.
+type AddComicJSONRequestBody = NewComic
+
+// AddComicFormdataRequestBody defines body for AddComic for application/x-www-form-urlencoded ContentType.
+type AddComicFormdataRequestBody = NewComic
+
+// UpdateComicJSONRequestBody defines body for UpdateComic for application/json ContentType.
+type UpdateComicJSONRequestBody = SetComic
+
+// UpdateComicFormdataRequestBody defines body for UpdateComic for application/x-www-form-urlencoded ContentType.
+type UpdateComicFormdataRequestBody = SetComic
+
+// AddComicChapterJSONRequestBody defines body for AddComicChapter for application/json ContentType.
+type AddComicChapterJSONRequestBody = NewComicChapter
+
+// AddComicChapterFormdataRequestBody defines body for AddComicChapter for application/x-www-form-urlencoded ContentType.
+type AddComicChapterFormdataRequestBody = NewComicChapter
+
+// UpdateComicChapterJSONRequestBody defines body for UpdateComicChapter for application/json ContentType.
+type UpdateComicChapterJSONRequestBody = SetComicChapter
+
+// UpdateComicChapterFormdataRequestBody defines body for UpdateComicChapter for application/x-www-form-urlencoded ContentType.
+type UpdateComicChapterFormdataRequestBody = SetComicChapter
+
+// PutComicChapterJSONRequestBody defines body for PutComicChapter for application/json ContentType.
+type PutComicChapterJSONRequestBody = PutComicChapter
+
+// PutComicChapterFormdataRequestBody defines body for PutComicChapter for application/x-www-form-urlencoded ContentType.
+type PutComicChapterFormdataRequestBody = PutComicChapter
+
+// AddComicChapterLinkJSONRequestBody defines body for AddComicChapterLink for application/json ContentType.
+type AddComicChapterLinkJSONRequestBody = NewComicChapterLink
+
+// AddComicChapterLinkFormdataRequestBody defines body for AddComicChapterLink for application/x-www-form-urlencoded ContentType.
+type AddComicChapterLinkFormdataRequestBody = NewComicChapterLink
+
+// UpdateComicChapterLinkJSONRequestBody defines body for UpdateComicChapterLink for application/json ContentType.
+type UpdateComicChapterLinkJSONRequestBody = SetComicChapterLink
+
+// UpdateComicChapterLinkFormdataRequestBody defines body for UpdateComicChapterLink for application/x-www-form-urlencoded ContentType.
+type UpdateComicChapterLinkFormdataRequestBody = SetComicChapterLink
+
+// AddComicCreatorJSONRequestBody defines body for AddComicCreator for application/json ContentType.
+type AddComicCreatorJSONRequestBody = NewComicCreator
+
+// AddComicCreatorFormdataRequestBody defines body for AddComicCreator for application/x-www-form-urlencoded ContentType.
+type AddComicCreatorFormdataRequestBody = NewComicCreator
+
+// UpdateComicCreatorJSONRequestBody defines body for UpdateComicCreator for application/json ContentType.
+type UpdateComicCreatorJSONRequestBody = SetComicCreator
+
+// UpdateComicCreatorFormdataRequestBody defines body for UpdateComicCreator for application/x-www-form-urlencoded ContentType.
+type UpdateComicCreatorFormdataRequestBody = SetComicCreator
+
+// AddComicLinkJSONRequestBody defines body for AddComicLink for application/json ContentType.
+type AddComicLinkJSONRequestBody = NewComicLink
+
+// AddComicLinkFormdataRequestBody defines body for AddComicLink for application/x-www-form-urlencoded ContentType.
+type AddComicLinkFormdataRequestBody = NewComicLink
+
+// UpdateComicLinkJSONRequestBody defines body for UpdateComicLink for application/json ContentType.
+type UpdateComicLinkJSONRequestBody = SetComicLink
+
+// UpdateComicLinkFormdataRequestBody defines body for UpdateComicLink for application/x-www-form-urlencoded ContentType.
+type UpdateComicLinkFormdataRequestBody = SetComicLink
+
+// AddComicTagJSONRequestBody defines body for AddComicTag for application/json ContentType.
+type AddComicTagJSONRequestBody = NewComicTag
+
+// AddComicTagFormdataRequestBody defines body for AddComicTag for application/x-www-form-urlencoded ContentType.
+type AddComicTagFormdataRequestBody = NewComicTag
+
+// UpdateComicTagJSONRequestBody defines body for UpdateComicTag for application/json ContentType.
+type UpdateComicTagJSONRequestBody = SetComicTag
+
+// UpdateComicTagFormdataRequestBody defines body for UpdateComicTag for application/x-www-form-urlencoded ContentType.
+type UpdateComicTagFormdataRequestBody = SetComicTag
+
+// AddComicTitleJSONRequestBody defines body for AddComicTitle for application/json ContentType.
+type AddComicTitleJSONRequestBody = NewComicTitle
+
+// AddComicTitleFormdataRequestBody defines body for AddComicTitle for application/x-www-form-urlencoded ContentType.
+type AddComicTitleFormdataRequestBody = NewComicTitle
+
+// UpdateComicTitleJSONRequestBody defines body for UpdateComicTitle for application/json ContentType.
+type UpdateComicTitleJSONRequestBody = SetComicTitle
+
+// UpdateComicTitleFormdataRequestBody defines body for UpdateComicTitle for application/x-www-form-urlencoded ContentType.
+type UpdateComicTitleFormdataRequestBody = SetComicTitle
+
+// AddCreatorJSONRequestBody defines body for AddCreator for application/json ContentType.
+type AddCreatorJSONRequestBody = NewCreator
+
+// AddCreatorFormdataRequestBody defines body for AddCreator for application/x-www-form-urlencoded ContentType.
+type AddCreatorFormdataRequestBody = NewCreator
+
+// UpdateCreatorJSONRequestBody defines body for UpdateCreator for application/json ContentType.
+type UpdateCreatorJSONRequestBody = SetCreator
+
+// UpdateCreatorFormdataRequestBody defines body for UpdateCreator for application/x-www-form-urlencoded ContentType.
+type UpdateCreatorFormdataRequestBody = SetCreator
+
+// AddCreatorLinkJSONRequestBody defines body for AddCreatorLink for application/json ContentType.
+type AddCreatorLinkJSONRequestBody = NewCreatorLink
+
+// AddCreatorLinkFormdataRequestBody defines body for AddCreatorLink for application/x-www-form-urlencoded ContentType.
+type AddCreatorLinkFormdataRequestBody = NewCreatorLink
+
+// UpdateCreatorLinkJSONRequestBody defines body for UpdateCreatorLink for application/json ContentType.
+type UpdateCreatorLinkJSONRequestBody = SetCreatorLink
+
+// UpdateCreatorLinkFormdataRequestBody defines body for UpdateCreatorLink for application/x-www-form-urlencoded ContentType.
+type UpdateCreatorLinkFormdataRequestBody = SetCreatorLink
+
+// AddLanguageJSONRequestBody defines body for AddLanguage for application/json ContentType.
+type AddLanguageJSONRequestBody = NewLanguage
+
+// AddLanguageFormdataRequestBody defines body for AddLanguage for application/x-www-form-urlencoded ContentType.
+type AddLanguageFormdataRequestBody = NewLanguage
+
+// UpdateLanguageJSONRequestBody defines body for UpdateLanguage for application/json ContentType.
+type UpdateLanguageJSONRequestBody = SetLanguage
+
+// UpdateLanguageFormdataRequestBody defines body for UpdateLanguage for application/x-www-form-urlencoded ContentType.
+type UpdateLanguageFormdataRequestBody = SetLanguage
+
+// AddLinkJSONRequestBody defines body for AddLink for application/json ContentType.
+type AddLinkJSONRequestBody = NewLink
+
+// AddLinkFormdataRequestBody defines body for AddLink for application/x-www-form-urlencoded ContentType.
+type AddLinkFormdataRequestBody = NewLink
+
+// UpdateLinkJSONRequestBody defines body for UpdateLink for application/json ContentType.
+type UpdateLinkJSONRequestBody = SetLink
+
+// UpdateLinkFormdataRequestBody defines body for UpdateLink for application/x-www-form-urlencoded ContentType.
+type UpdateLinkFormdataRequestBody = SetLink
+
+// PutLinkJSONRequestBody defines body for PutLink for application/json ContentType.
+type PutLinkJSONRequestBody = PutLink
+
+// PutLinkFormdataRequestBody defines body for PutLink for application/x-www-form-urlencoded ContentType.
+type PutLinkFormdataRequestBody = PutLink
+
+// AddLinkTLLanguageJSONRequestBody defines body for AddLinkTLLanguage for application/json ContentType.
+type AddLinkTLLanguageJSONRequestBody = NewLinkTLLanguage
+
+// AddLinkTLLanguageFormdataRequestBody defines body for AddLinkTLLanguage for application/x-www-form-urlencoded ContentType.
+type AddLinkTLLanguageFormdataRequestBody = NewLinkTLLanguage
+
+// UpdateLinkTLLanguageJSONRequestBody defines body for UpdateLinkTLLanguage for application/json ContentType.
+type UpdateLinkTLLanguageJSONRequestBody = SetLinkTLLanguage
 
 // UpdateLinkTLLanguageFormdataRequestBody defines body for UpdateLinkTLLanguage for application/x-www-form-urlencoded ContentType.
 type UpdateLinkTLLanguageFormdataRequestBody = SetLinkTLLanguage
 
-// AddWebsiteJSONRequestBody defines body for AddWebsite for application/json ContentType.
-type AddWebsiteJSONRequestBody = NewWebsite
+// AddTagJSONRequestBody defines body for AddTag for application/json ContentType.
+type AddTagJSONRequestBody = NewTag
+
+// AddTagFormdataRequestBody defines body for AddTag for application/x-www-form-urlencoded ContentType.
+type AddTagFormdataRequestBody = NewTag
+
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = SetTag
+
+// UpdateTagFormdataRequestBody defines body for UpdateTag for application/x-www-form-urlencoded ContentType.
+type UpdateTagFormdataRequestBody = SetTag
+
+// AddWebsiteJSONRequestBody defines body for AddWebsite for application/json ContentType.
+type AddWebsiteJSONRequestBody = NewWebsite
+
+// AddWebsiteFormdataRequestBody defines body for AddWebsite for application/x-www-form-urlencoded ContentType.
+type AddWebsiteFormdataRequestBody = NewWebsite
+
+// UpdateWebsiteJSONRequestBody defines body for UpdateWebsite for application/json ContentType.
+type UpdateWebsiteJSONRequestBody = SetWebsite
+
+// UpdateWebsiteFormdataRequestBody defines body for UpdateWebsite for application/x-www-form-urlencoded ContentType.
+type UpdateWebsiteFormdataRequestBody = SetWebsite
+
+// AddWebsiteTLLanguageJSONRequestBody defines body for AddWebsiteTLLanguage for application/json ContentType.
+type AddWebsiteTLLanguageJSONRequestBody = NewWebsiteTLLanguage
+
+// AddWebsiteTLLanguageFormdataRequestBody defines body for AddWebsiteTLLanguage for application/x-www-form-urlencoded ContentType.
+type AddWebsiteTLLanguageFormdataRequestBody = NewWebsiteTLLanguage
+
+// UpdateWebsiteTLLanguageJSONRequestBody defines body for UpdateWebsiteTLLanguage for application/json ContentType.
+type UpdateWebsiteTLLanguageJSONRequestBody = SetWebsiteTLLanguage
+
+// UpdateWebsiteTLLanguageFormdataRequestBody defines body for UpdateWebsiteTLLanguage for application/x-www-form-urlencoded ContentType.
+type UpdateWebsiteTLLanguageFormdataRequestBody = SetWebsiteTLLanguage
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List audit event.
+	// (GET /audit)
+	ListAuditEvent(w http.ResponseWriter, r *http.Request, params ListAuditEventParams)
+	// Run batch.
+	// (POST /batch)
+	RunBatch(w http.ResponseWriter, r *http.Request)
+	// List comic.
+	// (GET /comics)
+	ListComic(w http.ResponseWriter, r *http.Request, params ListComicParams)
+	// Add comic.
+	// (POST /comics)
+	AddComic(w http.ResponseWriter, r *http.Request)
+	// Delete comic.
+	// (DELETE /comics/{code})
+	DeleteComic(w http.ResponseWriter, r *http.Request, code string)
+	// Get comic.
+	// (GET /comics/{code})
+	GetComic(w http.ResponseWriter, r *http.Request, code string, params GetComicParams)
+	// Update comic.
+	// (PATCH /comics/{code})
+	UpdateComic(w http.ResponseWriter, r *http.Request, code string, params UpdateComicParams)
+	// List comic chapter.
+	// (GET /comics/{code}/chapters)
+	ListComicChapter(w http.ResponseWriter, r *http.Request, code string, params ListComicChapterParams)
+	// Add comic chapter.
+	// (POST /comics/{code}/chapters)
+	AddComicChapter(w http.ResponseWriter, r *http.Request, code string)
+	// Delete comic chapter.
+	// (DELETE /comics/{code}/chapters/{cv})
+	DeleteComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string)
+	// Get comic chapter.
+	// (GET /comics/{code}/chapters/{cv})
+	GetComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string)
+	// Update comic chapter.
+	// (PATCH /comics/{code}/chapters/{cv})
+	UpdateComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string)
+	// Add or update comic chapter.
+	// (PUT /comics/{code}/chapters/{cv})
+	PutComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string)
+	// List comic chapter link.
+	// (GET /comics/{code}/chapters/{cv}/links)
+	ListComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, params ListComicChapterLinkParams)
+	// Add comic chapter link.
+	// (POST /comics/{code}/chapters/{cv}/links)
+	AddComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string)
+	// Delete comic chapter link.
+	// (DELETE /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
+	DeleteComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string)
+	// Get comic chapter link.
+	// (GET /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
+	GetComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string)
+	// Update comic chapter link.
+	// (PATCH /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
+	UpdateComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string)
+	// Add or touch comic chapter link.
+	// (PUT /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
+	PutComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string)
+	// Restore comic chapter.
+	// (POST /comics/{code}/chapters/{cv}/restore)
+	RestoreComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string)
+	// List comic creator.
+	// (GET /comics/{code}/creators)
+	ListComicCreator(w http.ResponseWriter, r *http.Request, code string, params ListComicCreatorParams)
+	// Add comic creator.
+	// (POST /comics/{code}/creators)
+	AddComicCreator(w http.ResponseWriter, r *http.Request, code string)
+	// Delete comic creator.
+	// (DELETE /comics/{code}/creators/{creatorSlug}+{role})
+	DeleteComicCreator(w http.ResponseWriter, r *http.Request, code string, creatorSlug string, role string)
+	// Get comic creator.
+	// (GET /comics/{code}/creators/{creatorSlug}+{role})
+	GetComicCreator(w http.ResponseWriter, r *http.Request, code string, creatorSlug string, role string)
+	// Update comic creator.
+	// (PATCH /comics/{code}/creators/{creatorSlug}+{role})
+	UpdateComicCreator(w http.ResponseWriter, r *http.Request, code string, creatorSlug string, role string)
+	// List comic history.
+	// (GET /comics/{code}/history)
+	ListComicHistory(w http.ResponseWriter, r *http.Request, code string, params ListComicHistoryParams)
+	// List comic link.
+	// (GET /comics/{code}/links)
+	ListComicLink(w http.ResponseWriter, r *http.Request, code string, params ListComicLinkParams)
+	// Add comic link.
+	// (POST /comics/{code}/links)
+	AddComicLink(w http.ResponseWriter, r *http.Request, code string)
+	// Delete comic link.
+	// (DELETE /comics/{code}/links/{websiteDomain}-{relativeURL})
+	DeleteComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string)
+	// Get comic link.
+	// (GET /comics/{code}/links/{websiteDomain}-{relativeURL})
+	GetComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string)
+	// Update comic link.
+	// (PATCH /comics/{code}/links/{websiteDomain}-{relativeURL})
+	UpdateComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string)
+	// Add or touch comic link.
+	// (PUT /comics/{code}/links/{websiteDomain}-{relativeURL})
+	PutComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string)
+	// Restore comic.
+	// (POST /comics/{code}/restore)
+	RestoreComic(w http.ResponseWriter, r *http.Request, code string)
+	// List comic tag.
+	// (GET /comics/{code}/tags)
+	ListComicTag(w http.ResponseWriter, r *http.Request, code string, params ListComicTagParams)
+	// Add comic tag.
+	// (POST /comics/{code}/tags)
+	AddComicTag(w http.ResponseWriter, r *http.Request, code string)
+	// Delete comic tag.
+	// (DELETE /comics/{code}/tags/{tagNamespace}/{tagSlug})
+	DeleteComicTag(w http.ResponseWriter, r *http.Request, code string, tagNamespace string, tagSlug string)
+	// Get comic tag.
+	// (GET /comics/{code}/tags/{tagNamespace}/{tagSlug})
+	GetComicTag(w http.ResponseWriter, r *http.Request, code string, tagNamespace string, tagSlug string)
+	// Update comic tag.
+	// (PATCH /comics/{code}/tags/{tagNamespace}/{tagSlug})
+	UpdateComicTag(w http.ResponseWriter, r *http.Request, code string, tagNamespace string, tagSlug string)
+	// List comic title.
+	// (GET /comics/{code}/titles)
+	ListComicTitle(w http.ResponseWriter, r *http.Request, code string, params ListComicTitleParams)
+	// Add comic title.
+	// (POST /comics/{code}/titles)
+	AddComicTitle(w http.ResponseWriter, r *http.Request, code string)
+	// Delete comic title.
+	// (DELETE /comics/{code}/titles/{rid})
+	DeleteComicTitle(w http.ResponseWriter, r *http.Request, code string, rid string)
+	// Get comic title.
+	// (GET /comics/{code}/titles/{rid})
+	GetComicTitle(w http.ResponseWriter, r *http.Request, code string, rid string)
+	// Update comic title.
+	// (PATCH /comics/{code}/titles/{rid})
+	UpdateComicTitle(w http.ResponseWriter, r *http.Request, code string, rid string)
+	// List creator.
+	// (GET /creators)
+	ListCreator(w http.ResponseWriter, r *http.Request, params ListCreatorParams)
+	// Add creator.
+	// (POST /creators)
+	AddCreator(w http.ResponseWriter, r *http.Request)
+	// Delete creator.
+	// (DELETE /creators/{slug})
+	DeleteCreator(w http.ResponseWriter, r *http.Request, slug string)
+	// Get creator.
+	// (GET /creators/{slug})
+	GetCreator(w http.ResponseWriter, r *http.Request, slug string)
+	// Update creator.
+	// (PATCH /creators/{slug})
+	UpdateCreator(w http.ResponseWriter, r *http.Request, slug string)
+	// List creator comic.
+	// (GET /creators/{slug}/comics)
+	ListCreatorComic(w http.ResponseWriter, r *http.Request, slug string, params ListCreatorComicParams)
+	// Add creator link.
+	// (POST /creators/{slug}/links)
+	AddCreatorLink(w http.ResponseWriter, r *http.Request, slug string)
+	// Delete creator link.
+	// (DELETE /creators/{slug}/links/{websiteDomain}-{relativeURL})
+	DeleteCreatorLink(w http.ResponseWriter, r *http.Request, slug string, websiteDomain string, relativeURL string)
+	// Get creator link.
+	// (GET /creators/{slug}/links/{websiteDomain}-{relativeURL})
+	GetCreatorLink(w http.ResponseWriter, r *http.Request, slug string, websiteDomain string, relativeURL string)
+	// Update creator link.
+	// (PATCH /creators/{slug}/links/{websiteDomain}-{relativeURL})
+	UpdateCreatorLink(w http.ResponseWriter, r *http.Request, slug string, websiteDomain string, relativeURL string)
+	// List language.
+	// (GET /languages)
+	ListLanguage(w http.ResponseWriter, r *http.Request, params ListLanguageParams)
+	// Add language.
+	// (POST /languages)
+	AddLanguage(w http.ResponseWriter, r *http.Request)
+	// Delete language.
+	// (DELETE /languages/{ietf})
+	DeleteLanguage(w http.ResponseWriter, r *http.Request, ietf string, params DeleteLanguageParams)
+	// Get language.
+	// (GET /languages/{ietf})
+	GetLanguage(w http.ResponseWriter, r *http.Request, ietf string)
+	// Update language.
+	// (PATCH /languages/{ietf})
+	UpdateLanguage(w http.ResponseWriter, r *http.Request, ietf string)
+	// List language link.
+	// (GET /languages/{ietf}/links)
+	ListLanguageLink(w http.ResponseWriter, r *http.Request, ietf string, params ListLanguageLinkParams)
+	// Restore language.
+	// (POST /languages/{ietf}/restore)
+	RestoreLanguage(w http.ResponseWriter, r *http.Request, ietf string)
+	// List language website.
+	// (GET /languages/{ietf}/websites)
+	ListLanguageWebsite(w http.ResponseWriter, r *http.Request, ietf string, params ListLanguageWebsiteParams)
+	// List link.
+	// (GET /links)
+	ListLink(w http.ResponseWriter, r *http.Request, params ListLinkParams)
+	// Add link.
+	// (POST /links)
+	AddLink(w http.ResponseWriter, r *http.Request)
+	// Resolve link.
+	// (GET /links/resolve)
+	ResolveLink(w http.ResponseWriter, r *http.Request, params ResolveLinkParams)
+	// Delete link.
+	// (DELETE /links/{websiteDomain}-{relativeURL})
+	DeleteLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string)
+	// Get link.
+	// (GET /links/{websiteDomain}-{relativeURL})
+	GetLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string)
+	// Update link.
+	// (PATCH /links/{websiteDomain}-{relativeURL})
+	UpdateLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string)
+	// Add or update link.
+	// (PUT /links/{websiteDomain}-{relativeURL})
+	PutLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string)
+	// List link comic chapter.
+	// (GET /links/{websiteDomain}-{relativeURL}/chapters)
+	ListLinkComicChapter(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, params ListLinkComicChapterParams)
+	// List link comic.
+	// (GET /links/{websiteDomain}-{relativeURL}/comics)
+	ListLinkComic(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, params ListLinkComicParams)
+	// Restore link.
+	// (POST /links/{websiteDomain}-{relativeURL}/restore)
+	RestoreLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string)
+	// List link tl language.
+	// (GET /links/{websiteDomain}-{relativeURL}/tl-languages)
+	ListLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, params ListLinkTLLanguageParams)
+	// Add link TL language.
+	// (POST /links/{websiteDomain}-{relativeURL}/tl-languages)
+	AddLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string)
+	// Delete link TL language.
+	// (DELETE /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
+	DeleteLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string)
+	// Get link TL language.
+	// (GET /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
+	GetLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string)
+	// Update link TL language.
+	// (PATCH /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
+	UpdateLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string)
+	// Add or touch link tl language.
+	// (PUT /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
+	PutLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string)
+	// Search.
+	// (GET /search)
+	Search(w http.ResponseWriter, r *http.Request, params SearchParams)
+	// List tag.
+	// (GET /tags)
+	ListTag(w http.ResponseWriter, r *http.Request, params ListTagParams)
+	// Add tag.
+	// (POST /tags)
+	AddTag(w http.ResponseWriter, r *http.Request)
+	// List tag facet.
+	// (GET /tags/facets)
+	ListTagFacet(w http.ResponseWriter, r *http.Request, params ListTagFacetParams)
+	// Delete tag.
+	// (DELETE /tags/{namespace}/{slug})
+	DeleteTag(w http.ResponseWriter, r *http.Request, namespace string, slug string)
+	// Get tag.
+	// (GET /tags/{namespace}/{slug})
+	GetTag(w http.ResponseWriter, r *http.Request, namespace string, slug string)
+	// Update tag.
+	// (PATCH /tags/{namespace}/{slug})
+	UpdateTag(w http.ResponseWriter, r *http.Request, namespace string, slug string)
+	// List trash.
+	// (GET /trash)
+	ListTrash(w http.ResponseWriter, r *http.Request, params ListTrashParams)
+	// List website.
+	// (GET /websites)
+	ListWebsite(w http.ResponseWriter, r *http.Request, params ListWebsiteParams)
+	// Add website.
+	// (POST /websites)
+	AddWebsite(w http.ResponseWriter, r *http.Request)
+	// Delete website.
+	// (DELETE /websites/{domain})
+	DeleteWebsite(w http.ResponseWriter, r *http.Request, domain string, params DeleteWebsiteParams)
+	// Get website.
+	// (GET /websites/{domain})
+	GetWebsite(w http.ResponseWriter, r *http.Request, domain string)
+	// Update website.
+	// (PATCH /websites/{domain})
+	UpdateWebsite(w http.ResponseWriter, r *http.Request, domain string)
+	// List website link.
+	// (GET /websites/{domain}/links)
+	ListWebsiteLink(w http.ResponseWriter, r *http.Request, domain string, params ListWebsiteLinkParams)
+	// Restore website.
+	// (POST /websites/{domain}/restore)
+	RestoreWebsite(w http.ResponseWriter, r *http.Request, domain string)
+	// List website tl language.
+	// (GET /websites/{domain}/tl-languages)
+	ListWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, params ListWebsiteTLLanguageParams)
+	// Add website TL language.
+	// (POST /websites/{domain}/tl-languages)
+	AddWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string)
+	// Delete website TL language.
+	// (DELETE /websites/{domain}/tl-languages/{ietf})
+	DeleteWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string)
+	// Get website TL language.
+	// (GET /websites/{domain}/tl-languages/{ietf})
+	GetWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string)
+	// Update website TL language.
+	// (PATCH /websites/{domain}/tl-languages/{ietf})
+	UpdateWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// List audit event.
+// (GET /audit)
+func (_ Unimplemented) ListAuditEvent(w http.ResponseWriter, r *http.Request, params ListAuditEventParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Run batch.
+// (POST /batch)
+func (_ Unimplemented) RunBatch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List comic.
+// (GET /comics)
+func (_ Unimplemented) ListComic(w http.ResponseWriter, r *http.Request, params ListComicParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add comic.
+// (POST /comics)
+func (_ Unimplemented) AddComic(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete comic.
+// (DELETE /comics/{code})
+func (_ Unimplemented) DeleteComic(w http.ResponseWriter, r *http.Request, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get comic.
+// (GET /comics/{code})
+func (_ Unimplemented) GetComic(w http.ResponseWriter, r *http.Request, code string, params GetComicParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update comic.
+// (PATCH /comics/{code})
+func (_ Unimplemented) UpdateComic(w http.ResponseWriter, r *http.Request, code string, params UpdateComicParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List comic chapter.
+// (GET /comics/{code}/chapters)
+func (_ Unimplemented) ListComicChapter(w http.ResponseWriter, r *http.Request, code string, params ListComicChapterParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add comic chapter.
+// (POST /comics/{code}/chapters)
+func (_ Unimplemented) AddComicChapter(w http.ResponseWriter, r *http.Request, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete comic chapter.
+// (DELETE /comics/{code}/chapters/{cv})
+func (_ Unimplemented) DeleteComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get comic chapter.
+// (GET /comics/{code}/chapters/{cv})
+func (_ Unimplemented) GetComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update comic chapter.
+// (PATCH /comics/{code}/chapters/{cv})
+func (_ Unimplemented) UpdateComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add or update comic chapter.
+// (PUT /comics/{code}/chapters/{cv})
+func (_ Unimplemented) PutComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List comic chapter link.
+// (GET /comics/{code}/chapters/{cv}/links)
+func (_ Unimplemented) ListComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, params ListComicChapterLinkParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add comic chapter link.
+// (POST /comics/{code}/chapters/{cv}/links)
+func (_ Unimplemented) AddComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete comic chapter link.
+// (DELETE /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) DeleteComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get comic chapter link.
+// (GET /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) GetComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update comic chapter link.
+// (PATCH /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) UpdateComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add or touch comic chapter link.
+// (PUT /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) PutComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore comic chapter.
+// (POST /comics/{code}/chapters/{cv}/restore)
+func (_ Unimplemented) RestoreComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List comic creator.
+// (GET /comics/{code}/creators)
+func (_ Unimplemented) ListComicCreator(w http.ResponseWriter, r *http.Request, code string, params ListComicCreatorParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add comic creator.
+// (POST /comics/{code}/creators)
+func (_ Unimplemented) AddComicCreator(w http.ResponseWriter, r *http.Request, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete comic creator.
+// (DELETE /comics/{code}/creators/{creatorSlug}+{role})
+func (_ Unimplemented) DeleteComicCreator(w http.ResponseWriter, r *http.Request, code string, creatorSlug string, role string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get comic creator.
+// (GET /comics/{code}/creators/{creatorSlug}+{role})
+func (_ Unimplemented) GetComicCreator(w http.ResponseWriter, r *http.Request, code string, creatorSlug string, role string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update comic creator.
+// (PATCH /comics/{code}/creators/{creatorSlug}+{role})
+func (_ Unimplemented) UpdateComicCreator(w http.ResponseWriter, r *http.Request, code string, creatorSlug string, role string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List comic history.
+// (GET /comics/{code}/history)
+func (_ Unimplemented) ListComicHistory(w http.ResponseWriter, r *http.Request, code string, params ListComicHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List comic link.
+// (GET /comics/{code}/links)
+func (_ Unimplemented) ListComicLink(w http.ResponseWriter, r *http.Request, code string, params ListComicLinkParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add comic link.
+// (POST /comics/{code}/links)
+func (_ Unimplemented) AddComicLink(w http.ResponseWriter, r *http.Request, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete comic link.
+// (DELETE /comics/{code}/links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) DeleteComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get comic link.
+// (GET /comics/{code}/links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) GetComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update comic link.
+// (PATCH /comics/{code}/links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) UpdateComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add or touch comic link.
+// (PUT /comics/{code}/links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) PutComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore comic.
+// (POST /comics/{code}/restore)
+func (_ Unimplemented) RestoreComic(w http.ResponseWriter, r *http.Request, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List comic tag.
+// (GET /comics/{code}/tags)
+func (_ Unimplemented) ListComicTag(w http.ResponseWriter, r *http.Request, code string, params ListComicTagParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add comic tag.
+// (POST /comics/{code}/tags)
+func (_ Unimplemented) AddComicTag(w http.ResponseWriter, r *http.Request, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete comic tag.
+// (DELETE /comics/{code}/tags/{tagNamespace}/{tagSlug})
+func (_ Unimplemented) DeleteComicTag(w http.ResponseWriter, r *http.Request, code string, tagNamespace string, tagSlug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get comic tag.
+// (GET /comics/{code}/tags/{tagNamespace}/{tagSlug})
+func (_ Unimplemented) GetComicTag(w http.ResponseWriter, r *http.Request, code string, tagNamespace string, tagSlug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update comic tag.
+// (PATCH /comics/{code}/tags/{tagNamespace}/{tagSlug})
+func (_ Unimplemented) UpdateComicTag(w http.ResponseWriter, r *http.Request, code string, tagNamespace string, tagSlug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List comic title.
+// (GET /comics/{code}/titles)
+func (_ Unimplemented) ListComicTitle(w http.ResponseWriter, r *http.Request, code string, params ListComicTitleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add comic title.
+// (POST /comics/{code}/titles)
+func (_ Unimplemented) AddComicTitle(w http.ResponseWriter, r *http.Request, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete comic title.
+// (DELETE /comics/{code}/titles/{rid})
+func (_ Unimplemented) DeleteComicTitle(w http.ResponseWriter, r *http.Request, code string, rid string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get comic title.
+// (GET /comics/{code}/titles/{rid})
+func (_ Unimplemented) GetComicTitle(w http.ResponseWriter, r *http.Request, code string, rid string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update comic title.
+// (PATCH /comics/{code}/titles/{rid})
+func (_ Unimplemented) UpdateComicTitle(w http.ResponseWriter, r *http.Request, code string, rid string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List creator.
+// (GET /creators)
+func (_ Unimplemented) ListCreator(w http.ResponseWriter, r *http.Request, params ListCreatorParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add creator.
+// (POST /creators)
+func (_ Unimplemented) AddCreator(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete creator.
+// (DELETE /creators/{slug})
+func (_ Unimplemented) DeleteCreator(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get creator.
+// (GET /creators/{slug})
+func (_ Unimplemented) GetCreator(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update creator.
+// (PATCH /creators/{slug})
+func (_ Unimplemented) UpdateCreator(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List creator comic.
+// (GET /creators/{slug}/comics)
+func (_ Unimplemented) ListCreatorComic(w http.ResponseWriter, r *http.Request, slug string, params ListCreatorComicParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add creator link.
+// (POST /creators/{slug}/links)
+func (_ Unimplemented) AddCreatorLink(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete creator link.
+// (DELETE /creators/{slug}/links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) DeleteCreatorLink(w http.ResponseWriter, r *http.Request, slug string, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get creator link.
+// (GET /creators/{slug}/links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) GetCreatorLink(w http.ResponseWriter, r *http.Request, slug string, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update creator link.
+// (PATCH /creators/{slug}/links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) UpdateCreatorLink(w http.ResponseWriter, r *http.Request, slug string, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List language.
+// (GET /languages)
+func (_ Unimplemented) ListLanguage(w http.ResponseWriter, r *http.Request, params ListLanguageParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add language.
+// (POST /languages)
+func (_ Unimplemented) AddLanguage(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete language.
+// (DELETE /languages/{ietf})
+func (_ Unimplemented) DeleteLanguage(w http.ResponseWriter, r *http.Request, ietf string, params DeleteLanguageParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get language.
+// (GET /languages/{ietf})
+func (_ Unimplemented) GetLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update language.
+// (PATCH /languages/{ietf})
+func (_ Unimplemented) UpdateLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List language link.
+// (GET /languages/{ietf}/links)
+func (_ Unimplemented) ListLanguageLink(w http.ResponseWriter, r *http.Request, ietf string, params ListLanguageLinkParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore language.
+// (POST /languages/{ietf}/restore)
+func (_ Unimplemented) RestoreLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List language website.
+// (GET /languages/{ietf}/websites)
+func (_ Unimplemented) ListLanguageWebsite(w http.ResponseWriter, r *http.Request, ietf string, params ListLanguageWebsiteParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List link.
+// (GET /links)
+func (_ Unimplemented) ListLink(w http.ResponseWriter, r *http.Request, params ListLinkParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add link.
+// (POST /links)
+func (_ Unimplemented) AddLink(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resolve link.
+// (GET /links/resolve)
+func (_ Unimplemented) ResolveLink(w http.ResponseWriter, r *http.Request, params ResolveLinkParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete link.
+// (DELETE /links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) DeleteLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get link.
+// (GET /links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) GetLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update link.
+// (PATCH /links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) UpdateLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add or update link.
+// (PUT /links/{websiteDomain}-{relativeURL})
+func (_ Unimplemented) PutLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List link comic chapter.
+// (GET /links/{websiteDomain}-{relativeURL}/chapters)
+func (_ Unimplemented) ListLinkComicChapter(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, params ListLinkComicChapterParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List link comic.
+// (GET /links/{websiteDomain}-{relativeURL}/comics)
+func (_ Unimplemented) ListLinkComic(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, params ListLinkComicParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore link.
+// (POST /links/{websiteDomain}-{relativeURL}/restore)
+func (_ Unimplemented) RestoreLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List link tl language.
+// (GET /links/{websiteDomain}-{relativeURL}/tl-languages)
+func (_ Unimplemented) ListLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, params ListLinkTLLanguageParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add link TL language.
+// (POST /links/{websiteDomain}-{relativeURL}/tl-languages)
+func (_ Unimplemented) AddLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete link TL language.
+// (DELETE /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
+func (_ Unimplemented) DeleteLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get link TL language.
+// (GET /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
+func (_ Unimplemented) GetLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update link TL language.
+// (PATCH /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
+func (_ Unimplemented) UpdateLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add or touch link tl language.
+// (PUT /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
+func (_ Unimplemented) PutLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search.
+// (GET /search)
+func (_ Unimplemented) Search(w http.ResponseWriter, r *http.Request, params SearchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List tag.
+// (GET /tags)
+func (_ Unimplemented) ListTag(w http.ResponseWriter, r *http.Request, params ListTagParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add tag.
+// (POST /tags)
+func (_ Unimplemented) AddTag(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List tag facet.
+// (GET /tags/facets)
+func (_ Unimplemented) ListTagFacet(w http.ResponseWriter, r *http.Request, params ListTagFacetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete tag.
+// (DELETE /tags/{namespace}/{slug})
+func (_ Unimplemented) DeleteTag(w http.ResponseWriter, r *http.Request, namespace string, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get tag.
+// (GET /tags/{namespace}/{slug})
+func (_ Unimplemented) GetTag(w http.ResponseWriter, r *http.Request, namespace string, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update tag.
+// (PATCH /tags/{namespace}/{slug})
+func (_ Unimplemented) UpdateTag(w http.ResponseWriter, r *http.Request, namespace string, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List trash.
+// (GET /trash)
+func (_ Unimplemented) ListTrash(w http.ResponseWriter, r *http.Request, params ListTrashParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List website.
+// (GET /websites)
+func (_ Unimplemented) ListWebsite(w http.ResponseWriter, r *http.Request, params ListWebsiteParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add website.
+// (POST /websites)
+func (_ Unimplemented) AddWebsite(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete website.
+// (DELETE /websites/{domain})
+func (_ Unimplemented) DeleteWebsite(w http.ResponseWriter, r *http.Request, domain string, params DeleteWebsiteParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get website.
+// (GET /websites/{domain})
+func (_ Unimplemented) GetWebsite(w http.ResponseWriter, r *http.Request, domain string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update website.
+// (PATCH /websites/{domain})
+func (_ Unimplemented) UpdateWebsite(w http.ResponseWriter, r *http.Request, domain string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List website link.
+// (GET /websites/{domain}/links)
+func (_ Unimplemented) ListWebsiteLink(w http.ResponseWriter, r *http.Request, domain string, params ListWebsiteLinkParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore website.
+// (POST /websites/{domain}/restore)
+func (_ Unimplemented) RestoreWebsite(w http.ResponseWriter, r *http.Request, domain string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List website tl language.
+// (GET /websites/{domain}/tl-languages)
+func (_ Unimplemented) ListWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, params ListWebsiteTLLanguageParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add website TL language.
+// (POST /websites/{domain}/tl-languages)
+func (_ Unimplemented) AddWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete website TL language.
+// (DELETE /websites/{domain}/tl-languages/{ietf})
+func (_ Unimplemented) DeleteWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get website TL language.
+// (GET /websites/{domain}/tl-languages/{ietf})
+func (_ Unimplemented) GetWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update website TL language.
+// (PATCH /websites/{domain}/tl-languages/{ietf})
+func (_ Unimplemented) UpdateWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListAuditEvent operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditEvent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEventParams
+
+	// ------------- Optional query parameter "entity" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity", r.URL.Query(), &params.Entity)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "operation" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation", r.URL.Query(), &params.Operation)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditEvent(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RunBatch operation middleware
+func (siw *ServerInterfaceWrapper) RunBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunBatch(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListComic operation middleware
+func (siw *ServerInterfaceWrapper) ListComic(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start_year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_year", r.URL.Query(), &params.StartYear)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_year", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_tag", r.URL.Query(), &params.ExcludeTag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag_match" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_match", r.URL.Query(), &params.TagMatch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_match", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_limit", r.URL.Query(), &params.IncludeLimit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComic(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddComic operation middleware
+func (siw *ServerInterfaceWrapper) AddComic(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddComic(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteComic operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComic(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteComic(w, r, code)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetComic operation middleware
+func (siw *ServerInterfaceWrapper) GetComic(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetComicParams
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_limit", r.URL.Query(), &params.IncludeLimit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComic(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateComic operation middleware
+func (siw *ServerInterfaceWrapper) UpdateComic(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateComicParams
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "include", r.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_limit", r.URL.Query(), &params.IncludeLimit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateComic(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListComicChapter operation middleware
+func (siw *ServerInterfaceWrapper) ListComicChapter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicChapterParams
+
+	// ------------- Optional query parameter "chapter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "chapter", r.URL.Query(), &params.Chapter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "chapter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "released_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "released_after", r.URL.Query(), &params.ReleasedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "released_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "released_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "released_before", r.URL.Query(), &params.ReleasedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "released_before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicChapter(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddComicChapter operation middleware
+func (siw *ServerInterfaceWrapper) AddComicChapter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddComicChapter(w, r, code)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteComicChapter operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComicChapter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv" -------------
+	var cv string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteComicChapter(w, r, code, cv)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetComicChapter operation middleware
+func (siw *ServerInterfaceWrapper) GetComicChapter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv" -------------
+	var cv string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComicChapter(w, r, code, cv)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateComicChapter operation middleware
+func (siw *ServerInterfaceWrapper) UpdateComicChapter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv" -------------
+	var cv string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateComicChapter(w, r, code, cv)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutComicChapter operation middleware
+func (siw *ServerInterfaceWrapper) PutComicChapter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv" -------------
+	var cv string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutComicChapter(w, r, code, cv)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListComicChapterLink operation middleware
+func (siw *ServerInterfaceWrapper) ListComicChapterLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv" -------------
+	var cv string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicChapterLinkParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicChapterLink(w, r, code, cv, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddComicChapterLink operation middleware
+func (siw *ServerInterfaceWrapper) AddComicChapterLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv" -------------
+	var cv string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddComicChapterLink(w, r, code, cv)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteComicChapterLink operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComicChapterLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv" -------------
+	var cv string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteComicChapterLink(w, r, code, cv, websiteDomain, relativeURL)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetComicChapterLink operation middleware
+func (siw *ServerInterfaceWrapper) GetComicChapterLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv" -------------
+	var cv string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComicChapterLink(w, r, code, cv, websiteDomain, relativeURL)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateComicChapterLink operation middleware
+func (siw *ServerInterfaceWrapper) UpdateComicChapterLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv" -------------
+	var cv string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateComicChapterLink(w, r, code, cv, websiteDomain, relativeURL)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutComicChapterLink operation middleware
+func (siw *ServerInterfaceWrapper) PutComicChapterLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv" -------------
+	var cv string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutComicChapterLink(w, r, code, cv, websiteDomain, relativeURL)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RestoreComicChapter operation middleware
+func (siw *ServerInterfaceWrapper) RestoreComicChapter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv" -------------
+	var cv string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreComicChapter(w, r, code, cv)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListComicCreator operation middleware
+func (siw *ServerInterfaceWrapper) ListComicCreator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicCreatorParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicCreator(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddComicCreator operation middleware
+func (siw *ServerInterfaceWrapper) AddComicCreator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddComicCreator(w, r, code)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteComicCreator operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComicCreator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "creatorSlug" -------------
+	var creatorSlug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "creatorSlug", runtime.ParamLocationPath, chi.URLParam(r, "creatorSlug"), &creatorSlug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "creatorSlug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "role" -------------
+	var role string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "role", runtime.ParamLocationPath, chi.URLParam(r, "role"), &role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteComicCreator(w, r, code, creatorSlug, role)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetComicCreator operation middleware
+func (siw *ServerInterfaceWrapper) GetComicCreator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "creatorSlug" -------------
+	var creatorSlug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "creatorSlug", runtime.ParamLocationPath, chi.URLParam(r, "creatorSlug"), &creatorSlug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "creatorSlug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "role" -------------
+	var role string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "role", runtime.ParamLocationPath, chi.URLParam(r, "role"), &role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComicCreator(w, r, code, creatorSlug, role)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateComicCreator operation middleware
+func (siw *ServerInterfaceWrapper) UpdateComicCreator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "creatorSlug" -------------
+	var creatorSlug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "creatorSlug", runtime.ParamLocationPath, chi.URLParam(r, "creatorSlug"), &creatorSlug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "creatorSlug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "role" -------------
+	var role string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "role", runtime.ParamLocationPath, chi.URLParam(r, "role"), &role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateComicCreator(w, r, code, creatorSlug, role)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListComicHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListComicHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicHistoryParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicHistory(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListComicLink operation middleware
+func (siw *ServerInterfaceWrapper) ListComicLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicLinkParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicLink(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddComicLink operation middleware
+func (siw *ServerInterfaceWrapper) AddComicLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddComicLink(w, r, code)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteComicLink operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComicLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteComicLink(w, r, code, websiteDomain, relativeURL)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetComicLink operation middleware
+func (siw *ServerInterfaceWrapper) GetComicLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComicLink(w, r, code, websiteDomain, relativeURL)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateComicLink operation middleware
+func (siw *ServerInterfaceWrapper) UpdateComicLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateComicLink(w, r, code, websiteDomain, relativeURL)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutComicLink operation middleware
+func (siw *ServerInterfaceWrapper) PutComicLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutComicLink(w, r, code, websiteDomain, relativeURL)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RestoreComic operation middleware
+func (siw *ServerInterfaceWrapper) RestoreComic(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreComic(w, r, code)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListComicTag operation middleware
+func (siw *ServerInterfaceWrapper) ListComicTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicTagParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicTag(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddComicTag operation middleware
+func (siw *ServerInterfaceWrapper) AddComicTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddComicTag(w, r, code)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteComicTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComicTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tagNamespace" -------------
+	var tagNamespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagNamespace", runtime.ParamLocationPath, chi.URLParam(r, "tagNamespace"), &tagNamespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagNamespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tagSlug" -------------
+	var tagSlug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagSlug", runtime.ParamLocationPath, chi.URLParam(r, "tagSlug"), &tagSlug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagSlug", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteComicTag(w, r, code, tagNamespace, tagSlug)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetComicTag operation middleware
+func (siw *ServerInterfaceWrapper) GetComicTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tagNamespace" -------------
+	var tagNamespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagNamespace", runtime.ParamLocationPath, chi.URLParam(r, "tagNamespace"), &tagNamespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagNamespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tagSlug" -------------
+	var tagSlug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagSlug", runtime.ParamLocationPath, chi.URLParam(r, "tagSlug"), &tagSlug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagSlug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComicTag(w, r, code, tagNamespace, tagSlug)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateComicTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateComicTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tagNamespace" -------------
+	var tagNamespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagNamespace", runtime.ParamLocationPath, chi.URLParam(r, "tagNamespace"), &tagNamespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagNamespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tagSlug" -------------
+	var tagSlug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "tagSlug", runtime.ParamLocationPath, chi.URLParam(r, "tagSlug"), &tagSlug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagSlug", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateComicTag(w, r, code, tagNamespace, tagSlug)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListComicTitle operation middleware
+func (siw *ServerInterfaceWrapper) ListComicTitle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComicTitleParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListComicTitle(w, r, code, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddComicTitle operation middleware
+func (siw *ServerInterfaceWrapper) AddComicTitle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddComicTitle(w, r, code)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteComicTitle operation middleware
+func (siw *ServerInterfaceWrapper) DeleteComicTitle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rid" -------------
+	var rid string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "rid", runtime.ParamLocationPath, chi.URLParam(r, "rid"), &rid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteComicTitle(w, r, code, rid)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetComicTitle operation middleware
+func (siw *ServerInterfaceWrapper) GetComicTitle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rid" -------------
+	var rid string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "rid", runtime.ParamLocationPath, chi.URLParam(r, "rid"), &rid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rid", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetComicTitle(w, r, code, rid)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateComicTitle operation middleware
+func (siw *ServerInterfaceWrapper) UpdateComicTitle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "rid" -------------
+	var rid string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "rid", runtime.ParamLocationPath, chi.URLParam(r, "rid"), &rid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateComicTitle(w, r, code, rid)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListCreator operation middleware
+func (siw *ServerInterfaceWrapper) ListCreator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCreatorParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCreator(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddCreator operation middleware
+func (siw *ServerInterfaceWrapper) AddCreator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddCreator(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteCreator operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCreator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "slug", runtime.ParamLocationPath, chi.URLParam(r, "slug"), &slug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCreator(w, r, slug)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCreator operation middleware
+func (siw *ServerInterfaceWrapper) GetCreator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "slug", runtime.ParamLocationPath, chi.URLParam(r, "slug"), &slug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCreator(w, r, slug)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateCreator operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCreator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "slug", runtime.ParamLocationPath, chi.URLParam(r, "slug"), &slug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCreator(w, r, slug)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListCreatorComic operation middleware
+func (siw *ServerInterfaceWrapper) ListCreatorComic(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "slug", runtime.ParamLocationPath, chi.URLParam(r, "slug"), &slug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCreatorComicParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCreatorComic(w, r, slug, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddCreatorLink operation middleware
+func (siw *ServerInterfaceWrapper) AddCreatorLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "slug", runtime.ParamLocationPath, chi.URLParam(r, "slug"), &slug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddCreatorLink(w, r, slug)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteCreatorLink operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCreatorLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "slug", runtime.ParamLocationPath, chi.URLParam(r, "slug"), &slug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCreatorLink(w, r, slug, websiteDomain, relativeURL)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCreatorLink operation middleware
+func (siw *ServerInterfaceWrapper) GetCreatorLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "slug", runtime.ParamLocationPath, chi.URLParam(r, "slug"), &slug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCreatorLink(w, r, slug, websiteDomain, relativeURL)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateCreatorLink operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCreatorLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "slug", runtime.ParamLocationPath, chi.URLParam(r, "slug"), &slug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCreatorLink(w, r, slug, websiteDomain, relativeURL)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListLanguage operation middleware
+func (siw *ServerInterfaceWrapper) ListLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLanguageParams
+
+	// ------------- Optional query parameter "ietf" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ietf", r.URL.Query(), &params.IETF)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_before", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLanguage(w, r, params)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddLanguage operation middleware
+func (siw *ServerInterfaceWrapper) AddLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddLanguage(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteLanguage operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
 
-// AddWebsiteFormdataRequestBody defines body for AddWebsite for application/x-www-form-urlencoded ContentType.
-type AddWebsiteFormdataRequestBody = NewWebsite
+	// ------------- Path parameter "ietf" -------------
+	var ietf string
 
-// UpdateWebsiteJSONRequestBody defines body for UpdateWebsite for application/json ContentType.
-type UpdateWebsiteJSONRequestBody = SetWebsite
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		return
+	}
 
-// UpdateWebsiteFormdataRequestBody defines body for UpdateWebsite for application/x-www-form-urlencoded ContentType.
-type UpdateWebsiteFormdataRequestBody = SetWebsite
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// AddWebsiteTLLanguageJSONRequestBody defines body for AddWebsiteTLLanguage for application/json ContentType.
-type AddWebsiteTLLanguageJSONRequestBody = NewWebsiteTLLanguage
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteLanguageParams
 
-// AddWebsiteTLLanguageFormdataRequestBody defines body for AddWebsiteTLLanguage for application/x-www-form-urlencoded ContentType.
-type AddWebsiteTLLanguageFormdataRequestBody = NewWebsiteTLLanguage
+	// ------------- Optional query parameter "dry_run" -------------
 
-// UpdateWebsiteTLLanguageJSONRequestBody defines body for UpdateWebsiteTLLanguage for application/json ContentType.
-type UpdateWebsiteTLLanguageJSONRequestBody = SetWebsiteTLLanguage
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
 
-// UpdateWebsiteTLLanguageFormdataRequestBody defines body for UpdateWebsiteTLLanguage for application/x-www-form-urlencoded ContentType.
-type UpdateWebsiteTLLanguageFormdataRequestBody = SetWebsiteTLLanguage
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLanguage(w, r, ietf, params)
+	}))
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// List comic.
-	// (GET /comics)
-	ListComic(w http.ResponseWriter, r *http.Request, params ListComicParams)
-	// Add comic.
-	// (POST /comics)
-	AddComic(w http.ResponseWriter, r *http.Request)
-	// Delete comic.
-	// (DELETE /comics/{code})
-	DeleteComic(w http.ResponseWriter, r *http.Request, code string)
-	// Get comic.
-	// (GET /comics/{code})
-	GetComic(w http.ResponseWriter, r *http.Request, code string)
-	// Update comic.
-	// (PATCH /comics/{code})
-	UpdateComic(w http.ResponseWriter, r *http.Request, code string)
-	// List comic chapter.
-	// (GET /comics/{code}/chapters)
-	ListComicChapter(w http.ResponseWriter, r *http.Request, code string, params ListComicChapterParams)
-	// Add comic chapter.
-	// (POST /comics/{code}/chapters)
-	AddComicChapter(w http.ResponseWriter, r *http.Request, code string)
-	// Delete comic chapter.
-	// (DELETE /comics/{code}/chapters/{cv})
-	DeleteComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string)
-	// Get comic chapter.
-	// (GET /comics/{code}/chapters/{cv})
-	GetComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string)
-	// Update comic chapter.
-	// (PATCH /comics/{code}/chapters/{cv})
-	UpdateComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string)
-	// Add comic chapter link.
-	// (POST /comics/{code}/chapters/{cv}/links)
-	AddComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string)
-	// Delete comic chapter link.
-	// (DELETE /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
-	DeleteComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string)
-	// Get comic chapter link.
-	// (GET /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
-	GetComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string)
-	// Update comic chapter link.
-	// (PATCH /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
-	UpdateComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string)
-	// Add comic link.
-	// (POST /comics/{code}/links)
-	AddComicLink(w http.ResponseWriter, r *http.Request, code string)
-	// Delete comic link.
-	// (DELETE /comics/{code}/links/{websiteDomain}-{relativeURL})
-	DeleteComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string)
-	// Get comic link.
-	// (GET /comics/{code}/links/{websiteDomain}-{relativeURL})
-	GetComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string)
-	// Update comic link.
-	// (PATCH /comics/{code}/links/{websiteDomain}-{relativeURL})
-	UpdateComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string)
-	// List language.
-	// (GET /languages)
-	ListLanguage(w http.ResponseWriter, r *http.Request, params ListLanguageParams)
-	// Add language.
-	// (POST /languages)
-	AddLanguage(w http.ResponseWriter, r *http.Request)
-	// Delete language.
-	// (DELETE /languages/{ietf})
-	DeleteLanguage(w http.ResponseWriter, r *http.Request, ietf string)
-	// Get language.
-	// (GET /languages/{ietf})
-	GetLanguage(w http.ResponseWriter, r *http.Request, ietf string)
-	// Update language.
-	// (PATCH /languages/{ietf})
-	UpdateLanguage(w http.ResponseWriter, r *http.Request, ietf string)
-	// List link.
-	// (GET /links)
-	ListLink(w http.ResponseWriter, r *http.Request, params ListLinkParams)
-	// Add link.
-	// (POST /links)
-	AddLink(w http.ResponseWriter, r *http.Request)
-	// Delete link.
-	// (DELETE /links/{websiteDomain}-{relativeURL})
-	DeleteLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string)
-	// Get link.
-	// (GET /links/{websiteDomain}-{relativeURL})
-	GetLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string)
-	// Update link.
-	// (PATCH /links/{websiteDomain}-{relativeURL})
-	UpdateLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string)
-	// Add link TL language.
-	// (POST /links/{websiteDomain}-{relativeURL}/tl-languages)
-	AddLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string)
-	// Delete link TL language.
-	// (DELETE /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
-	DeleteLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string)
-	// Get link TL language.
-	// (GET /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
-	GetLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string)
-	// Update link TL language.
-	// (PATCH /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
-	UpdateLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string)
-	// List website.
-	// (GET /websites)
-	ListWebsite(w http.ResponseWriter, r *http.Request, params ListWebsiteParams)
-	// Add website.
-	// (POST /websites)
-	AddWebsite(w http.ResponseWriter, r *http.Request)
-	// Delete website.
-	// (DELETE /websites/{domain})
-	DeleteWebsite(w http.ResponseWriter, r *http.Request, domain string)
-	// Get website.
-	// (GET /websites/{domain})
-	GetWebsite(w http.ResponseWriter, r *http.Request, domain string)
-	// Update website.
-	// (PATCH /websites/{domain})
-	UpdateWebsite(w http.ResponseWriter, r *http.Request, domain string)
-	// Add website TL language.
-	// (POST /websites/{domain}/tl-languages)
-	AddWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string)
-	// Delete website TL language.
-	// (DELETE /websites/{domain}/tl-languages/{ietf})
-	DeleteWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string)
-	// Get website TL language.
-	// (GET /websites/{domain}/tl-languages/{ietf})
-	GetWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string)
-	// Update website TL language.
-	// (PATCH /websites/{domain}/tl-languages/{ietf})
-	UpdateWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string)
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// GetLanguage operation middleware
+func (siw *ServerInterfaceWrapper) GetLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type Unimplemented struct{}
+	var err error
 
-// List comic.
-// (GET /comics)
-func (_ Unimplemented) ListComic(w http.ResponseWriter, r *http.Request, params ListComicParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// ------------- Path parameter "ietf" -------------
+	var ietf string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLanguage(w, r, ietf)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Add comic.
-// (POST /comics)
-func (_ Unimplemented) AddComic(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// UpdateLanguage operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ietf" -------------
+	var ietf string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateLanguage(w, r, ietf)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Delete comic.
-// (DELETE /comics/{code})
-func (_ Unimplemented) DeleteComic(w http.ResponseWriter, r *http.Request, code string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ListLanguageLink operation middleware
+func (siw *ServerInterfaceWrapper) ListLanguageLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ietf" -------------
+	var ietf string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLanguageLinkParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLanguageLink(w, r, ietf, params)
+	}))
 
-// Get comic.
-// (GET /comics/{code})
-func (_ Unimplemented) GetComic(w http.ResponseWriter, r *http.Request, code string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-// Update comic.
-// (PATCH /comics/{code})
-func (_ Unimplemented) UpdateComic(w http.ResponseWriter, r *http.Request, code string) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// List comic chapter.
-// (GET /comics/{code}/chapters)
-func (_ Unimplemented) ListComicChapter(w http.ResponseWriter, r *http.Request, code string, params ListComicChapterParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// RestoreLanguage operation middleware
+func (siw *ServerInterfaceWrapper) RestoreLanguage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// Add comic chapter.
-// (POST /comics/{code}/chapters)
-func (_ Unimplemented) AddComicChapter(w http.ResponseWriter, r *http.Request, code string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// Delete comic chapter.
-// (DELETE /comics/{code}/chapters/{cv})
-func (_ Unimplemented) DeleteComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "ietf" -------------
+	var ietf string
 
-// Get comic chapter.
-// (GET /comics/{code}/chapters/{cv})
-func (_ Unimplemented) GetComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		return
+	}
 
-// Update comic chapter.
-// (PATCH /comics/{code}/chapters/{cv})
-func (_ Unimplemented) UpdateComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// Add comic chapter link.
-// (POST /comics/{code}/chapters/{cv}/links)
-func (_ Unimplemented) AddComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreLanguage(w, r, ietf)
+	}))
 
-// Delete comic chapter link.
-// (DELETE /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
-func (_ Unimplemented) DeleteComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-// Get comic chapter link.
-// (GET /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
-func (_ Unimplemented) GetComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Update comic chapter link.
-// (PATCH /comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL})
-func (_ Unimplemented) UpdateComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ListLanguageWebsite operation middleware
+func (siw *ServerInterfaceWrapper) ListLanguageWebsite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// Add comic link.
-// (POST /comics/{code}/links)
-func (_ Unimplemented) AddComicLink(w http.ResponseWriter, r *http.Request, code string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// Delete comic link.
-// (DELETE /comics/{code}/links/{websiteDomain}-{relativeURL})
-func (_ Unimplemented) DeleteComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Path parameter "ietf" -------------
+	var ietf string
 
-// Get comic link.
-// (GET /comics/{code}/links/{websiteDomain}-{relativeURL})
-func (_ Unimplemented) GetComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		return
+	}
 
-// Update comic link.
-// (PATCH /comics/{code}/links/{websiteDomain}-{relativeURL})
-func (_ Unimplemented) UpdateComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLanguageWebsiteParams
 
-// List language.
-// (GET /languages)
-func (_ Unimplemented) ListLanguage(w http.ResponseWriter, r *http.Request, params ListLanguageParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "count" -------------
 
-// Add language.
-// (POST /languages)
-func (_ Unimplemented) AddLanguage(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
 
-// Delete language.
-// (DELETE /languages/{ietf})
-func (_ Unimplemented) DeleteLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "page" -------------
 
-// Get language.
-// (GET /languages/{ietf})
-func (_ Unimplemented) GetLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-// Update language.
-// (PATCH /languages/{ietf})
-func (_ Unimplemented) UpdateLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-// List link.
-// (GET /links)
-func (_ Unimplemented) ListLink(w http.ResponseWriter, r *http.Request, params ListLinkParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-// Add link.
-// (POST /links)
-func (_ Unimplemented) AddLink(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "order_by" -------------
 
-// Delete link.
-// (DELETE /links/{websiteDomain}-{relativeURL})
-func (_ Unimplemented) DeleteLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
 
-// Get link.
-// (GET /links/{websiteDomain}-{relativeURL})
-func (_ Unimplemented) GetLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLanguageWebsite(w, r, ietf, params)
+	}))
 
-// Update link.
-// (PATCH /links/{websiteDomain}-{relativeURL})
-func (_ Unimplemented) UpdateLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
 
-// Add link TL language.
-// (POST /links/{websiteDomain}-{relativeURL}/tl-languages)
-func (_ Unimplemented) AddLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
-	w.WriteHeader(http.StatusNotImplemented)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Delete link TL language.
-// (DELETE /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
-func (_ Unimplemented) DeleteLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ListLink operation middleware
+func (siw *ServerInterfaceWrapper) ListLink(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// Get link TL language.
-// (GET /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
-func (_ Unimplemented) GetLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	var err error
 
-// Update link TL language.
-// (PATCH /links/{websiteDomain}-{relativeURL}/tl-languages/{ietf})
-func (_ Unimplemented) UpdateLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLinkParams
 
-// List website.
-// (GET /websites)
-func (_ Unimplemented) ListWebsite(w http.ResponseWriter, r *http.Request, params ListWebsiteParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "website_domain" -------------
 
-// Add website.
-// (POST /websites)
-func (_ Unimplemented) AddWebsite(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "website_domain", r.URL.Query(), &params.WebsiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "website_domain", Err: err})
+		return
+	}
 
-// Delete website.
-// (DELETE /websites/{domain})
-func (_ Unimplemented) DeleteWebsite(w http.ResponseWriter, r *http.Request, domain string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "relative_url" -------------
 
-// Get website.
-// (GET /websites/{domain})
-func (_ Unimplemented) GetWebsite(w http.ResponseWriter, r *http.Request, domain string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "relative_url", r.URL.Query(), &params.RelativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relative_url", Err: err})
+		return
+	}
 
-// Update website.
-// (PATCH /websites/{domain})
-func (_ Unimplemented) UpdateWebsite(w http.ResponseWriter, r *http.Request, domain string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "machine_tl" -------------
 
-// Add website TL language.
-// (POST /websites/{domain}/tl-languages)
-func (_ Unimplemented) AddWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "machine_tl", r.URL.Query(), &params.MachineTL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "machine_tl", Err: err})
+		return
+	}
 
-// Delete website TL language.
-// (DELETE /websites/{domain}/tl-languages/{ietf})
-func (_ Unimplemented) DeleteWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "created_after" -------------
 
-// Get website TL language.
-// (GET /websites/{domain}/tl-languages/{ietf})
-func (_ Unimplemented) GetWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
+	}
 
-// Update website TL language.
-// (PATCH /websites/{domain}/tl-languages/{ietf})
-func (_ Unimplemented) UpdateWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, ietf string) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional query parameter "created_before" -------------
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	err = runtime.BindQueryParameter("form", true, false, "created_before", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_before", Err: err})
+		return
+	}
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	// ------------- Optional query parameter "count" -------------
 
-// ListComic operation middleware
-func (siw *ServerInterfaceWrapper) ListComic(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "cursor" -------------
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListComicParams
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -839,7 +5124,7 @@ func (siw *ServerInterfaceWrapper) ListComic(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListComic(w, r, params)
+		siw.Handler.ListLink(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -849,14 +5134,14 @@ func (siw *ServerInterfaceWrapper) ListComic(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddComic operation middleware
-func (siw *ServerInterfaceWrapper) AddComic(w http.ResponseWriter, r *http.Request) {
+// AddLink operation middleware
+func (siw *ServerInterfaceWrapper) AddLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddComic(w, r)
+		siw.Handler.AddLink(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -866,25 +5151,32 @@ func (siw *ServerInterfaceWrapper) AddComic(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteComic operation middleware
-func (siw *ServerInterfaceWrapper) DeleteComic(w http.ResponseWriter, r *http.Request) {
+// ResolveLink operation middleware
+func (siw *ServerInterfaceWrapper) ResolveLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResolveLinkParams
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+	// ------------- Required query parameter "url" -------------
+
+	if paramValue := r.URL.Query().Get("url"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "url"})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, true, "url", r.URL.Query(), &params.URL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "url", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteComic(w, r, code)
+		siw.Handler.ResolveLink(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -894,23 +5186,34 @@ func (siw *ServerInterfaceWrapper) DeleteComic(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetComic operation middleware
-func (siw *ServerInterfaceWrapper) GetComic(w http.ResponseWriter, r *http.Request) {
+// DeleteLink operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetComic(w, r, code)
+		siw.Handler.DeleteLink(w, r, websiteDomain, relativeURL)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -920,25 +5223,32 @@ func (siw *ServerInterfaceWrapper) GetComic(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateComic operation middleware
-func (siw *ServerInterfaceWrapper) UpdateComic(w http.ResponseWriter, r *http.Request) {
+// GetLink operation middleware
+func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateComic(w, r, code)
+		siw.Handler.GetLink(w, r, websiteDomain, relativeURL)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -948,50 +5258,34 @@ func (siw *ServerInterfaceWrapper) UpdateComic(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListComicChapter operation middleware
-func (siw *ServerInterfaceWrapper) ListComicChapter(w http.ResponseWriter, r *http.Request) {
+// UpdateLink operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
-		return
-	}
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListComicChapterParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "order_by" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListComicChapter(w, r, code, params)
+		siw.Handler.UpdateLink(w, r, websiteDomain, relativeURL)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1001,25 +5295,34 @@ func (siw *ServerInterfaceWrapper) ListComicChapter(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddComicChapter operation middleware
-func (siw *ServerInterfaceWrapper) AddComicChapter(w http.ResponseWriter, r *http.Request) {
+// PutLink operation middleware
+func (siw *ServerInterfaceWrapper) PutLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddComicChapter(w, r, code)
+		siw.Handler.PutLink(w, r, websiteDomain, relativeURL)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1029,69 +5332,67 @@ func (siw *ServerInterfaceWrapper) AddComicChapter(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteComicChapter operation middleware
-func (siw *ServerInterfaceWrapper) DeleteComicChapter(w http.ResponseWriter, r *http.Request) {
+// ListLinkComicChapter operation middleware
+func (siw *ServerInterfaceWrapper) ListLinkComicChapter(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "cv" -------------
-	var cv string
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLinkComicChapterParams
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteComicChapter(w, r, code, cv)
-	}))
+	// ------------- Optional query parameter "count" -------------
 
-	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
-		handler = siw.HandlerMiddlewares[i](handler)
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// GetComicChapter operation middleware
-func (siw *ServerInterfaceWrapper) GetComicChapter(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Optional query parameter "page" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-	// ------------- Path parameter "code" -------------
-	var code string
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "cv" -------------
-	var cv string
+	// ------------- Optional query parameter "order_by" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetComicChapter(w, r, code, cv)
+		siw.Handler.ListLinkComicChapter(w, r, websiteDomain, relativeURL, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1101,34 +5402,67 @@ func (siw *ServerInterfaceWrapper) GetComicChapter(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateComicChapter operation middleware
-func (siw *ServerInterfaceWrapper) UpdateComicChapter(w http.ResponseWriter, r *http.Request) {
+// ListLinkComic operation middleware
+func (siw *ServerInterfaceWrapper) ListLinkComic(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLinkComicParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "cv" -------------
-	var cv string
+	// ------------- Optional query parameter "order_by" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateComicChapter(w, r, code, cv)
+		siw.Handler.ListLinkComic(w, r, websiteDomain, relativeURL, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1138,34 +5472,34 @@ func (siw *ServerInterfaceWrapper) UpdateComicChapter(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddComicChapterLink operation middleware
-func (siw *ServerInterfaceWrapper) AddComicChapterLink(w http.ResponseWriter, r *http.Request) {
+// RestoreLink operation middleware
+func (siw *ServerInterfaceWrapper) RestoreLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "cv" -------------
-	var cv string
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddComicChapterLink(w, r, code, cv)
+		siw.Handler.RestoreLink(w, r, websiteDomain, relativeURL)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1175,52 +5509,67 @@ func (siw *ServerInterfaceWrapper) AddComicChapterLink(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteComicChapterLink operation middleware
-func (siw *ServerInterfaceWrapper) DeleteComicChapterLink(w http.ResponseWriter, r *http.Request) {
+// ListLinkTLLanguage operation middleware
+func (siw *ServerInterfaceWrapper) ListLinkTLLanguage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "cv" -------------
-	var cv string
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "websiteDomain" -------------
-	var websiteDomain string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLinkTLLanguageParams
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "relativeURL" -------------
-	var relativeURL string
+	// ------------- Optional query parameter "page" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteComicChapterLink(w, r, code, cv, websiteDomain, relativeURL)
+		siw.Handler.ListLinkTLLanguage(w, r, websiteDomain, relativeURL, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1230,30 +5579,12 @@ func (siw *ServerInterfaceWrapper) DeleteComicChapterLink(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetComicChapterLink operation middleware
-func (siw *ServerInterfaceWrapper) GetComicChapterLink(w http.ResponseWriter, r *http.Request) {
+// AddLinkTLLanguage operation middleware
+func (siw *ServerInterfaceWrapper) AddLinkTLLanguage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "cv" -------------
-	var cv string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
-		return
-	}
-
 	// ------------- Path parameter "websiteDomain" -------------
 	var websiteDomain string
 
@@ -1272,8 +5603,10 @@ func (siw *ServerInterfaceWrapper) GetComicChapterLink(w http.ResponseWriter, r
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetComicChapterLink(w, r, code, cv, websiteDomain, relativeURL)
+		siw.Handler.AddLinkTLLanguage(w, r, websiteDomain, relativeURL)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1283,30 +5616,12 @@ func (siw *ServerInterfaceWrapper) GetComicChapterLink(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateComicChapterLink operation middleware
-func (siw *ServerInterfaceWrapper) UpdateComicChapterLink(w http.ResponseWriter, r *http.Request) {
+// DeleteLinkTLLanguage operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLinkTLLanguage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "cv" -------------
-	var cv string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "cv", runtime.ParamLocationPath, chi.URLParam(r, "cv"), &cv)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv", Err: err})
-		return
-	}
-
 	// ------------- Path parameter "websiteDomain" -------------
 	var websiteDomain string
 
@@ -1325,10 +5640,19 @@ func (siw *ServerInterfaceWrapper) UpdateComicChapterLink(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "ietf" -------------
+	var ietf string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateComicChapterLink(w, r, code, cv, websiteDomain, relativeURL)
+		siw.Handler.DeleteLinkTLLanguage(w, r, websiteDomain, relativeURL, ietf)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1338,25 +5662,41 @@ func (siw *ServerInterfaceWrapper) UpdateComicChapterLink(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddComicLink operation middleware
-func (siw *ServerInterfaceWrapper) AddComicLink(w http.ResponseWriter, r *http.Request) {
+// GetLinkTLLanguage operation middleware
+func (siw *ServerInterfaceWrapper) GetLinkTLLanguage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
+	// ------------- Path parameter "websiteDomain" -------------
+	var websiteDomain string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Path parameter "relativeURL" -------------
+	var relativeURL string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "ietf" -------------
+	var ietf string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddComicLink(w, r, code)
+		siw.Handler.GetLinkTLLanguage(w, r, websiteDomain, relativeURL, ietf)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1366,21 +5706,12 @@ func (siw *ServerInterfaceWrapper) AddComicLink(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteComicLink operation middleware
-func (siw *ServerInterfaceWrapper) DeleteComicLink(w http.ResponseWriter, r *http.Request) {
+// UpdateLinkTLLanguage operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLinkTLLanguage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
-		return
-	}
-
 	// ------------- Path parameter "websiteDomain" -------------
 	var websiteDomain string
 
@@ -1399,10 +5730,19 @@ func (siw *ServerInterfaceWrapper) DeleteComicLink(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// ------------- Path parameter "ietf" -------------
+	var ietf string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteComicLink(w, r, code, websiteDomain, relativeURL)
+		siw.Handler.UpdateLinkTLLanguage(w, r, websiteDomain, relativeURL, ietf)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1412,21 +5752,12 @@ func (siw *ServerInterfaceWrapper) DeleteComicLink(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetComicLink operation middleware
-func (siw *ServerInterfaceWrapper) GetComicLink(w http.ResponseWriter, r *http.Request) {
+// PutLinkTLLanguage operation middleware
+func (siw *ServerInterfaceWrapper) PutLinkTLLanguage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
-		return
-	}
-
 	// ------------- Path parameter "websiteDomain" -------------
 	var websiteDomain string
 
@@ -1445,8 +5776,19 @@ func (siw *ServerInterfaceWrapper) GetComicLink(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Path parameter "ietf" -------------
+	var ietf string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetComicLink(w, r, code, websiteDomain, relativeURL)
+		siw.Handler.PutLinkTLLanguage(w, r, websiteDomain, relativeURL, ietf)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1456,43 +5798,64 @@ func (siw *ServerInterfaceWrapper) GetComicLink(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateComicLink operation middleware
-func (siw *ServerInterfaceWrapper) UpdateComicLink(w http.ResponseWriter, r *http.Request) {
+// Search operation middleware
+func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "code" -------------
-	var code string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchParams
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "websiteDomain" -------------
-	var websiteDomain string
+	// ------------- Optional query parameter "kind" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "relativeURL" -------------
-	var relativeURL string
+	// ------------- Optional query parameter "count" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateComicLink(w, r, code, websiteDomain, relativeURL)
+		siw.Handler.Search(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1502,14 +5865,38 @@ func (siw *ServerInterfaceWrapper) UpdateComicLink(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListLanguage operation middleware
-func (siw *ServerInterfaceWrapper) ListLanguage(w http.ResponseWriter, r *http.Request) {
+// ListTag operation middleware
+func (siw *ServerInterfaceWrapper) ListTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLanguageParams
+	var params ListTagParams
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", r.URL.Query(), &params.Namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -1536,7 +5923,7 @@ func (siw *ServerInterfaceWrapper) ListLanguage(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListLanguage(w, r, params)
+		siw.Handler.ListTag(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1546,14 +5933,14 @@ func (siw *ServerInterfaceWrapper) ListLanguage(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddLanguage operation middleware
-func (siw *ServerInterfaceWrapper) AddLanguage(w http.ResponseWriter, r *http.Request) {
+// AddTag operation middleware
+func (siw *ServerInterfaceWrapper) AddTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddLanguage(w, r)
+		siw.Handler.AddTag(w, r)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1563,97 +5950,55 @@ func (siw *ServerInterfaceWrapper) AddLanguage(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteLanguage operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLanguage(w http.ResponseWriter, r *http.Request) {
+// ListTagFacet operation middleware
+func (siw *ServerInterfaceWrapper) ListTagFacet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "ietf" -------------
-	var ietf string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTagFacetParams
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", r.URL.Query(), &params.Namespace)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLanguage(w, r, ietf)
-	}))
-
-	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
-		handler = siw.HandlerMiddlewares[i](handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// GetLanguage operation middleware
-func (siw *ServerInterfaceWrapper) GetLanguage(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
-
-	// ------------- Path parameter "ietf" -------------
-	var ietf string
+	// ------------- Optional query parameter "tag" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLanguage(w, r, ietf)
-	}))
+	// ------------- Optional query parameter "exclude_tag" -------------
 
-	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
-		handler = siw.HandlerMiddlewares[i](handler)
+	err = runtime.BindQueryParameter("form", true, false, "exclude_tag", r.URL.Query(), &params.ExcludeTag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_tag", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// UpdateLanguage operation middleware
-func (siw *ServerInterfaceWrapper) UpdateLanguage(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
-
-	// ------------- Path parameter "ietf" -------------
-	var ietf string
+	// ------------- Optional query parameter "tag_match" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	err = runtime.BindQueryParameter("form", true, false, "tag_match", r.URL.Query(), &params.TagMatch)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag_match", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateLanguage(w, r, ietf)
-	}))
+	// ------------- Optional query parameter "count" -------------
 
-	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
-		handler = siw.HandlerMiddlewares[i](handler)
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// ListLink operation middleware
-func (siw *ServerInterfaceWrapper) ListLink(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLinkParams
-
 	// ------------- Optional query parameter "page" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
@@ -1679,7 +6024,7 @@ func (siw *ServerInterfaceWrapper) ListLink(w http.ResponseWriter, r *http.Reque
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListLink(w, r, params)
+		siw.Handler.ListTagFacet(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1689,14 +6034,34 @@ func (siw *ServerInterfaceWrapper) ListLink(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddLink operation middleware
-func (siw *ServerInterfaceWrapper) AddLink(w http.ResponseWriter, r *http.Request) {
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, chi.URLParam(r, "namespace"), &namespace)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "slug", runtime.ParamLocationPath, chi.URLParam(r, "slug"), &slug)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddLink(w, r)
+		siw.Handler.DeleteTag(w, r, namespace, slug)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1706,34 +6071,32 @@ func (siw *ServerInterfaceWrapper) AddLink(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteLink operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Request) {
+// GetTag operation middleware
+func (siw *ServerInterfaceWrapper) GetTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "websiteDomain" -------------
-	var websiteDomain string
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, chi.URLParam(r, "namespace"), &namespace)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "relativeURL" -------------
-	var relativeURL string
+	// ------------- Path parameter "slug" -------------
+	var slug string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "slug", runtime.ParamLocationPath, chi.URLParam(r, "slug"), &slug)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLink(w, r, websiteDomain, relativeURL)
+		siw.Handler.GetTag(w, r, namespace, slug)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1743,32 +6106,34 @@ func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLink operation middleware
-func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Request) {
+// UpdateTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTag(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "websiteDomain" -------------
-	var websiteDomain string
+	// ------------- Path parameter "namespace" -------------
+	var namespace string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "namespace", runtime.ParamLocationPath, chi.URLParam(r, "namespace"), &namespace)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "namespace", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "relativeURL" -------------
-	var relativeURL string
+	// ------------- Path parameter "slug" -------------
+	var slug string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "slug", runtime.ParamLocationPath, chi.URLParam(r, "slug"), &slug)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLink(w, r, websiteDomain, relativeURL)
+		siw.Handler.UpdateTag(w, r, namespace, slug)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1778,34 +6143,51 @@ func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateLink operation middleware
-func (siw *ServerInterfaceWrapper) UpdateLink(w http.ResponseWriter, r *http.Request) {
+// ListTrash operation middleware
+func (siw *ServerInterfaceWrapper) ListTrash(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "websiteDomain" -------------
-	var websiteDomain string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTrashParams
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "kind", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "relativeURL" -------------
-	var relativeURL string
+	// ------------- Optional query parameter "count" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateLink(w, r, websiteDomain, relativeURL)
+		siw.Handler.ListTrash(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1815,80 +6197,97 @@ func (siw *ServerInterfaceWrapper) UpdateLink(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddLinkTLLanguage operation middleware
-func (siw *ServerInterfaceWrapper) AddLinkTLLanguage(w http.ResponseWriter, r *http.Request) {
+// ListWebsite operation middleware
+func (siw *ServerInterfaceWrapper) ListWebsite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "websiteDomain" -------------
-	var websiteDomain string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebsiteParams
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	// ------------- Optional query parameter "domain" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "domain", r.URL.Query(), &params.Domain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "relativeURL" -------------
-	var relativeURL string
+	// ------------- Optional query parameter "name" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "machine_tl" -------------
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddLinkTLLanguage(w, r, websiteDomain, relativeURL)
-	}))
+	err = runtime.BindQueryParameter("form", true, false, "machine_tl", r.URL.Query(), &params.MachineTL)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "machine_tl", Err: err})
+		return
+	}
 
-	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
-		handler = siw.HandlerMiddlewares[i](handler)
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "created_before" -------------
 
-// DeleteLinkTLLanguage operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLinkTLLanguage(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, false, "created_before", r.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_before", Err: err})
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "count" -------------
 
-	// ------------- Path parameter "websiteDomain" -------------
-	var websiteDomain string
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "relativeURL" -------------
-	var relativeURL string
+	// ------------- Optional query parameter "page" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "ietf" -------------
-	var ietf string
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLinkTLLanguage(w, r, websiteDomain, relativeURL, ietf)
+		siw.Handler.ListWebsite(w, r, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1898,41 +6297,53 @@ func (siw *ServerInterfaceWrapper) DeleteLinkTLLanguage(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLinkTLLanguage operation middleware
-func (siw *ServerInterfaceWrapper) GetLinkTLLanguage(w http.ResponseWriter, r *http.Request) {
+// AddWebsite operation middleware
+func (siw *ServerInterfaceWrapper) AddWebsite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddWebsite(w, r)
+	}))
+
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteWebsite operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebsite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "websiteDomain" -------------
-	var websiteDomain string
+	// ------------- Path parameter "domain" -------------
+	var domain string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "domain", runtime.ParamLocationPath, chi.URLParam(r, "domain"), &domain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "relativeURL" -------------
-	var relativeURL string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
-		return
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteWebsiteParams
 
-	// ------------- Path parameter "ietf" -------------
-	var ietf string
+	// ------------- Optional query parameter "dry_run" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLinkTLLanguage(w, r, websiteDomain, relativeURL, ietf)
+		siw.Handler.DeleteWebsite(w, r, domain, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1942,43 +6353,51 @@ func (siw *ServerInterfaceWrapper) GetLinkTLLanguage(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateLinkTLLanguage operation middleware
-func (siw *ServerInterfaceWrapper) UpdateLinkTLLanguage(w http.ResponseWriter, r *http.Request) {
+// GetWebsite operation middleware
+func (siw *ServerInterfaceWrapper) GetWebsite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "websiteDomain" -------------
-	var websiteDomain string
+	// ------------- Path parameter "domain" -------------
+	var domain string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "websiteDomain", runtime.ParamLocationPath, chi.URLParam(r, "websiteDomain"), &websiteDomain)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "domain", runtime.ParamLocationPath, chi.URLParam(r, "domain"), &domain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "websiteDomain", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "relativeURL" -------------
-	var relativeURL string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebsite(w, r, domain)
+	}))
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "relativeURL", runtime.ParamLocationPath, chi.URLParam(r, "relativeURL"), &relativeURL)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relativeURL", Err: err})
-		return
+	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = siw.HandlerMiddlewares[i](handler)
 	}
 
-	// ------------- Path parameter "ietf" -------------
-	var ietf string
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "ietf", runtime.ParamLocationPath, chi.URLParam(r, "ietf"), &ietf)
+// UpdateWebsite operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebsite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "domain" -------------
+	var domain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "domain", runtime.ParamLocationPath, chi.URLParam(r, "domain"), &domain)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ietf", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateLinkTLLanguage(w, r, websiteDomain, relativeURL, ietf)
+		siw.Handler.UpdateWebsite(w, r, domain)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -1988,14 +6407,31 @@ func (siw *ServerInterfaceWrapper) UpdateLinkTLLanguage(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListWebsite operation middleware
-func (siw *ServerInterfaceWrapper) ListWebsite(w http.ResponseWriter, r *http.Request) {
+// ListWebsiteLink operation middleware
+func (siw *ServerInterfaceWrapper) ListWebsiteLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "domain" -------------
+	var domain string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "domain", runtime.ParamLocationPath, chi.URLParam(r, "domain"), &domain)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListWebsiteParams
+	var params ListWebsiteLinkParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -2022,24 +6458,7 @@ func (siw *ServerInterfaceWrapper) ListWebsite(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWebsite(w, r, params)
-	}))
-
-	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
-		handler = siw.HandlerMiddlewares[i](handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// AddWebsite operation middleware
-func (siw *ServerInterfaceWrapper) AddWebsite(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddWebsite(w, r)
+		siw.Handler.ListWebsiteLink(w, r, domain, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -2049,8 +6468,8 @@ func (siw *ServerInterfaceWrapper) AddWebsite(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteWebsite operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWebsite(w http.ResponseWriter, r *http.Request) {
+// RestoreWebsite operation middleware
+func (siw *ServerInterfaceWrapper) RestoreWebsite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2067,7 +6486,7 @@ func (siw *ServerInterfaceWrapper) DeleteWebsite(w http.ResponseWriter, r *http.
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWebsite(w, r, domain)
+		siw.Handler.RestoreWebsite(w, r, domain)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -2077,8 +6496,8 @@ func (siw *ServerInterfaceWrapper) DeleteWebsite(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetWebsite operation middleware
-func (siw *ServerInterfaceWrapper) GetWebsite(w http.ResponseWriter, r *http.Request) {
+// ListWebsiteTLLanguage operation middleware
+func (siw *ServerInterfaceWrapper) ListWebsiteTLLanguage(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2092,36 +6511,43 @@ func (siw *ServerInterfaceWrapper) GetWebsite(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWebsite(w, r, domain)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebsiteTLLanguageParams
 
-	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
-		handler = siw.HandlerMiddlewares[i](handler)
-	}
+	// ------------- Optional query parameter "count" -------------
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	err = runtime.BindQueryParameter("form", true, false, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "count", Err: err})
+		return
+	}
 
-// UpdateWebsite operation middleware
-func (siw *ServerInterfaceWrapper) UpdateWebsite(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Optional query parameter "page" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-	// ------------- Path parameter "domain" -------------
-	var domain string
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "domain", runtime.ParamLocationPath, chi.URLParam(r, "domain"), &domain)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "domain", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateWebsite(w, r, domain)
+		siw.Handler.ListWebsiteTLLanguage(w, r, domain, params)
 	}))
 
 	for i := len(siw.HandlerMiddlewares) - 1; i >= 0; i-- {
@@ -2381,6 +6807,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.ListAuditEvent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/batch", wrapper.RunBatch)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/comics", wrapper.ListComic)
 	})
@@ -2411,6 +6843,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/comics/{code}/chapters/{cv}", wrapper.UpdateComicChapter)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/comics/{code}/chapters/{cv}", wrapper.PutComicChapter)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/chapters/{cv}/links", wrapper.ListComicChapterLink)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/comics/{code}/chapters/{cv}/links", wrapper.AddComicChapterLink)
 	})
@@ -2423,6 +6861,33 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL}", wrapper.UpdateComicChapterLink)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/comics/{code}/chapters/{cv}/links/{websiteDomain}-{relativeURL}", wrapper.PutComicChapterLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/comics/{code}/chapters/{cv}/restore", wrapper.RestoreComicChapter)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/creators", wrapper.ListComicCreator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/comics/{code}/creators", wrapper.AddComicCreator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/comics/{code}/creators/{creatorSlug}+{role}", wrapper.DeleteComicCreator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/creators/{creatorSlug}+{role}", wrapper.GetComicCreator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/comics/{code}/creators/{creatorSlug}+{role}", wrapper.UpdateComicCreator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/history", wrapper.ListComicHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/links", wrapper.ListComicLink)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/comics/{code}/links", wrapper.AddComicLink)
 	})
@@ -2435,6 +6900,72 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/comics/{code}/links/{websiteDomain}-{relativeURL}", wrapper.UpdateComicLink)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/comics/{code}/links/{websiteDomain}-{relativeURL}", wrapper.PutComicLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/comics/{code}/restore", wrapper.RestoreComic)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/tags", wrapper.ListComicTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/comics/{code}/tags", wrapper.AddComicTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/comics/{code}/tags/{tagNamespace}/{tagSlug}", wrapper.DeleteComicTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/tags/{tagNamespace}/{tagSlug}", wrapper.GetComicTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/comics/{code}/tags/{tagNamespace}/{tagSlug}", wrapper.UpdateComicTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/titles", wrapper.ListComicTitle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/comics/{code}/titles", wrapper.AddComicTitle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/comics/{code}/titles/{rid}", wrapper.DeleteComicTitle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/comics/{code}/titles/{rid}", wrapper.GetComicTitle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/comics/{code}/titles/{rid}", wrapper.UpdateComicTitle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/creators", wrapper.ListCreator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/creators", wrapper.AddCreator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/creators/{slug}", wrapper.DeleteCreator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/creators/{slug}", wrapper.GetCreator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/creators/{slug}", wrapper.UpdateCreator)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/creators/{slug}/comics", wrapper.ListCreatorComic)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/creators/{slug}/links", wrapper.AddCreatorLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/creators/{slug}/links/{websiteDomain}-{relativeURL}", wrapper.DeleteCreatorLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/creators/{slug}/links/{websiteDomain}-{relativeURL}", wrapper.GetCreatorLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/creators/{slug}/links/{websiteDomain}-{relativeURL}", wrapper.UpdateCreatorLink)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/languages", wrapper.ListLanguage)
 	})
@@ -2450,12 +6981,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/languages/{ietf}", wrapper.UpdateLanguage)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/languages/{ietf}/links", wrapper.ListLanguageLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/languages/{ietf}/restore", wrapper.RestoreLanguage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/languages/{ietf}/websites", wrapper.ListLanguageWebsite)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/links", wrapper.ListLink)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/links", wrapper.AddLink)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/resolve", wrapper.ResolveLink)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/links/{websiteDomain}-{relativeURL}", wrapper.DeleteLink)
 	})
@@ -2465,6 +7008,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/links/{websiteDomain}-{relativeURL}", wrapper.UpdateLink)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/links/{websiteDomain}-{relativeURL}", wrapper.PutLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/{websiteDomain}-{relativeURL}/chapters", wrapper.ListLinkComicChapter)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/{websiteDomain}-{relativeURL}/comics", wrapper.ListLinkComic)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/links/{websiteDomain}-{relativeURL}/restore", wrapper.RestoreLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/links/{websiteDomain}-{relativeURL}/tl-languages", wrapper.ListLinkTLLanguage)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/links/{websiteDomain}-{relativeURL}/tl-languages", wrapper.AddLinkTLLanguage)
 	})
@@ -2477,6 +7035,33 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/links/{websiteDomain}-{relativeURL}/tl-languages/{ietf}", wrapper.UpdateLinkTLLanguage)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/links/{websiteDomain}-{relativeURL}/tl-languages/{ietf}", wrapper.PutLinkTLLanguage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/search", wrapper.Search)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tags", wrapper.ListTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tags", wrapper.AddTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tags/facets", wrapper.ListTagFacet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tags/{namespace}/{slug}", wrapper.DeleteTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tags/{namespace}/{slug}", wrapper.GetTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/tags/{namespace}/{slug}", wrapper.UpdateTag)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trash", wrapper.ListTrash)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/websites", wrapper.ListWebsite)
 	})
@@ -2492,6 +7077,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/websites/{domain}", wrapper.UpdateWebsite)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/websites/{domain}/links", wrapper.ListWebsiteLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/websites/{domain}/restore", wrapper.RestoreWebsite)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/websites/{domain}/tl-languages", wrapper.ListWebsiteTLLanguage)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/websites/{domain}/tl-languages", wrapper.AddWebsiteTLLanguage)
 	})
@@ -2511,56 +7105,161 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xdXY/buBX9KwJboA/112YXffBbMpMEKdzsIpl0txgMClri2NzIopaixjMY6L8XpL4t",
-	"kaJkSrK7ehvbEu/l5bmX5+jS41dgk4NPPOSxAKxfAUWBT7wAiRe36BGGLuN/2sRjyBN/Qt93sQ0ZJt7y",
-	"94B4/L3A3qMD5H/9laJHsAZ/WebjLuNPg+V7SgkFURTNgIMCm2KfDwLW4JuHnn1kM+RYiF+zAPya5DY+",
-	"6g05YFsYd92fH8H6Xm3o5+3vyGYgmr0CnxIfUYbjGdl76DNExd+YoUPQ5LIwfBPfBaIZYC8+AmsAKYUv",
-	"/LVNHMTHSN4PGMXejn/gYu+7vpkN9r5Xh49mgKI/QkyRA9b3sa2H7CKSTLL6zgyU3DYWtt4myufpIhgg",
-	"562A2COhB8jAGjiQoTnDBwRmVctPiAY4xp8Xui7cugisGQ1R5drTQCbTKZltH1gxl3UlVhRB1m4iPISf",
-	"bgvRxR5DO+Hg83xH5sm7IfZYevkX5EKGn9C3LxvpqvyKtgFm6JYcIPZqrwp9p8HXloHN5p5Nqs6V6hSk",
-	"oZ5i3GuM44pciS+qf/uAggDu6utdwCALg5qPTtxPrptlg1XdOrkjdqbO+w30dmHi0JkVDiP2WHU+wYYH",
-	"D/zNT+/vPnCz8cumiYoRk4v1akuK9TOncoD2HnvobqMojFtCXAS9pPIqUc7cNMwtqny6MKeVvhzQu00+",
-	"dDQDx8ZcSq7QzuOTJclvPzVWDoP+cuUzMFOkksHaFKr0Fg7OwetP7u+JJ3UB+4yOGY87CVUti+ITJtDH",
-	"c/7xDnlz9MwonDO4C9K5gHV8b6TJlXInCuRIk+toupNyxS6kRs9EYdioDQ3SGz0dLurImiohrt/C8/1Y",
-	"4rQC9noTSSxE9bu5iVCdDhvJSIEpY+WBxQpJoz+Ffeiwy7cCbXah55MYLpIyEb1BxL1RO9YST7MWWG1Y",
-	"h56D+YhRE03RrpslzBz7wMvxBCsnpKWvrMuNVJaUNmiAZElVVKbMS3qrHLmVqIbZGMnm4pjSRE7yvRoH",
-	"R0JN9Yw7OSb6zhbzhcFJibKqNCSBm6AURySRaCaUAXZK12KP/eMnIItXuqPcSjSDaUmAHTArTKoOHF8R",
-	"a+D+JtYk1wJSB3R4vxFX2ukAEyZPdEGA2OfQdUuivarua9Q5f28efMf+nIgH9NCd+4RDjKbe6XiTmJ+R",
-	"A7fus5fexUrTmk+MeFBGnEZ/CvvQYW8WIs0OmREmJiae85HauV6CGjFUvUtAusjy/X8smWTgmshsFg4N",
-	"XWTCmRF0Uv91apJGpYgUoHRmu8uRt4tadsIkfb3hWmAtJfeDPK5TX0q7LyW2WjukmL185WsXB+kdghTR",
-	"tyHb81db8epD6uQ/f70DyfEkASHxaR63PWN+DHvsPRKB0dJpp49kvuU6zbI5Pbb2JGDY21k2ZNAlO2sL",
-	"7e/IcxaihW8jL4grVIyXtz6098h6s1iBGQipm5hbL5fH43EBxacLQnfL5NZgufl08/7z1/fzN4vVYs8O",
-	"rgAkZjys4B3c4RtCebAzYQZWi9XiB34V8ZEHfQzW4MfFavEjmAEfsr0Iz1K4Lv7cIbGCHGHiTNgnB6zB",
-	"BgeJ3uc3UXhA8amr+9NY/AJ3yPLCwxZRizxaFAWhywI+d57T4I8Q0Zc0A9bA54CeFU6bnUAx4uWhbOBf",
-	"8BkfwoO+DRcfMGtp5CuhLB3XooiF1EOOzAChDqL/3b6UbGhSvIgnfemM3pvVqtX5PP1TbzXGKwf3xIWW",
-	"iwPGZ7tH0EkO1/02/wXusCe8mG9ESCt5cLdHlgsDZvllEMRpccRsb9khpchj1iN2GaIW9BxLrM+iYYHA",
-	"b/M7wqA7vyGhJzHN+AWWzS9QWm2wFQclOyhZF9ZswZbpiUpRdcLDAdKXJFti+9xWvJneJ2ceH6IZ8ElQ",
-	"k2NvHSdNMV4HUcDeEefF2GHNrFvPfS0O8zw/Ho9zXrHnIXWRx6mA02ncUgXnNT6qgPsHY/MpGK3DMHSc",
-	"OGULIN6Q2FI9fngx5MDx0DFfvApQst2qO06S3UlUz+K+dP/Aq0EOo7eOI0dRNEuL9vKVr1gUT8pFMQkr",
-	"Q+tWvK9VwG+Ig/L0YcSKx8yKH49SXvvEA9rTVVdFrVrufqquRryCsWFnAXoPdBweVcbWboofEesW0nhH",
-	"6S+kq6GSbIf4vnt+wfyI1PUSMntfjf83wTi7LUHMVo0ugfmSnTVZDJfswrgaJXswNCUKolPRTu7VLdyK",
-	"shN6B+LgRzxI5Ykh3KLKL4vfeFBT9ZvsfJd+aphKiNmkC65GF0i/DSPL0wSC/ciEdPBx5ILC+pCyIfWj",
-	"i3wYM+0f+pUuN4UefA8KJh++Avz/kNCyofc3ZgUpVbGwYxGarheP5hbZMAyQhZl1xK5rbZFFnhCl2HGQ",
-	"Z21f4qvEjpUtzQIMrpkU0yzntwEJVQTyRUgpVWbJN9vlq/2kK7AuattNnLn/+7/jx4EP1VLXKPGe+hJ4",
-	"qQfjCD11jVUKvi5LbF73dVxstRNPlyU9tWuVcSXasAU3KtIrLANqTfx0SYq4JyZQHV7BBOw99HZIRgaw",
-	"Z7E9DizkOeK0hs5GP1byGBPe+tu9xrYwmhA/gyMss2/Pa5F0cdjpSirERdeFuq+o9asSEhPRWPQ9t6+W",
-	"6N53kzxeDHhxZD7zqlO2Ll9LB9ai+Wvh6F5L1v/nS+iK4eTEihWfdrH4qLlpgccmxVH9KndnZ9LTvNa3",
-	"LxvuhZb94snNnqWP8Gdc/SNNHj0RNCFehvgUa0rBNQDWlfbNYX019jbXmwRUZIi2DpzSpClNlOpzgDRR",
-	"2j8rTXqXwX3Q3XoT0VgitkUdMK5mNUmv7nY/uq5tQZc15exo1a1nOdmnjhxNQKpTyZhivCyl2BLyRjTh",
-	"5ez5kybT0GRjarGOGuziETZpoJ4rtXnRc47YuRo8TmKjvRLoU2WMJi80UsycnjhbR4ysH1Qkyi1+o1J6",
-	"MDX7FuP0NbIrPC4q/Q5sTQKl15o+JZribPADoirDA50NTV0o5mC2JsqToYW860kv59gwLpcLQw+qlst2",
-	"JfA+TyoXV3RsodyArlKRX75ixB41hLBuwf/0/u5DfHCmmGpNqjD575aG5WC2toOLwcYEl6nBs8OsFEgG",
-	"wrwaNitNyqLmqqvSRWevjFIqdFuZXjRCXxtAeehBFYIW1EzIA/1tQF2wxpAGOttG2kWQ6wKNBweTJrhI",
-	"TVD/S0fVfOHS1bQW4GMOrgMkRofSACcaXMRfzf3j3OqL9/fTIhujOyZ7FrQ5vyV2Kc0wCXqyIn1u30un",
-	"jssfzeKx+1B45EbUZpQWlLSkSNXGWas8NYSMFyejcke6wShlzvmQmHoy7URRP+2YMToxSmwb0VfdGy+b",
-	"sVou523VS+bOSy0ZJT0s/HvJ7jk8TuZeR75Wf5OlH8pcMjA4eT61XpNJd5v84db5lLo43KXQ61OfDOSv",
-	"/tP2KZdVNsvPWE/hOFKz4dSNUeh/A2jVUmBCXHfEXVHfpX2BNy1LGlHaKFEmqHaH6tU0onolWnUGBhdL",
-	"LfPQlIRqR7f0truxpJUGTUsSWt25Sn/4YGpeXWHzKl08jf5Vuh8YbmElGBu8i6WwO1AjK/GgmHvpcijb",
-	"WXnC9STUM1AYV+j5yINK85LZelSfJ8ULSzm2AleiqljUl68xs9NQ1Zol/rbMFNMMa5KVTnu+qCUsMwI7",
-	"tJ5sSGyZkjw7ykopZSTKqyFT0qR4aqq1Ktl09roodUPXdelFOfRU+UsjD6oVNGBmQhto139loRpDCbTf",
-	"LvQbK9Wf7uqUQhefOLW/E94beRqvwyFxoB7M5vocx+qIF0S0ZDK6TRZptzcuLqPUD9FqQjRSk6EOlWPx",
-	"wmbENHLEKwfA9Tzz71zyeiCvOrjRIbJXDp5reQrfOyWQ2BiDX7fPD4Os28BD+VoPx2PjGpxCDE6f0ryN",
-	"fzh1CX28fFrFP6Qb35P9zmr6C5jZG4XvMmfv5Y+D88vE0bSH6H8BAAD//52upNxYmwAA",
+	"H4sIAAAAAAAC/+x9a3PbuLLgX0Fxt2o/XPqRmdmtW/6WyWM2W04mm3junFNTLhdMwhJOSEADgLZ1XPrv",
+	"t/DgmwRBiRSphJ9sSSTQAPrdje4XL6DxhhJEBPeuXjyG+IYSjtSHt+gBJpGQ/waUCETUv3CziXAABabk",
+	"4l+cEvkdD9YohvK//8nQg3fl/Y+LfNwL/Su/eMcYZd5ut/O9EPGA4Y0cxLvy/iDoeYMCgUKA5DPn3s73",
+	"PtJHFH5GLIZylGgrRy+/9gnGKAT3WwDBA2UxYgCHiAj8gBHzQSzfB4ICsUYgSBhDRABK0Lnne2sEQ8TU",
+	"Iq+pXkt9+Js1An98uQb0oWmIfMliu0HelccFw2Qll7fzvU9UfKShhCRsgJsKEJtfAcckQGqCR8Q4pkTO",
+	"9+Hh7BMl6OwjFMFa7cZnhgJKQiyHeA9xhMLxj0XCCQWAFchSoHbpJqh9fJ2EWLx7NNBsGN0gJrBGJBgI",
+	"yur78DW5/xcKRLrBgn5DBIg1FCCGod6TOBFqSXLHSRJF8D5C3pVgCfKr++578EEgNQ0M9UbB6HMBDv1W",
+	"/YyphkK9XZrUB3JKiVsgRBESyAKFHkRCcY8eKEN7g6Ffb4MDhqETEAGNcfDhrYRCkgYU3pWHifg/v7S/",
+	"jIlAK8Q833s+W9EzAmP57RszkPnWPJtgoqdhCAoUvhaliUIo0JnAMfIaDkkSqFDEjEgSe1d/eREkqwSu",
+	"5NNP6J5jUfjv5vo6/zXC5Jv5U/peQUFZ/t+1flDAlWe2Iv17g0WE0g/mMfX/m3wM9Vz+6ps13AjEKh/V",
+	"u7fV9ZV3qbror/pAGl8x2/0ue3DnezhsPD/rebUelUTAjNGlWw/D0PO9ZCNPzPM9jeWe7zHEBWXIdYE7",
+	"+cbfCWYolKNiOaim+eK82dkX9yNH1YxyUkIuotdtA47/KvlQndnENESa1xjp5UFhUCBbdvrFPeLi3cMD",
+	"ZcL9LLMFqdmwQDHv4rAK0t/T9+QgMXz+oN/835eXvhdjYj6+ysCAjMFtbW8Ls7fuye/Fo65x4joKGDrQ",
+	"p28+wDB8U8Z+/XPpS+c9C8wLNRFgRpIiQOEBME9KuZ3z3Pp4RSg6Nv8TeioBnb2e4UkJIBqiHBr5Tw4Q",
+	"DENAmQ0uxaG64VF7bB7/giIo8CP648t1HZr0x1QPkS90bI185E/NPN/SGOIGxcb8DEL1u+O4Rgeoj/Zf",
+	"uXJgOcIO4V3BcoOmrRj+BXGjmFZoH3EOV+pYO7UFLqBIeH09bylBICczwJMgQChEoQ8elOIlNUAO1vAR",
+	"AQjMjACSEMB7ykT6+xNiCDAayRfuYfBNYk6EHgRICEuUPpNSYEgJ8nxPD+75nhlmT+ZrltW0dYoMFBeI",
+	"ot8fvKu/7Ij6e/pidZfNAbvzvyr9lTmcpEdNig10/oiYoYzOAzXCvy9Y+q0msCRVuI+WEnV1FC4gE/9E",
+	"kFkWkcryElqm+EHJiurjl7NGSCgsWWP1nO8FkARIopk8c/smtbFnviV0wzFvV1obzqaiy5shwDe01UZZ",
+	"qtaBD+9u3ncrrQXYzvg3vDmjGw3G2YbK7WH6tZ1S63qesdTmGk5GYBGhvkPJdxoHU5/zU4shWUFPSnqy",
+	"fkr/SaBWbQVV+hBleIUJjPY9uQrpKzKqE34rKygI0GE4QiOeDENFJInvmxSIr5QJiXNgAxlHIXhgNE4l",
+	"kLGbntaIACzAGnJAKNAjSYTMDRaa3CuzoOUQzORquyMEeT+LpyA5+0nBINO9CtP2P99ro5RUjqy/6SZP",
+	"smTCVEyQisXRoN24aSu1p7SBYoO158Zma88W1QRKfQntW22kSH2bi6pmsx7bY0v3ODQjFftOQtknZVC+",
+	"tI74NUpWjb8zGpU4oTQlt57vQSYKPO/siTJlpDNIeKQ2z9mcGBMfcnM0P7riLpbXX94ts/ZWLFkocXxK",
+	"lLJ+flQo4KrHBAKuJD4doo+9xXwTwS0gcpzjKGUK5A0MmjdZwFUrxzg+PevzqICdA9mOXUr9O1xhwvwz",
+	"wzFk28J+3FMaIajcQ+kx9aHx9JV3N++b2TIO69+XnYdftN+R0RgS/G8UNgMn0j2wHwJTbsDCSiowpgP5",
+	"hc0ozu2o6OSC98AjgZFAjECBMtLLFNbaXrago9ZW3YlmINW4TVDzZnqrOgy0HFOj9NrzRZqNKs3eoggJ",
+	"9CHewKDB07VJ2AqFDgKi4FqoejoTomJvjD5xKRuEXKiOv62oijatKA0BjChZgScs1ioopV16oXwL0DR0",
+	"KRjka4A50GD5QKwZTVZrEEAewBCTlRwP4RWRooifew3rVWOMt6QcdhUZ5mlkWE3r15aGSRAlIQrPwc0a",
+	"bQFkCNBEjc3xai29eAJHwMRJwoYFVVAjXZ2fnlzTketIbO2sUfPXBWenxbnp5C30s8FuuxaigWmCPovI",
+	"DSAekXjoElZKiLSzvwrYasReXC5lbwcuJYbBGhN0Y/NhFoQr62BsIkq3uYfgMG/UhEd5Q/OYKvd2WQTW",
+	"wj7NE86su3Ik+evVycrb4H5c+QqGkUsjaGGjipxWXatpw9K4WMNWFfz7jeE6ngd7ytIBMxVKUgzeCTUb",
+	"gnP76FLyMQo3+Ey6QFeInKFnweBZ6imW++xdZevyaSyB2wgdXg26A4E+WCGCmNxp7U5UkRyaiHqobFiI",
+	"JWi7XoEQ14HNeLuiGlreAUlRvLAFMeZcSnL1uJKIMAy17HM960+ICxQ2KrKD7psCsXLMfeIwbrPkI+6m",
+	"CNw4Aymn251WmMdxbemCdkWztJJaJb9W6l1OwnJQqa2GiOHHNGiAiXpqLTVZmUPiy5gBliEDGWDYiO4o",
+	"thvQGs7dNCEjRxDlO7vdrnp4BalRiB05hoJ68Wh3vpQHe06cP/UOLblNVRh21ycY5TZ6Otxuz9hVDaGa",
+	"nQq5h8AtebGolbmfyIe3GdpV/AtDbFV12F2bm2KoycoDd5Bza/yqGD4aa/PzSXb1+NIg6k5hyN34ISpH",
+	"yqQRqhNOawQpPaqFQiahkMa4UhbWGWvT9QS7hgDLIKpIccxdOUgz0PiG5Kw7mypulhBJh+PEDZx8xF3N",
+	"uh+NavJZdg3+gUEwuTjmrhq/GWTn8hF37QGgfupv1T+KhYXvtUmnY8ds3JZYhqqi5jW7K90GVu/uWiM7",
+	"boPwjCLdo0DFU1jkz7HlT7tT09lP7sgg5XADIWkv/7teZiNi9fGfuwGYj7jrcrgPyhZYBbUSFtWN2/cy",
+	"Y7GY7Y8J2EQwUL5I4yVXyeascDXAzTWRo4SEwA1mCeOuKRgwBAk8VdC/ElEYi5Hkk7RTXEcw4XvVHVq2",
+	"o+AWmR999ie8jsjWfsjbHNxyDGipXW60MMgp5YG5CwieiZnMoElt8RUiDHm+J9ZIOb1CFNMVg5u1ucWp",
+	"bjyfPUFG5IKHNMhJyRYaXsfKxzejtyCCUQvqyBAehLFhzmfHJtrh9YcwJSSbBvFn7dLwD825TU7CEKHw",
+	"Qa8iDx0DV4mG9rvCnxNhD1sssYahYw2VQ+oIA3xOhIN+UTkcGYSHXAbpKAGQAPSMuUiPRQfqzUFZRNz+",
+	"fK6J5r4iyNrvp5by0DsvND5gFDWUD1H1N2TUUv4scVPPXrxSGpgsZ5Ngmzkk7tJviL6uEFbVkzup8Dvf",
+	"wxiUK3zDJCzqAWndhrwmRNSr5gLr4yBQtUxM2Y7aFWz0CGWio3pA7jdTp9t8g6x2Y0ygZ9F+hvJXOaQ6",
+	"y8Z71z0tryrZqV31NYtUk3gGpHTBt41ILNpSg5xwd07JLRyJT0kU2X2D43FfM/2Si/Jj5aKcYGbHV9Sh",
+	"IhUyOwah0kKmh4M+MsSUlVyIWbKGMRM0us58ce0f1bWf7f6SfOGafDEaY8yTMVqPaaGOSahjSbwYOvEi",
+	"29kl8eJEEy+GzENuw5DvMOdiiG3LczBmaVgOyES4nYMs2SDTCMXubJA+qQCHZIcMSE5ta51DyHkgy7OE",
+	"SLNkHj9SkodBriVUmG3Hkn6wX/rBaCZpJR3hx1Y3HHIihgBighyJ8aXokhZR2hHD6A6s60BOrEDX0XKt",
+	"nAsPOaRD3TYf33sYIOF+hrr8a0ssPtENK6qlZWqlVbOHHaFkkK9lTfcGjqXLzEyWflMNtDf2X4gKvRGq",
+	"nRCc8WADxbq5r4r8RVbgMQV01M1rvXnqMnZDJNwS11bT+IV9bTqQggA5kPLD9oooPYu9tFbuOlaVl55J",
+	"dpZ9XUqvOJdeUcpckDDZAUOend6kXxFkiL1ONMXcq0/vUyD/3583acshhULq13zf1kJstLDD5IHWKe43",
+	"enYPOQpNFtua6iypAAoY0ZUqT4+ISj+JcIAIR6mA86681xsYrBH46fzSM1cW1HRXFxdPT0/nUP16Ttnq",
+	"wrzKL64/vHn36eu7s5/OL8/XIo4KNQO9X+EKv9FNPrJAp3d5fnn+yrTWIHCDvSvv5/PL858NaavtuYCy",
+	"v5D8b4Ua8mnePSK2zRrmZC2bzAIZCigLOXhaU91aCItqWbNy9x1VzV/1A8LCBwQ9IS7AA2ZcZf1kzQE+",
+	"hCpFkItC8yMJM4Mx0gV0/qonFMZYmAwiVYNMt0IB8iTl2JKxeH8nSEXEzBFkbVPyfk4/TO+cnd+5g9lx",
+	"tO1fsQVNfQsH64HTCSjv1++qaSlpV5327mPdYJhUVl3iRJW5k8wgJwKuYbpHikIEBVi0gWPyDHtA8+ca",
+	"ibVuDhKoan2CChil4MkWGxFHQKqyXJOwekhN2w5EQkQJiqz1T7PgrUP1WWrcOmcvT/DjbRNuNOnUVl1Q",
+	"Huv5fs84TmL3OSJ5avZJbv1yu76fLi979YRz0ioKnK1WerTeMU49DZB8HESYi0qvvX+cfYYyuC+fPlN4",
+	"2awdRpALsCmfCSwMrbh22o/vAUeSUUuWrTbtvONovH+c3UicO8t0/zoAGimDtJxkx9wdM+ptylopNu12",
+	"do4Xac/FopagxEhRP/jrVh4+T2IdpVUiqAjkuZd2yfhLH4l3K8e7uM86ZlHesPLf86Y3LCHy5iFlIWLn",
+	"4LXql6WqIqmWNlx3tEFK6GbcVZcDpQSpPjlcnwiCqtbmGilVXze/8cG9/B+pxlt61G8IGYIvdN5RbMi0",
+	"36nL3S8J0R3AtFaGuPiVhtvBuiLqsStp7MaWHp/sil2OHOhOPW5YiiK87PBUfni2pefe6Lj4JSHgXneI",
+	"zJFQb6ZGQiV+eEGTq6tTb4zaYdWkdKa9zqCOTTo1XEFMuNAFviT+6RYv2QWONm77d4mCY/h8jchKru7V",
+	"T/+purNln/1uEfdeM6T7rQaja+7+MjSfQCfUdk6RVXmtaz998oL3U4NyaOWjnbCq8Zog7ZtDeyi0KqMa",
+	"bBFkLvvLxJ18tKdaUNgbuOKSaDOn2JV0iQFtprbuFVyVJnQMDDQA8u5Z6oFoPzCQfvluOHBSLVHzChAn",
+	"XJiGa1EkG6lBss20aLjilv25i42MaFD8o8jzPUi2Q2CLcQZkXWQxBwLHrSadefwu7XSZQ+fiH3EBJOsj",
+	"6whJ1nzzUFB0zoSU39m1OWnmxvco9IGRPcoOkWeJngO0EaZobMHrJaFFz5tIXTdRNkEz9Np+Qc1olx60",
+	"FgJeoUOckUxpiUHfqwHg+XkZ3Pzfc/28swO03gtuG8kv5M56LlaC2rYQhZn9Rh+M2sXMNoNNSic+eHUp",
+	"McDs8bln3bK7uoUR6+llT1Il9PSHV74TI0NRWD5xhkTCiJ8ByrOiueq+JKEiW53raasLTHwvHtO59/M0",
+	"TH/fwL8T1XucU4UQBD1r08gHAn6Tqs0jYkVb6Ry8k+FZLb64Lm2qPFfqoVZQ1QT91JCTNJr9xgZyKXVp",
+	"nDX3eBsmUCr13f12PzF3FINd684ONoN6sMlKT5Ou6mZxhnzmgq0xhSVqqsu2G2Pdk5WSROoXe698/yCf",
+	"gOY1x/YGtM46mh+gbOhnqmhqXekjv935mV1fNqlem17OI1nKWSF5CWtxmOezp6enM8lvzxIWISItnXCv",
+	"cR2M8FeDracwaRPFZBf9CyTzzuQU1HEnde/DVRaUMDhj4h9d9HFNg6yXd0scVYmFpxwv2ocb3xX1Ogzb",
+	"ETQ3/y9eJDLs8nB48/I0qWGuWraEpZYtlQ4s0irQnVXqnqK3eefyLqdCuf13qYO1kgcm1Fw23cuoadv/",
+	"ugT4panzgJxbTxwqj80vr37qPq7PDAWU6FyU97qX9OinrXfWxpEafTy/IbHfaWj5PNRpLKbLYrqcoOly",
+	"qBp5gOxbIcGHE31KS7un4dYHHx7OVEkMY9Rgwd3kowT058tXbcvK2dtHKUA+IxZD+UuktvXny1+6X/xE",
+	"xUca4gd8CD/NGOZvyK6/pQGaMr/8QwWn92OZOrC9sMyFZf7oLHN4yyerkzOw5VMYd/Dw4wHc3yTQzc32",
+	"MWC52j8WnTshsWH181a7tTzoYWddFFu62cOuecqWu5wZTboUwhoarFb/ZQb1XqOnColckH0i8+S+E6UV",
+	"aFzjROnzIwSKMlBcI0UZLIOFipbw2RKHWOIQljhEWYU28wvpas8Zgzj1YEVb789WPcSw6O8hdpEuZZoY",
+	"hmX2Y8YyijK3b0xjSnXldtx4yptCgbwRwir58DUy+ydNQADJ/xKAp/4KgJWLPc8dB/cogAlXdymecBSB",
+	"e6RkAMNhiEiW/aaU9Oxozr2jB3Isyyxzk3nHdYo0Mov4jo1o2+2Pi5fg0THqk57LgNGfWdk2Bpi//uO/",
+	"NMLc1vlyZ/zpcazoUwrByUWh7LLEGo3aBzuOEJRywxM7EIfiyeVEPHmm8YbpwgYdqlJn+OAEOaA9gPE4",
+	"B42tWjp5JHewk8YWrCFZoTalTTU5xxwgEqoCES4K2VTEfwruZne1zEHYnqL7uYMhJQ3yttqL5aR4EUdM",
+	"zJoXVXd3YF5UH96VF+kLx+lmUpZJ5YUndTCOxSKeu0VMU8w42Da+yBpROYXpTBmGk2Cgw7DNpaLAcjli",
+	"kHhDY+c1h5gD+TZ0aYOgPsO08YA2EKYICihgDogM/Hgc8jhhCU0+o4YmzBS7qWIG+fydHKFJVdpTn8kQ",
+	"flZu/lYydNJnLl5KZaB3Zy+FgtiVeECX935RedKae0DX61OX9fOpFT52RQ7q7akPzADWjeizrvVd8xeO",
+	"f+wQhoKnGMc4fjDCIsNcIhILxrdhfIpr1ujHEXDdOv9wuH45tZjT8ZgR4hk2Lc81qLGQSReZWEMpRyAT",
+	"6/wHkcnoMZ0x1N3mKXZTeT978IFmN+gB8RNHpddV3FciKccPh9j4mUNMZGFl3azMEok5CiuzzP8dSfyM",
+	"0keMRPzgdjaVvuskWA9kcKd1iwtlPiuFM/UDpxqDNeubLCXOzH8MyWJOaq+gUnr5tTuSlFXvngEKLMGc",
+	"JZjTL5hjsNc9kKNfGCmGYwafKHzTPvtxIzcajr2CNhMyo7HjJimmjhQzyYafIF5SnNtGdIOobzl2zSRC",
+	"0o7u7XL54qXQFn/3Hy+MRsg5EDIniS3hV7OYI+68s5Av+0DLjEaozvu6ppf7PJqGaGCYKthgY7v2OMPM",
+	"Ecp+uWF0hLJOPwBCXU7EjUfw5lslf7cjf+Z4aL+KMDoeWqffDw9H9JuPo+7Uh5/AX+5KYAO6yV2VHgfx",
+	"NKFzvI+mtMZcULZtbflW6DXEs4xjNY20tFTZLLHWna2yblZct7OqtHQDct/NdIALuOVZHZJCCdJMqvtt",
+	"npT/ayDe7wJhBgF9WNwrS/etpfvWSXXfMp01NQm7MTjHTP/5xAIX7rE4Z3s5Z/ul2I+XWj9hSv0sUun3",
+	"TaGfjPWM7IodM3d9sqR1exR9sOj5vLLT3YPkw+Whz0ggL3ng3V7ZKfO/98z7nj2GLXnXI3Pq4V2zhyRY",
+	"nww+LgnO/T2pY2Y2T5bS7EBiwzlnD85dnjhnec9c5RPiCkuu8LgkNEZy8A9mz1SygN0Nm175vnvEJqzZ",
+	"tiM2QJsqz9Zt1/UTXb5bWSxncd0urtvTc93ewKb5W5ixgKtxHLdy4Gn8ti0zH9VtK+BqH6/tVExnZKet",
+	"wshxfLZ66OO7bLN524hqCAXHYNE8/LUtKN0sXy9eBFx9gjHiGxignfqo0mUdHbWzkb7ZGuRU8ly73KLF",
+	"dQ+TxeY4a+/stR4KnQRhIj9sKye1umFnjT9W1+do+NM16wD4c3lkHju8q7Vdbnd6WmeNclbv5mgo1zXr",
+	"fig3nj91BD2lPPTxvamdNDScL9VJW+kQMtN5Ut3VG92kttuBIJ9bXAiLC+EUXQgKd92dCPLxkdwIauiJ",
+	"HAltcx/XlSCh2MuZMBkDGtudoLFzJIeCGXznN6AJwyHAHKwQQUz3Gt0qhskRe5ykRVortEXCHMQVkWLh",
+	"TJwRbUTRJq8vXhgOnb0P8xHdXz68rTKkzsQsHI7mB1AATOUJaGeEdl/A3E/Tngp18GleTsByRrDMLWKw",
+	"2zafOwrYs49wOAfx+7VET2PZyTl2HdtSdsHrAa1lN4HayYwntJitMtipiJXbpfGlr/rSV/27MK17FLxq",
+	"L3V1Qn3SpyqpNX0xrYbb8vqrDrs9Y4hj2c/jVbKaqIiVpaDDMJWrZlSzyoZVRaF78cIdY+1uArhvoSg+",
+	"Tih6shpNdmpuNT733lyrQchnFaftJr9BTcEOvmo1BPc+DqtxxmcUwxyvbs9EJXu6sWsQg+ywCj1T1ubp",
+	"KRGMa9TFKHPKOa8Qy1AksgQwFyvreLWFDf2mlzeGDWKWBp/KEGqd/bjmUMMlDSuzyqrsdNlLLtfZRmJV",
+	"t2ObamMVvCiNPoXJ1npJLHN9HH5NrDDUjAy4+g2xbjI4uPzF5JTS9+rnD1z8okgBU1m7FiTtNHlPCMuW",
+	"Mhgjcu8RTH8rWrrY/yeEm0tJjH2cFGMVxeirME1DcgP6Qw4qjlGCaTrPSIeqFUGySuCqI5372jzVxTbe",
+	"a4vufgs+vLt5ryjJvNlmTmMkHmwb7HvPZyt6Zp6Wo3o7v33ajIGYaX0QQI4AJhwRjhWJbyATGEYglqyy",
+	"DSz1pxcLyWFQG4/CrAwz5kDguD26rR+/U4+X5pTECoV35cnzPJNjeP4+gNyjB8qQOyT6+QFAWdIWlrSF",
+	"78GhlrE/B2da+uxpJy6k/PPoDjvbxEfy1RVFVio0MwywJi8UxORILrEcEwf3hxWGPqozrDxvCzE1ecHe",
+	"yZt9jTiFiMBiq67Tpb0VDCI96uacXaTj7mArIsvUzrUOxC2pexcvUvOquM2aeIM5AMxBTB9RKGW53FDB",
+	"IF+DhAgcZcWOAGVgk7BVU58J7Vpx1SOV9hiYbOQMhi6XlNElh4myURJtU61ljQCjT1w341hRAGU7Ds2h",
+	"RGGTfECo7tpR7rjRJPVCtr1jCWnWZJRe1KDKjOnV0Af0Id7AQDTnABsOrXZC3vqB9xHKtqSEFi37owQH",
+	"JQHKsKTNeMrovuj7++XVT93E85mhgJIQy4HeQxyh8Ghuw0650eYzPJgqrC603lQxJpY5MXvtNBuK1yus",
+	"u6fh1gcfHs4+SqvPqPlYcDeBIGH9+fKXtsXl6POJio/G0B/I6detjNg8fgdjltUBth9mjeL5GksvKg99",
+	"VJ+XE6k0O7sm14wMWD20I7sYqPjPZisJjOttD0XMoaFOOoKL//5IomJJU1q8Kvt7VRwb7BQ8KsP32IlK",
+	"g0/m7Zi4004Jjj5cy7V+7wAqrq2U7wA6rk38TFDNdx8RYgKiblLERF4XQbIIklMXJCkq95ElhlZGEyfp",
+	"+NP5z9sBOLZQMaDYWFm38uug9OaRT5NOQh+Kczdht/n5LkyTSPaK+KaZICBhUZo+sm/kOR3rLmGRe2D8",
+	"SyEXxQZpDIM1JspPR3ikttgHJIki8KAucHwj9Im0gWZevhNlwBBJYnmmkol6vqc4s3wpiSLvtholNmBb",
+	"F/NRz3NjX8oSZl/C7EuY/Yc0CFvswBMKr09ibE5sY1ZNS3la9nC6lvljhdLHuVYyxX2StrzI67YLJPMI",
+	"nc/kTkoLYmZ6qZyDRo+ooJ+WF/YbElqwKgqTTd/Bg1Sp/vhyLUPCLIYR/rfUVEiodT8dRseCZ1p6QWdl",
+	"8nXKYsTMV+fgJh0bc32cmmthAUKKOCBUAPQsaWyLhJpFnRuMIsRADLfyHfU+r8flv+i1OanXZk0pL2mT",
+	"NFpxdbb9y7qfUmBHjUDaaGWWkceFU4zJKYqeN0kJ3eygxz20Bm3AkPEQyTQONNt+vwJPfcUMT3zH7Lp6",
+	"t2z2+SVtClRrXslBCLJcDvsxxMtUiS2t5oA1oeVwlF7ulPVLfxnnMtkUt8istDn3TJr9b6JdN9xAm30G",
+	"TSt3aGnqPAhj+F47LQ/PGNIdH5gx5MP+sIxhsfjm5BvK6qsdaBZeBGu4EYh1Bzl18Sb99KK8L5kiS4Rp",
+	"8MpohrhcI026dpgh4METRuozTBIX6gThiFGiMjCHMN3uWo8Zy1147cJrF147NK/tyWTHY64TMtU5MNMD",
+	"mKhzivcQ3mZbkvdRuKgNgPHDEVMkmh9m14jozLGEECbfbq5d7wFYEGUa7Fgk6yJZ55QnVyAlVxErouI9",
+	"n+EFbXH8ScRtBwDHFLoFUPrnvC1scvza0lUaGiUPsDTB0TMCq7M3sISb65xiDis0HVWGm0tiXxWmAdSc",
+	"xtI5bQk6Cy23zlm+WlhFx8GL/bhr4UUwjl582gFp7Rk/C8btj3EnVEinP4Mfsha1E5Z2ZvIsqLo/qp5M",
+	"ZZ5RFa2mCY6eOtCTDocoUN1f3XITd1MUqnbjJZa8n4WNHMJGLElHpyjxik6AjNJGyKnpCcoA1lXFpzCD",
+	"1BhBk2Dt4vCQZhZHkGl1oPES1Rfjn4MMAQbJNxSam/XoEZIAAR5Ixzz4TLlYMfT1/1/LwMY9JogDwR9R",
+	"oPrckhBsVneCrWLAcYwjKFejhsNk5YM3NPjGKAzWb3+VDtWIg3sYKMe/YHjFYOkt+ZMGIv0Rk0fEJPfF",
+	"JETPqOE21Ve9xq7mGuopIOSl1PQ+GFxBTLhIQ980RNzX/eW5n90Sk2TJfcO9uHGoqbBBzllaPad/Wyk5",
+	"hs/XiKzkYb/66T99L8Yk++xwBV35CzMfq6DgGyYhl+hL7/+FAtEGlHys2dOaVhUITHD+KatXEymcci0s",
+	"UHGNLo738RzvR3F+a+LR3MLF9W2ITa9uaL83Lw1+bKd35+zje7z17hY5vv7G8Hz9pSUqKHNNO7jlJxgj",
+	"voGBUmEEXNladqjnvO8g8LYUz1iCgv344g1smrrGDm/g6rRLZwi4OjqjbZnzSAFFw/NSBisP2hpC1Dx1",
+	"pLiZQrPBg2V61KNGyLIp6/RxmK1mjmtq86wRa1KZfPEAAyQ6RfN7+dTE8jmvsyUBB8Zrowa6kv0Hga6Z",
+	"1TargKvSfM5MuwbIu+cgSkK0HxhIv3w3HDip3qJznEGccAHW8BEBGEWqiAjZprd0JMSW/blTNmhjSTcY",
+	"RZ7vQbJ1tbcW+2rRYQ7SYTTLcVRkFBcb2qYT2cBTqBmtMx9P2dAwWITHS8b6dqZDd3cuxB6GXmcKQFGi",
+	"HOAjT3vduky5X3f8rqwDictHTzRoUSvbUgv2PEBrRH2cA7ROOcABXh5DBx0yWN9mQNjC83setzUqPc5x",
+	"W6fc77hHCYSPYDllox415G3B2iFC2072UysbnSKA3W5qMcjbI16aD4dGg/fLtx65ryv2ZcEfE+5JkxAB",
+	"F3ArzZBq/TCxRlsdRKsVEstKJcsfBSISDrBBDNOGGmPKBFTwd7CB8UI/UR7Vr0R//CwsFBRv9C5BoR80",
+	"KKQQ9YNAsZPloKhFjju46ZCPfHTbwTr1aMaDK6fUtoWEscQp5ReGVzo1L3FsWrJHI4DDGgCkiURmkim6",
+	"zi+V/ZfK/ktwcnHsnXqHnj/bG/OcTpByqu4/0zf9aej1kx6+NWiZS/aRApcZCg4evMxHPmoAszRtMw3N",
+	"trxbAUumjpNaEbaomF68aB2xs8R3SoTDVPl2VHnfljPaUxi6/NnhAHnt+zXNz1TlpWd+U8/89ACdW+Zn",
+	"VxtOqKJ5h7BoC0UcTBLWCMEeJDEmjjmw+ZmWFX/VjTwfJX/8jFgM5S/R1puuHnmX4mILlhyMkNYYxr4I",
+	"OUoUYyQ1qjTyUaMZDvQ189LgzsqUVW6cWIHw/mqbQ5NRM4JLJbPjCZclOLE4XkZvqZh7XYZvsf9UHHsq",
+	"j8jEzQ+LYPThWa7FFwdQiW2VDwfRiS3CZ4K6h3sIEOdah2Yg93vpzeeyyJBFhszHed+v4mBK2SMWHXxq",
+	"mGIq8TKP0oMN0OzriJ8P77odOyQwahnApjmmCBPYy1Wk1DpcPcCn+ogz8va3lZvpowE4lwGcvzZQqhLT",
+	"sEUTFeNrwsqjp8k7Y0ynv/rEEeB0auPtzfKGTLrvgTcuvuUTR55TqVY3ukrQMscULu/+9DFEev9eioGz",
+	"eJgi/d9dp1CDs8eUbhMWeVfeBdzgi8dL1fXevPOSUkbaQyX/giEoKCt+pQs/ZB8LRlr2XZ5+lT+mHYPZ",
+	"Z1O2pTiuSs4tfPE6CbEofvGr4lu7291/DwDu8ugxidsBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -20,6 +20,8 @@ type (
 		GetLanguageByIETF(ctx context.Context, ietf string) (*model.Language, error)
 		UpdateLanguageByIETF(ctx context.Context, ietf string, data model.SetLanguage, v *model.Language) error
 		DeleteLanguageByIETF(ctx context.Context, ietf string) error
+		PreviewDeleteLanguageByIETF(ctx context.Context, ietf string) (*model.DeleteImpact, error)
+		RestoreLanguageByIETF(ctx context.Context, ietf string) error
 		ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, error)
 		CountLanguage(ctx context.Context, conds any) (int, error)
 
@@ -27,48 +29,116 @@ type (
 		GetWebsiteByDomain(ctx context.Context, domain string) (*model.Website, error)
 		UpdateWebsiteByDomain(ctx context.Context, domain string, data model.SetWebsite, v *model.Website) error
 		DeleteWebsiteByDomain(ctx context.Context, domain string) error
+		PreviewDeleteWebsiteByDomain(ctx context.Context, domain string) (*model.DeleteImpact, error)
+		RestoreWebsiteByDomain(ctx context.Context, domain string) error
 		ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, error)
 		CountWebsite(ctx context.Context, conds any) (int, error)
 		AddWebsiteTLLanguage(ctx context.Context, data model.AddWebsiteTLLanguage, v *model.WebsiteTLLanguage) error
 		GetWebsiteTLLanguageBySID(ctx context.Context, sid model.WebsiteTLLanguageSID) (*model.WebsiteTLLanguage, error)
 		UpdateWebsiteTLLanguageBySID(ctx context.Context, sid model.WebsiteTLLanguageSID, data model.SetWebsiteTLLanguage, v *model.WebsiteTLLanguage) error
 		DeleteWebsiteTLLanguageBySID(ctx context.Context, sid model.WebsiteTLLanguageSID) error
+		ListWebsiteTLLanguage(ctx context.Context, params model.ListParams) ([]*model.WebsiteTLLanguage, error)
+		CountWebsiteTLLanguage(ctx context.Context, conds any) (int, error)
 
 		AddLink(ctx context.Context, data model.AddLink, v *model.Link) error
+		UpsertLink(ctx context.Context, data model.AddLink, v *model.Link) (bool, error)
 		GetLinkBySID(ctx context.Context, sid model.LinkSID) (*model.Link, error)
+		ResolveLinkURL(ctx context.Context, rawURL string) (*model.Link, error)
 		UpdateLinkBySID(ctx context.Context, sid model.LinkSID, data model.SetLink, v *model.Link) error
 		DeleteLinkBySID(ctx context.Context, sid model.LinkSID) error
+		RestoreLinkBySID(ctx context.Context, sid model.LinkSID) error
 		ListLink(ctx context.Context, params model.ListParams) ([]*model.Link, error)
 		CountLink(ctx context.Context, conds any) (int, error)
 		AddLinkTLLanguage(ctx context.Context, data model.AddLinkTLLanguage, v *model.LinkTLLanguage) error
+		UpsertLinkTLLanguage(ctx context.Context, data model.AddLinkTLLanguage, v *model.LinkTLLanguage) (bool, error)
 		GetLinkTLLanguageBySID(ctx context.Context, sid model.LinkTLLanguageSID) (*model.LinkTLLanguage, error)
 		UpdateLinkTLLanguageBySID(ctx context.Context, sid model.LinkTLLanguageSID, data model.SetLinkTLLanguage, v *model.LinkTLLanguage) error
 		DeleteLinkTLLanguageBySID(ctx context.Context, sid model.LinkTLLanguageSID) error
+		ListLinkTLLanguage(ctx context.Context, params model.ListParams) ([]*model.LinkTLLanguage, error)
+		CountLinkTLLanguage(ctx context.Context, conds any) (int, error)
+
+		AddCreator(ctx context.Context, data model.AddCreator, v *model.Creator) error
+		GetCreatorBySlug(ctx context.Context, slug string) (*model.Creator, error)
+		UpdateCreatorBySlug(ctx context.Context, slug string, data model.SetCreator, v *model.Creator) error
+		DeleteCreatorBySlug(ctx context.Context, slug string) error
+		ListCreator(ctx context.Context, params model.ListParams) ([]*model.Creator, error)
+		CountCreator(ctx context.Context, conds any) (int, error)
+		AddCreatorLink(ctx context.Context, data model.AddCreatorLink, v *model.CreatorLink) error
+		GetCreatorLinkBySID(ctx context.Context, sid model.CreatorLinkSID) (*model.CreatorLink, error)
+		UpdateCreatorLinkBySID(ctx context.Context, sid model.CreatorLinkSID, data model.SetCreatorLink, v *model.CreatorLink) error
+		DeleteCreatorLinkBySID(ctx context.Context, sid model.CreatorLinkSID) error
+
+		AddTag(ctx context.Context, data model.AddTag, v *model.Tag) error
+		GetTagBySID(ctx context.Context, sid model.TagSID) (*model.Tag, error)
+		UpdateTagBySID(ctx context.Context, sid model.TagSID, data model.SetTag, v *model.Tag) error
+		DeleteTagBySID(ctx context.Context, sid model.TagSID) error
+		ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, error)
+		CountTag(ctx context.Context, conds any) (int, error)
+		ListTagFacet(ctx context.Context, comicConds any, params model.ListParams) ([]*model.TagFacet, error)
+		CountTagFacet(ctx context.Context, comicConds any, conds any) (int, error)
+
+		Search(ctx context.Context, params model.SearchParams) ([]*model.SearchResult, error)
+		CountSearch(ctx context.Context, params model.SearchParams) (int, error)
+
+		ListTrash(ctx context.Context, params model.TrashParams) ([]*model.TrashItem, error)
+		CountTrash(ctx context.Context, params model.TrashParams) (int, error)
+
+		ListAuditEvent(ctx context.Context, params model.AuditParams) ([]*model.AuditEvent, error)
+		CountAuditEvent(ctx context.Context, params model.AuditParams) (int, error)
+
+		RunBatch(ctx context.Context, data model.Batch) ([]*model.BatchResult, error)
 
 		// Comic
 		AddComic(ctx context.Context, data model.AddComic, v *model.Comic) error
-		GetComicByCode(ctx context.Context, code string) (*model.Comic, error)
-		UpdateComicByCode(ctx context.Context, code string, data model.SetComic, v *model.Comic) error
+		GetComicByCode(ctx context.Context, code string, include model.ComicInclude) (*model.Comic, error)
+		UpdateComicByCode(ctx context.Context, code string, data model.SetComic, include model.ComicInclude, v *model.Comic) error
 		DeleteComicByCode(ctx context.Context, code string) error
-		ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, error)
+		RestoreComicByCode(ctx context.Context, code string) error
+		ListComic(ctx context.Context, params model.ListParams, include model.ComicInclude) ([]*model.Comic, error)
 		CountComic(ctx context.Context, conds any) (int, error)
 		ExistsComicByCode(ctx context.Context, code string) (bool, error)
+		AddComicTitle(ctx context.Context, data model.AddComicTitle, v *model.ComicTitle) error
+		GetComicTitleBySID(ctx context.Context, sid model.ComicTitleSID) (*model.ComicTitle, error)
+		UpdateComicTitleBySID(ctx context.Context, sid model.ComicTitleSID, data model.SetComicTitle, v *model.ComicTitle) error
+		DeleteComicTitleBySID(ctx context.Context, sid model.ComicTitleSID) error
+		ListComicTitle(ctx context.Context, params model.ListParams) ([]*model.ComicTitle, error)
+		CountComicTitle(ctx context.Context, conds any) (int, error)
 		AddComicLink(ctx context.Context, data model.AddComicLink, v *model.ComicLink) error
+		UpsertComicLink(ctx context.Context, data model.AddComicLink, v *model.ComicLink) (bool, error)
 		GetComicLinkBySID(ctx context.Context, sid model.ComicLinkSID) (*model.ComicLink, error)
 		UpdateComicLinkBySID(ctx context.Context, sid model.ComicLinkSID, data model.SetComicLink, v *model.ComicLink) error
 		DeleteComicLinkBySID(ctx context.Context, sid model.ComicLinkSID) error
+		ListComicLink(ctx context.Context, params model.ListParams) ([]*model.ComicLink, error)
+		CountComicLink(ctx context.Context, conds any) (int, error)
+		AddComicCreator(ctx context.Context, data model.AddComicCreator, v *model.ComicCreator) error
+		GetComicCreatorBySID(ctx context.Context, sid model.ComicCreatorSID) (*model.ComicCreator, error)
+		UpdateComicCreatorBySID(ctx context.Context, sid model.ComicCreatorSID, data model.SetComicCreator, v *model.ComicCreator) error
+		DeleteComicCreatorBySID(ctx context.Context, sid model.ComicCreatorSID) error
+		ListComicCreator(ctx context.Context, params model.ListParams) ([]*model.ComicCreator, error)
+		CountComicCreator(ctx context.Context, conds any) (int, error)
+		AddComicTag(ctx context.Context, data model.AddComicTag, v *model.ComicTag) error
+		GetComicTagBySID(ctx context.Context, sid model.ComicTagSID) (*model.ComicTag, error)
+		UpdateComicTagBySID(ctx context.Context, sid model.ComicTagSID, data model.SetComicTag, v *model.ComicTag) error
+		DeleteComicTagBySID(ctx context.Context, sid model.ComicTagSID) error
+		ListComicTag(ctx context.Context, params model.ListParams) ([]*model.ComicTag, error)
+		CountComicTag(ctx context.Context, conds any) (int, error)
 		// Comic Chapter
 		AddComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) error
+		UpsertComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) (bool, error)
 		GetComicChapterBySID(ctx context.Context, sid model.ComicChapterSID) (*model.ComicChapter, error)
 		UpdateComicChapterBySID(ctx context.Context, sid model.ComicChapterSID, data model.SetComicChapter, v *model.ComicChapter) error
 		DeleteComicChapterBySID(ctx context.Context, sid model.ComicChapterSID) error
+		RestoreComicChapterBySID(ctx context.Context, sid model.ComicChapterSID) error
 		ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, error)
 		CountComicChapter(ctx context.Context, conds any) (int, error)
 		ExistsComicChapterBySID(ctx context.Context, sid model.ComicChapterSID) (bool, error)
 		AddComicChapterLink(ctx context.Context, data model.AddComicChapterLink, v *model.ComicChapterLink) error
+		UpsertComicChapterLink(ctx context.Context, data model.AddComicChapterLink, v *model.ComicChapterLink) (bool, error)
 		GetComicChapterLinkBySID(ctx context.Context, sid model.ComicChapterLinkSID) (*model.ComicChapterLink, error)
 		UpdateComicChapterLinkBySID(ctx context.Context, sid model.ComicChapterLinkSID, data model.SetComicChapterLink, v *model.ComicChapterLink) error
 		DeleteComicChapterLinkBySID(ctx context.Context, sid model.ComicChapterLinkSID) error
+		ListComicChapterLink(ctx context.Context, params model.ListParams) ([]*model.ComicChapterLink, error)
+		CountComicChapterLink(ctx context.Context, conds any) (int, error)
 	}
 
 	OAuth interface {
@@ -0,0 +1,127 @@
+package rapi
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func modelAuditEvent(m *model.AuditEvent) AuditEvent {
+	result := AuditEvent{
+		ID:        m.ID,
+		Actor:     m.Actor,
+		Operation: m.Operation,
+		Entity:    m.Entity,
+		EntitySID: m.EntitySID,
+		ComicID:   m.ComicID,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.BeforeData != nil {
+		result.Before = &m.BeforeData
+	}
+	if m.AfterData != nil {
+		result.After = &m.AfterData
+	}
+	return result
+}
+
+func (api *api) ListAuditEvent(w http.ResponseWriter, r *http.Request, params ListAuditEventParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.AuditPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	auditParams := model.AuditParams{
+		Entity:     params.Entity,
+		Operation:  params.Operation,
+		Actor:      params.Actor,
+		ComicCode:  params.Code,
+		Pagination: &pagination,
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountAuditEvent(ctx, auditParams)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count audit event failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListAuditEvent(ctx, auditParams)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List audit event failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []AuditEvent
+	for _, r := range result0 {
+		result = append(result, modelAuditEvent(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
+func (api *api) ListComicHistory(w http.ResponseWriter, r *http.Request, code string, params ListComicHistoryParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.AuditPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	auditParams := model.AuditParams{ComicCode: &code, Pagination: &pagination}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountAuditEvent(ctx, auditParams)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count comic history failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListAuditEvent(ctx, auditParams)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic history failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []AuditEvent
+	for _, r := range result0 {
+		result = append(result, modelAuditEvent(r))
+	}
+	response(w, result, http.StatusOK)
+}
@@ -0,0 +1,89 @@
+package rapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func modelBatchResult(m *model.BatchResult) BatchResult {
+	var message *string
+	if m.Err != nil {
+		message0, _ := serviceErrMessage(m.Err)
+		message = &message0
+	}
+	return BatchResult{
+		Status:  m.Status,
+		Message: message,
+	}
+}
+
+func (api *api) RunBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data0 RunBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+		responseErr(w, "Bad request body.", http.StatusBadRequest)
+		log.ErrMessage(err, "Run batch decode json body failed.")
+		return
+	}
+	data := model.Batch{Mode: model.BatchModeAtomic}
+	if data0.Mode != nil {
+		data.Mode = *data0.Mode
+	}
+	for _, operation0 := range data0.Operations {
+		operation := model.BatchOperation{Action: operation0.Action}
+		switch operation0.Action {
+		case model.BatchActionAddLink:
+			if link := operation0.Link; link != nil {
+				operation.AddLink = &model.AddLink{
+					WebsiteID:     link.WebsiteID,
+					WebsiteDomain: link.WebsiteDomain,
+					RelativeURL:   link.RelativeURL,
+					MachineTL:     link.MachineTL,
+				}
+			}
+		case model.BatchActionDeleteLink:
+			if operation0.LinkWebsiteDomain != nil && operation0.LinkRelativeURL != nil {
+				operation.LinkSID = &model.LinkSID{
+					WebsiteDomain: operation0.LinkWebsiteDomain,
+					RelativeURL:   *operation0.LinkRelativeURL,
+				}
+			}
+		case model.BatchActionAddComicChapter:
+			if chapter := operation0.ComicChapter; chapter != nil {
+				operation.AddComicChapter = &model.AddComicChapter{
+					ComicCode:  operation0.ComicCode,
+					Chapter:    chapter.Chapter,
+					Version:    chapter.Version,
+					ReleasedAt: chapter.ReleasedAt,
+					Links:      addNestedLinks(chapter.Links),
+				}
+			}
+		case model.BatchActionDeleteComicChapter:
+			if operation0.ComicCode != nil && operation0.Chapter != nil {
+				operation.ComicChapterSID = &model.ComicChapterSID{
+					ComicCode: operation0.ComicCode,
+					Chapter:   *operation0.Chapter,
+					Version:   operation0.Version,
+				}
+			}
+		}
+		data.Operations = append(data.Operations, operation)
+	}
+
+	result0, err := api.service.RunBatch(ctx, data)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Run batch failed.")
+		return
+	}
+
+	result := make([]BatchResult, 0, len(result0))
+	for _, r := range result0 {
+		result = append(result, modelBatchResult(r))
+	}
+	response(w, result, http.StatusOK)
+}
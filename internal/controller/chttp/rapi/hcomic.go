@@ -19,6 +19,14 @@ func modelComic(m *model.Comic) Comic {
 	return Comic{
 		ID:        m.ID,
 		Code:      m.Code,
+		Status:    m.Status,
+		Type:      m.Type,
+		StartYear: m.StartYear,
+		Synopsis:  m.Synopsis,
+		CoverURL:  m.CoverURL,
+		Titles:    slicesModel(m.Titles, modelComicTitle),
+		Creators:  slicesModel(m.Creators, modelComicCreator),
+		Tags:      slicesModel(m.Tags, modelComicTag),
 		Links:     slicesModel(m.Links, modelLink),
 		Chapters:  slicesModel(m.Chapters, modelComicChapter),
 		CreatedAt: m.CreatedAt,
@@ -26,6 +34,19 @@ func modelComic(m *model.Comic) Comic {
 	}
 }
 
+func addNestedComicChapters(cs []NewComicChapter) []model.AddComicChapter {
+	ms := make([]model.AddComicChapter, 0, len(cs))
+	for _, c := range cs {
+		ms = append(ms, model.AddComicChapter{
+			Chapter:    c.Chapter,
+			Version:    c.Version,
+			ReleasedAt: c.ReleasedAt,
+			Links:      addNestedLinks(c.Links),
+		})
+	}
+	return ms
+}
+
 func (api *api) AddComic(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -40,7 +61,15 @@ func (api *api) AddComic(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		data = model.AddComic{
-			Code: data0.Code,
+			Code:      data0.Code,
+			Title:     data0.Title,
+			Status:    data0.Status,
+			Type:      data0.Type,
+			StartYear: data0.StartYear,
+			Synopsis:  data0.Synopsis,
+			CoverURL:  data0.CoverURL,
+			Links:     addNestedLinks(data0.Links),
+			Chapters:  addNestedComicChapters(data0.Chapters),
 		}
 	case "application/x-www-form-urlencoded":
 		if err := r.ParseForm(); err != nil {
@@ -55,7 +84,15 @@ func (api *api) AddComic(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		data = model.AddComic{
-			Code: data0.Code,
+			Code:      data0.Code,
+			Title:     data0.Title,
+			Status:    data0.Status,
+			Type:      data0.Type,
+			StartYear: data0.StartYear,
+			Synopsis:  data0.Synopsis,
+			CoverURL:  data0.CoverURL,
+			Links:     addNestedLinks(data0.Links),
+			Chapters:  addNestedComicChapters(data0.Chapters),
 		}
 	}
 
@@ -67,25 +104,32 @@ func (api *api) AddComic(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.Code)
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
 	response(w, modelComic(result), http.StatusCreated)
 }
 
-func (api *api) GetComic(w http.ResponseWriter, r *http.Request, code string) {
+func (api *api) GetComic(w http.ResponseWriter, r *http.Request, code string, params GetComicParams) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
 
-	result, err := api.service.GetComicByCode(ctx, code)
+	include := queryComicInclude(params.Include, params.IncludeLimit, params.Fields)
+
+	result, err := api.service.GetComicByCode(ctx, code, include)
 	if err != nil {
 		responseServiceErr(w, err)
 		log.ErrMessage(err, "Get comic failed.")
 		return
 	}
 
-	response(w, modelComic(result), http.StatusOK)
+	body := responseFields(modelComic(result), params.Fields)
+	if responseNotModified(w, r, etagBody(result.CreatedAt, result.UpdatedAt, body)) {
+		return
+	}
+	response(w, body, http.StatusOK)
 }
 
-func (api *api) UpdateComic(w http.ResponseWriter, r *http.Request, code string) {
-	ctx := r.Context()
+func (api *api) UpdateComic(w http.ResponseWriter, r *http.Request, code string, params UpdateComicParams) {
+	ctx := queryIfMatch(r)
 	log := api.logger.WithContext(ctx)
 
 	var data model.SetComic
@@ -98,7 +142,13 @@ func (api *api) UpdateComic(w http.ResponseWriter, r *http.Request, code string)
 			return
 		}
 		data = model.SetComic{
-			Code: data0.Code,
+			Code:      data0.Code,
+			Status:    data0.Status,
+			Type:      data0.Type,
+			StartYear: data0.StartYear,
+			Synopsis:  data0.Synopsis,
+			CoverURL:  data0.CoverURL,
+			SetNull:   data0.SetNull,
 		}
 	case "application/x-www-form-urlencoded":
 		if err := r.ParseForm(); err != nil {
@@ -113,12 +163,20 @@ func (api *api) UpdateComic(w http.ResponseWriter, r *http.Request, code string)
 			return
 		}
 		data = model.SetComic{
-			Code: data0.Code,
+			Code:      data0.Code,
+			Status:    data0.Status,
+			Type:      data0.Type,
+			StartYear: data0.StartYear,
+			Synopsis:  data0.Synopsis,
+			CoverURL:  data0.CoverURL,
+			SetNull:   data0.SetNull,
 		}
 	}
 
+	include := queryComicInclude(params.Include, params.IncludeLimit, params.Fields)
+
 	result := new(model.Comic)
-	if err := api.service.UpdateComicByCode(ctx, code, data, result); err != nil {
+	if err := api.service.UpdateComicByCode(ctx, code, data, include, result); err != nil {
 		if errors.As(err, &model.ErrNotFound) {
 			w.WriteHeader(http.StatusNoContent)
 			return
@@ -130,23 +188,750 @@ func (api *api) UpdateComic(w http.ResponseWriter, r *http.Request, code string)
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.Code)
-	response(w, modelComic(result), http.StatusOK)
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
+	response(w, responseFields(modelComic(result), params.Fields), http.StatusOK)
+}
+
+func (api *api) DeleteComic(w http.ResponseWriter, r *http.Request, code string) {
+	ctx := queryIfMatch(r)
+	log := api.logger.WithContext(ctx)
+
+	if err := api.service.DeleteComicByCode(ctx, code); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Delete comic failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *api) RestoreComic(w http.ResponseWriter, r *http.Request, code string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	if err := api.service.RestoreComicByCode(ctx, code); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Restore comic failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *api) ListComic(w http.ResponseWriter, r *http.Request, params ListComicParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		keyset, err := queryCursor(*params.Cursor)
+		if err != nil {
+			responseServiceErr(w, err)
+			return
+		}
+		pagination.Keyset = keyset
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions, err := queryComicTagConditions(params.Tag, params.ExcludeTag, params.TagMatch)
+	if err != nil {
+		responseServiceErr(w, err)
+		return
+	}
+	if params.Q != nil {
+		conditions = append(conditions, queryComicSearchConditions(*params.Q))
+	}
+	if params.Code != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBComicCode, Value: *params.Code})
+	}
+	if params.Status != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBComicStatus, Value: *params.Status})
+	}
+	if params.Type != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBComicType, Value: *params.Type})
+	}
+	if params.StartYear != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBComicStartYear, Value: *params.StartYear})
+	}
+	conditions = append(conditions, queryTimeRange(model.DBGenericCreatedAt, params.CreatedAfter, params.CreatedBefore)...)
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountComic(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count comic failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	include := queryComicInclude(params.Include, params.IncludeLimit, params.Fields)
+
+	result0, err := api.service.ListComic(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	}, include)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	responseNextLink(w, r, pagination.Keyset)
+	var result []Comic
+	for _, r := range result0 {
+		result = append(result, modelComic(r))
+	}
+	response(w, responseFields(result, params.Fields), http.StatusOK)
+}
+
+// Comic Title
+
+func modelComicTitle(m *model.ComicTitle) ComicTitle {
+	return ComicTitle{
+		ID:           m.ID,
+		RID:          m.RID,
+		LanguageID:   m.LanguageID,
+		LanguageIETF: m.LanguageIETF,
+		Title:        m.Title,
+		IsPrimary:    m.IsPrimary,
+		Romanized:    m.Romanized,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+func (api *api) AddComicTitle(w http.ResponseWriter, r *http.Request, code string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data model.AddComicTitle
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 AddComicTitleJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add comic title decode json body failed.")
+			return
+		}
+		data = model.AddComicTitle{
+			ComicID:      nil,
+			ComicCode:    &code,
+			LanguageID:   data0.LanguageID,
+			LanguageIETF: data0.LanguageIETF,
+			Title:        data0.Title,
+			IsPrimary:    data0.IsPrimary,
+			Romanized:    data0.Romanized,
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add comic title parse form failed.")
+			return
+		}
+		var data0 AddComicTitleFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add comic title decode form data failed.")
+			return
+		}
+		data = model.AddComicTitle{
+			ComicID:      nil,
+			ComicCode:    &code,
+			LanguageID:   data0.LanguageID,
+			LanguageIETF: data0.LanguageIETF,
+			Title:        data0.Title,
+			IsPrimary:    data0.IsPrimary,
+			Romanized:    data0.Romanized,
+		}
+	}
+
+	result := new(model.ComicTitle)
+	if err := api.service.AddComicTitle(ctx, data, result); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Add comic title failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+result.RID)
+	response(w, modelComicTitle(result), http.StatusCreated)
+}
+
+func (api *api) GetComicTitle(w http.ResponseWriter, r *http.Request, code string, rid string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	result, err := api.service.GetComicTitleBySID(ctx, model.ComicTitleSID{
+		ComicCode: &code,
+		RID:       rid,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Get comic title failed.")
+		return
+	}
+
+	response(w, modelComicTitle(result), http.StatusOK)
+}
+
+func (api *api) UpdateComicTitle(w http.ResponseWriter, r *http.Request, code string, rid string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data model.SetComicTitle
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 UpdateComicTitleJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update comic title decode json body failed.")
+			return
+		}
+		data = model.SetComicTitle{
+			ComicID:      nil,
+			ComicCode:    nil,
+			LanguageID:   data0.LanguageID,
+			LanguageIETF: data0.LanguageIETF,
+			Title:        data0.Title,
+			IsPrimary:    data0.IsPrimary,
+			Romanized:    data0.Romanized,
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update comic title parse form failed.")
+			return
+		}
+		var data0 UpdateComicTitleFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update comic title decode form data failed.")
+			return
+		}
+		data = model.SetComicTitle{
+			ComicID:      nil,
+			ComicCode:    nil,
+			LanguageID:   data0.LanguageID,
+			LanguageIETF: data0.LanguageIETF,
+			Title:        data0.Title,
+			IsPrimary:    data0.IsPrimary,
+			Romanized:    data0.Romanized,
+		}
+	}
+
+	result := new(model.ComicTitle)
+	if err := api.service.UpdateComicTitleBySID(ctx, model.ComicTitleSID{
+		ComicCode: &code,
+		RID:       rid,
+	}, data, result); err != nil {
+		if errors.As(err, &model.ErrNotFound) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Update comic title failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path)
+	response(w, modelComicTitle(result), http.StatusOK)
+}
+
+func (api *api) DeleteComicTitle(w http.ResponseWriter, r *http.Request, code string, rid string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	if err := api.service.DeleteComicTitleBySID(ctx, model.ComicTitleSID{
+		ComicCode: &code,
+		RID:       rid,
+	}); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Delete comic title failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *api) ListComicTitle(w http.ResponseWriter, r *http.Request, code string, params ListComicTitleParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicGenericComicID,
+		Value: model.DBComicCodeToID(code),
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountComicTitle(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count comic title failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListComicTitle(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic title failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []ComicTitle
+	for _, r := range result0 {
+		result = append(result, modelComicTitle(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
+// Comic Creator
+
+func modelComicCreator(m *model.ComicCreator) ComicCreator {
+	return ComicCreator{
+		ComicID:     m.ComicID,
+		ComicCode:   m.ComicCode,
+		CreatorID:   m.CreatorID,
+		CreatorSlug: m.CreatorSlug,
+		CreatorName: m.CreatorName,
+		Role:        m.Role,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func (api *api) AddComicCreator(w http.ResponseWriter, r *http.Request, code string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data model.AddComicCreator
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 AddComicCreatorJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add comic creator decode json body failed.")
+			return
+		}
+		data = model.AddComicCreator{
+			ComicID:     nil,
+			ComicCode:   &code,
+			CreatorID:   data0.CreatorID,
+			CreatorSlug: data0.CreatorSlug,
+			Role:        data0.Role,
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add comic creator parse form failed.")
+			return
+		}
+		var data0 AddComicCreatorFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add comic creator decode form data failed.")
+			return
+		}
+		data = model.AddComicCreator{
+			ComicID:     nil,
+			ComicCode:   &code,
+			CreatorID:   data0.CreatorID,
+			CreatorSlug: data0.CreatorSlug,
+			Role:        data0.Role,
+		}
+	}
+
+	result := new(model.ComicCreator)
+	if err := api.service.AddComicCreator(ctx, data, result); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Add comic creator failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+result.CreatorSlug+"+"+result.Role)
+	response(w, modelComicCreator(result), http.StatusCreated)
+}
+
+func (api *api) GetComicCreator(w http.ResponseWriter, r *http.Request, code string, creatorSlug string, role string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	result, err := api.service.GetComicCreatorBySID(ctx, model.ComicCreatorSID{
+		ComicCode:   &code,
+		CreatorSlug: &creatorSlug,
+		Role:        role,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Get comic creator failed.")
+		return
+	}
+
+	response(w, modelComicCreator(result), http.StatusOK)
+}
+
+func (api *api) UpdateComicCreator(w http.ResponseWriter, r *http.Request, code string, creatorSlug string, role string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data model.SetComicCreator
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 UpdateComicCreatorJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update comic creator decode json body failed.")
+			return
+		}
+		data = model.SetComicCreator{
+			ComicID:     nil,
+			ComicCode:   nil,
+			CreatorID:   data0.CreatorID,
+			CreatorSlug: data0.CreatorSlug,
+			Role:        data0.Role,
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update comic creator parse form failed.")
+			return
+		}
+		var data0 UpdateComicCreatorFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update comic creator decode form data failed.")
+			return
+		}
+		data = model.SetComicCreator{
+			ComicID:     nil,
+			ComicCode:   nil,
+			CreatorID:   data0.CreatorID,
+			CreatorSlug: data0.CreatorSlug,
+			Role:        data0.Role,
+		}
+	}
+
+	result := new(model.ComicCreator)
+	if err := api.service.UpdateComicCreatorBySID(ctx, model.ComicCreatorSID{
+		ComicCode:   &code,
+		CreatorSlug: &creatorSlug,
+		Role:        role,
+	}, data, result); err != nil {
+		if errors.As(err, &model.ErrNotFound) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Update comic creator failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+result.CreatorSlug+"+"+result.Role)
+	response(w, modelComicCreator(result), http.StatusOK)
+}
+
+func (api *api) DeleteComicCreator(w http.ResponseWriter, r *http.Request, code string, creatorSlug string, role string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	if err := api.service.DeleteComicCreatorBySID(ctx, model.ComicCreatorSID{
+		ComicCode:   &code,
+		CreatorSlug: &creatorSlug,
+		Role:        role,
+	}); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Delete comic creator failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *api) ListComicCreator(w http.ResponseWriter, r *http.Request, code string, params ListComicCreatorParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicGenericComicID,
+		Value: model.DBComicCodeToID(code),
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountComicCreator(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count comic creator failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListComicCreator(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic creator failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []ComicCreator
+	for _, r := range result0 {
+		result = append(result, modelComicCreator(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
+// Comic Tag
+
+func modelComicTag(m *model.ComicTag) ComicTag {
+	return ComicTag{
+		ComicID:      m.ComicID,
+		ComicCode:    m.ComicCode,
+		TagID:        m.TagID,
+		TagNamespace: m.TagNamespace,
+		TagSlug:      m.TagSlug,
+		TagNames:     m.TagNames,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+func (api *api) AddComicTag(w http.ResponseWriter, r *http.Request, code string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data model.AddComicTag
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 AddComicTagJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add comic tag decode json body failed.")
+			return
+		}
+		data = model.AddComicTag{
+			ComicID:   nil,
+			ComicCode: &code,
+			TagID:     data0.TagID,
+		}
+		if data0.TagNamespace != nil && data0.TagSlug != nil {
+			data.TagSID = &model.TagSID{
+				Namespace: *data0.TagNamespace,
+				Slug:      *data0.TagSlug,
+			}
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add comic tag parse form failed.")
+			return
+		}
+		var data0 AddComicTagFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add comic tag decode form data failed.")
+			return
+		}
+		data = model.AddComicTag{
+			ComicID:   nil,
+			ComicCode: &code,
+			TagID:     data0.TagID,
+		}
+		if data0.TagNamespace != nil && data0.TagSlug != nil {
+			data.TagSID = &model.TagSID{
+				Namespace: *data0.TagNamespace,
+				Slug:      *data0.TagSlug,
+			}
+		}
+	}
+
+	result := new(model.ComicTag)
+	if err := api.service.AddComicTag(ctx, data, result); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Add comic tag failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+result.TagNamespace+"/"+result.TagSlug)
+	response(w, modelComicTag(result), http.StatusCreated)
+}
+
+func (api *api) GetComicTag(w http.ResponseWriter, r *http.Request, code string, tagNamespace string, tagSlug string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	result, err := api.service.GetComicTagBySID(ctx, model.ComicTagSID{
+		ComicCode: &code,
+		TagSID:    &model.TagSID{Namespace: tagNamespace, Slug: tagSlug},
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Get comic tag failed.")
+		return
+	}
+
+	response(w, modelComicTag(result), http.StatusOK)
+}
+
+func (api *api) UpdateComicTag(w http.ResponseWriter, r *http.Request, code string, tagNamespace string, tagSlug string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data model.SetComicTag
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 UpdateComicTagJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update comic tag decode json body failed.")
+			return
+		}
+		data = model.SetComicTag{
+			ComicID:   nil,
+			ComicCode: nil,
+			TagID:     data0.TagID,
+		}
+		if data0.TagNamespace != nil && data0.TagSlug != nil {
+			data.TagSID = &model.TagSID{
+				Namespace: *data0.TagNamespace,
+				Slug:      *data0.TagSlug,
+			}
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update comic tag parse form failed.")
+			return
+		}
+		var data0 UpdateComicTagFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update comic tag decode form data failed.")
+			return
+		}
+		data = model.SetComicTag{
+			ComicID:   nil,
+			ComicCode: nil,
+			TagID:     data0.TagID,
+		}
+		if data0.TagNamespace != nil && data0.TagSlug != nil {
+			data.TagSID = &model.TagSID{
+				Namespace: *data0.TagNamespace,
+				Slug:      *data0.TagSlug,
+			}
+		}
+	}
+
+	result := new(model.ComicTag)
+	if err := api.service.UpdateComicTagBySID(ctx, model.ComicTagSID{
+		ComicCode: &code,
+		TagSID:    &model.TagSID{Namespace: tagNamespace, Slug: tagSlug},
+	}, data, result); err != nil {
+		if errors.As(err, &model.ErrNotFound) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Update comic tag failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+result.TagNamespace+"/"+result.TagSlug)
+	response(w, modelComicTag(result), http.StatusOK)
 }
 
-func (api *api) DeleteComic(w http.ResponseWriter, r *http.Request, code string) {
+func (api *api) DeleteComicTag(w http.ResponseWriter, r *http.Request, code string, tagNamespace string, tagSlug string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
 
-	if err := api.service.DeleteComicByCode(ctx, code); err != nil {
+	if err := api.service.DeleteComicTagBySID(ctx, model.ComicTagSID{
+		ComicCode: &code,
+		TagSID:    &model.TagSID{Namespace: tagNamespace, Slug: tagSlug},
+	}); err != nil {
 		responseServiceErr(w, err)
-		log.ErrMessage(err, "Delete comic failed.")
+		log.ErrMessage(err, "Delete comic tag failed.")
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
-func (api *api) ListComic(w http.ResponseWriter, r *http.Request, params ListComicParams) {
+func (api *api) ListComicTag(w http.ResponseWriter, r *http.Request, code string, params ListComicTagParams) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
 
@@ -163,33 +948,44 @@ func (api *api) ListComic(w http.ResponseWriter, r *http.Request, params ListCom
 		orderBys = queryOrderBys(*params.OrderBy)
 	}
 
-	totalCountCh := make(chan int, 1)
-	go func() {
-		count, err := api.service.CountComic(ctx, nil)
-		if err != nil {
-			totalCountCh <- -1
-			log.ErrMessage(err, "Count comic failed.")
-			return
-		}
-		totalCountCh <- count
-	}()
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicGenericComicID,
+		Value: model.DBComicCodeToID(code),
+	}
 
-	result0, err := api.service.ListComic(ctx, model.ListParams{
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountComicTag(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count comic tag failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListComicTag(ctx, model.ListParams{
+		Conditions: conditions,
 		OrderBys:   orderBys,
 		Pagination: &pagination,
 	})
 	if err != nil {
 		responseServiceErr(w, err)
-		log.ErrMessage(err, "List comic failed.")
+		log.ErrMessage(err, "List comic tag failed.")
 		return
 	}
 
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
-	var result []Comic
+	var result []ComicTag
 	for _, r := range result0 {
-		result = append(result, modelComic(r))
+		result = append(result, modelComicTag(r))
 	}
 	response(w, result, http.StatusOK)
 }
@@ -299,6 +1095,37 @@ func (api *api) GetComicLink(w http.ResponseWriter, r *http.Request, code string
 	response(w, modelComicLink(result), http.StatusOK)
 }
 
+func (api *api) PutComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	relativeURL, err := url.QueryUnescape(relativeURL)
+	if err != nil {
+		responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+		return
+	}
+
+	data := model.AddComicLink{
+		ComicCode: &code,
+		LinkSID:   &model.LinkSID{WebsiteDomain: &websiteDomain, RelativeURL: relativeURL},
+	}
+
+	result := new(model.ComicLink)
+	created, err := api.service.UpsertComicLink(ctx, data, result)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Put comic link failed.")
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		w.Header().Set("Location", r.URL.Path)
+		status = http.StatusCreated
+	}
+	response(w, modelComicLink(result), status)
+}
+
 func (api *api) UpdateComicLink(w http.ResponseWriter, r *http.Request, code string, websiteDomain string, relativeURL string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -395,6 +1222,65 @@ func (api *api) DeleteComicLink(w http.ResponseWriter, r *http.Request, code str
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) ListComicLink(w http.ResponseWriter, r *http.Request, code string, params ListComicLinkParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.ComicLinkPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicGenericComicID,
+		Value: model.DBComicCodeToID(code),
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountComicLink(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count comic link failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListComicLink(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic link failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []ComicLink
+	for _, r := range result0 {
+		result = append(result, modelComicLink(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
 //
 // Comic Chapter
 //
@@ -405,6 +1291,7 @@ func modelComicChapter(m *model.ComicChapter) ComicChapter {
 		Chapter:    m.Chapter,
 		Version:    m.Version,
 		ReleasedAt: m.ReleasedAt,
+		Number:     m.Number,
 		Links:      slicesModel(m.Links, modelLink),
 		CreatedAt:  m.CreatedAt,
 		UpdatedAt:  m.UpdatedAt,
@@ -430,6 +1317,7 @@ func (api *api) AddComicChapter(w http.ResponseWriter, r *http.Request, code str
 			Chapter:    data0.Chapter,
 			Version:    data0.Version,
 			ReleasedAt: data0.ReleasedAt,
+			Links:      addNestedLinks(data0.Links),
 		}
 	case "application/x-www-form-urlencoded":
 		if err := r.ParseForm(); err != nil {
@@ -449,6 +1337,7 @@ func (api *api) AddComicChapter(w http.ResponseWriter, r *http.Request, code str
 			Chapter:    data0.Chapter,
 			Version:    data0.Version,
 			ReleasedAt: data0.ReleasedAt,
+			Links:      addNestedLinks(data0.Links),
 		}
 	}
 
@@ -465,6 +1354,7 @@ func (api *api) AddComicChapter(w http.ResponseWriter, r *http.Request, code str
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+slug)
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
 	response(w, modelComicChapter(result), http.StatusCreated)
 }
 
@@ -494,13 +1384,76 @@ func (api *api) GetComicChapter(w http.ResponseWriter, r *http.Request, code str
 		return
 	}
 
-	response(w, modelComicChapter(result), http.StatusOK)
+	body := modelComicChapter(result)
+	if responseNotModified(w, r, etagBody(result.CreatedAt, result.UpdatedAt, body)) {
+		return
+	}
+	response(w, body, http.StatusOK)
 }
 
-func (api *api) UpdateComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
+func (api *api) PutComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
 
+	chapterRaw, versionRaw, versionOK := strings.Cut(cv, "+")
+	var version *string
+	if versionOK {
+		version = &versionRaw
+	}
+	chapter, err := url.QueryUnescape(chapterRaw)
+	if err != nil {
+		responseErr(w, "Invalid comic chapter chapter.", http.StatusBadRequest)
+		return
+	}
+
+	data := model.AddComicChapter{ComicCode: &code, Chapter: chapter, Version: version}
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 PutComicChapterJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Put comic chapter decode json body failed.")
+			return
+		}
+		data.ReleasedAt = data0.ReleasedAt
+		data.Links = addNestedLinks(data0.Links)
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Put comic chapter parse form failed.")
+			return
+		}
+		var data0 PutComicChapterFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Put comic chapter decode form data failed.")
+			return
+		}
+		data.ReleasedAt = data0.ReleasedAt
+		data.Links = addNestedLinks(data0.Links)
+	}
+
+	result := new(model.ComicChapter)
+	created, err := api.service.UpsertComicChapter(ctx, data, result)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Put comic chapter failed.")
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		w.Header().Set("Location", r.URL.Path)
+		status = http.StatusCreated
+	}
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
+	response(w, modelComicChapter(result), status)
+}
+
+func (api *api) UpdateComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
+	ctx := queryIfMatch(r)
+	log := api.logger.WithContext(ctx)
+
 	var data model.SetComicChapter
 	switch r.Header.Get("Content-Type") {
 	case "application/json":
@@ -573,11 +1526,12 @@ func (api *api) UpdateComicChapter(w http.ResponseWriter, r *http.Request, code
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+slug)
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
 	response(w, modelComicChapter(result), http.StatusOK)
 }
 
 func (api *api) DeleteComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
-	ctx := r.Context()
+	ctx := queryIfMatch(r)
 	log := api.logger.WithContext(ctx)
 
 	chapterRaw, versionRaw, versionOK := strings.Cut(cv, "+")
@@ -604,6 +1558,34 @@ func (api *api) DeleteComicChapter(w http.ResponseWriter, r *http.Request, code
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) RestoreComicChapter(w http.ResponseWriter, r *http.Request, code string, cv string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	chapterRaw, versionRaw, versionOK := strings.Cut(cv, "+")
+	var version *string
+	if versionOK {
+		version = &versionRaw
+	}
+	chapter, err := url.QueryUnescape(chapterRaw)
+	if err != nil {
+		responseErr(w, "Invalid comic chapter chapter.", http.StatusBadRequest)
+		return
+	}
+
+	if err := api.service.RestoreComicChapterBySID(ctx, model.ComicChapterSID{
+		ComicCode: &code,
+		Chapter:   chapter,
+		Version:   version,
+	}); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Restore comic chapter failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (api *api) ListComicChapter(w http.ResponseWriter, r *http.Request, code string, params ListComicChapterParams) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -615,27 +1597,46 @@ func (api *api) ListComicChapter(w http.ResponseWriter, r *http.Request, code st
 	if params.Limit != nil {
 		pagination.Limit = *params.Limit
 	}
+	if params.Cursor != nil {
+		keyset, err := queryCursor(*params.Cursor)
+		if err != nil {
+			responseServiceErr(w, err)
+			return
+		}
+		pagination.Keyset = keyset
+	}
 
 	var orderBys model.OrderBys
 	if params.OrderBy != nil {
 		orderBys = queryOrderBys(*params.OrderBy)
 	}
 
-	conditions := model.DBConditionalKV{
+	conditions := []any{model.DBLogicalAND{}, model.DBConditionalKV{
 		Key:   model.DBComicGenericComicID,
 		Value: model.DBComicCodeToID(code),
+	}}
+	if params.Chapter != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBComicChapterChapter, Value: *params.Chapter})
+	}
+	if params.Version != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBComicChapterVersion, Value: *params.Version})
+	}
+	conditions = append(conditions, queryTimeRange(model.DBComicChapterReleasedAt, params.ReleasedAfter, params.ReleasedBefore)...)
+	conditions = append(conditions, queryTimeRange(model.DBGenericCreatedAt, params.CreatedAfter, params.CreatedBefore)...)
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountComicChapter(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count comic chapter failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
 	}
-
-	totalCountCh := make(chan int, 1)
-	go func() {
-		count, err := api.service.CountComicChapter(ctx, conditions)
-		if err != nil {
-			totalCountCh <- -1
-			log.ErrMessage(err, "Count comic chapter failed.")
-			return
-		}
-		totalCountCh <- count
-	}()
 
 	result0, err := api.service.ListComicChapter(ctx, model.ListParams{
 		Conditions: conditions,
@@ -649,8 +1650,11 @@ func (api *api) ListComicChapter(w http.ResponseWriter, r *http.Request, code st
 	}
 
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	responseNextLink(w, r, pagination.Keyset)
 	var result []ComicChapter
 	for _, r := range result0 {
 		result = append(result, modelComicChapter(r))
@@ -775,6 +1779,48 @@ func (api *api) GetComicChapterLink(w http.ResponseWriter, r *http.Request, code
 	response(w, modelComicChapterLink(result), http.StatusOK)
 }
 
+func (api *api) PutComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	chapterRaw, versionRaw, versionOK := strings.Cut(cv, "+")
+	var version *string
+	if versionOK {
+		version = &versionRaw
+	}
+	chapter, err := url.QueryUnescape(chapterRaw)
+	if err != nil {
+		responseErr(w, "Invalid comic chapter chapter.", http.StatusBadRequest)
+		return
+	}
+
+	relativeURL, err = url.QueryUnescape(relativeURL)
+	if err != nil {
+		responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+		return
+	}
+
+	data := model.AddComicChapterLink{
+		ChapterSID: &model.ComicChapterSID{ComicCode: &code, Chapter: chapter, Version: version},
+		LinkSID:    &model.LinkSID{WebsiteDomain: &websiteDomain, RelativeURL: relativeURL},
+	}
+
+	result := new(model.ComicChapterLink)
+	created, err := api.service.UpsertComicChapterLink(ctx, data, result)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Put comic chapter link failed.")
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		w.Header().Set("Location", r.URL.Path)
+		status = http.StatusCreated
+	}
+	response(w, modelComicChapterLink(result), status)
+}
+
 func (api *api) UpdateComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, websiteDomain string, relativeURL string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -892,3 +1938,73 @@ func (api *api) DeleteComicChapterLink(w http.ResponseWriter, r *http.Request, c
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (api *api) ListComicChapterLink(w http.ResponseWriter, r *http.Request, code string, cv string, params ListComicChapterLinkParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	chapterRaw, versionRaw, versionOK := strings.Cut(cv, "+")
+	var version *string
+	if versionOK {
+		version = &versionRaw
+	}
+	chapter, err := url.QueryUnescape(chapterRaw)
+	if err != nil {
+		responseErr(w, "Invalid comic chapter chapter.", http.StatusBadRequest)
+		return
+	}
+
+	pagination := model.Pagination{Page: 1, Limit: model.ComicChapterLinkPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBComicChapterGenericChapterID,
+		Value: model.DBComicChapterSIDToID(model.ComicChapterSID{ComicCode: &code, Chapter: chapter, Version: version}),
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountComicChapterLink(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count comic chapter link failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListComicChapterLink(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List comic chapter link failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []ComicChapterLink
+	for _, r := range result0 {
+		result = append(result, modelComicChapterLink(r))
+	}
+	response(w, result, http.StatusOK)
+}
@@ -0,0 +1,479 @@
+package rapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+//
+// Creator
+//
+
+func modelCreator(m *model.Creator) Creator {
+	return Creator{
+		ID:             m.ID,
+		Slug:           m.Slug,
+		Name:           m.Name,
+		Links:          slicesModel(m.Links, modelLink),
+		AlternateNames: m.AlternateNames,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+func (api *api) AddCreator(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data model.AddCreator
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 AddCreatorJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add creator decode json body failed.")
+			return
+		}
+		data = model.AddCreator{
+			Slug:           data0.Slug,
+			Name:           data0.Name,
+			AlternateNames: data0.AlternateNames,
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add creator parse form failed.")
+			return
+		}
+		var data0 AddCreatorFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add creator decode form data failed.")
+			return
+		}
+		data = model.AddCreator{
+			Slug:           data0.Slug,
+			Name:           data0.Name,
+			AlternateNames: data0.AlternateNames,
+		}
+	}
+
+	result := new(model.Creator)
+	if err := api.service.AddCreator(ctx, data, result); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Add creator failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+result.Slug)
+	response(w, modelCreator(result), http.StatusCreated)
+}
+
+func (api *api) GetCreator(w http.ResponseWriter, r *http.Request, slug string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	result, err := api.service.GetCreatorBySlug(ctx, slug)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Get creator failed.")
+		return
+	}
+
+	response(w, modelCreator(result), http.StatusOK)
+}
+
+func (api *api) UpdateCreator(w http.ResponseWriter, r *http.Request, slug string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data model.SetCreator
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 UpdateCreatorJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update creator decode json body failed.")
+			return
+		}
+		data = model.SetCreator{
+			Slug:           data0.Slug,
+			Name:           data0.Name,
+			AlternateNames: data0.AlternateNames,
+			SetNull:        data0.SetNull,
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update creator parse form failed.")
+			return
+		}
+		var data0 UpdateCreatorFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update creator decode form data failed.")
+			return
+		}
+		data = model.SetCreator{
+			Slug:           data0.Slug,
+			Name:           data0.Name,
+			AlternateNames: data0.AlternateNames,
+			SetNull:        data0.SetNull,
+		}
+	}
+
+	result := new(model.Creator)
+	if err := api.service.UpdateCreatorBySlug(ctx, slug, data, result); err != nil {
+		if errors.As(err, &model.ErrNotFound) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Update creator failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+result.Slug)
+	response(w, modelCreator(result), http.StatusOK)
+}
+
+func (api *api) DeleteCreator(w http.ResponseWriter, r *http.Request, slug string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	if err := api.service.DeleteCreatorBySlug(ctx, slug); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Delete creator failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *api) ListCreator(w http.ResponseWriter, r *http.Request, params ListCreatorParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		keyset, err := queryCursor(*params.Cursor)
+		if err != nil {
+			responseServiceErr(w, err)
+			return
+		}
+		pagination.Keyset = keyset
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountCreator(ctx, nil)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count creator failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListCreator(ctx, model.ListParams{
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List creator failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	responseNextLink(w, r, pagination.Keyset)
+	var result []Creator
+	for _, r := range result0 {
+		result = append(result, modelCreator(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
+func (api *api) ListCreatorComic(w http.ResponseWriter, r *http.Request, slug string, params ListCreatorComicParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBCreatorGenericCreatorID,
+		Value: model.DBCreatorSlugToID(slug),
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountComicCreator(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count creator comic failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListComicCreator(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List creator comic failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []ComicCreator
+	for _, r := range result0 {
+		result = append(result, modelComicCreator(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
+// Creator Link
+
+func modelCreatorLink(m *model.CreatorLink) CreatorLink {
+	return CreatorLink{
+		LinkID:            m.LinkID,
+		LinkWebsiteDomain: m.LinkWebsiteDomain,
+		LinkRelativeURL:   m.LinkRelativeURL,
+		CreatedAt:         m.CreatedAt,
+		UpdatedAt:         m.UpdatedAt,
+	}
+}
+
+func (api *api) AddCreatorLink(w http.ResponseWriter, r *http.Request, slug string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data model.AddCreatorLink
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 AddCreatorLinkJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add creator link decode json body failed.")
+			return
+		}
+		data = model.AddCreatorLink{
+			CreatorID:   nil,
+			CreatorSlug: &slug,
+			LinkID:      data0.LinkID,
+		}
+		if data0.LinkWebsiteDomain != nil && data0.LinkRelativeURL != nil {
+			relativeURL, err := url.QueryUnescape(*data0.LinkRelativeURL)
+			if err != nil {
+				responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+				return
+			}
+			data.LinkSID = &model.LinkSID{
+				WebsiteDomain: data0.LinkWebsiteDomain,
+				RelativeURL:   relativeURL,
+			}
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add creator link parse form failed.")
+			return
+		}
+		var data0 AddCreatorLinkFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add creator link decode form data failed.")
+			return
+		}
+		data = model.AddCreatorLink{
+			CreatorID:   nil,
+			CreatorSlug: &slug,
+			LinkID:      data0.LinkID,
+		}
+		if data0.LinkWebsiteDomain != nil && data0.LinkRelativeURL != nil {
+			relativeURL, err := url.QueryUnescape(*data0.LinkRelativeURL)
+			if err != nil {
+				responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+				return
+			}
+			data.LinkSID = &model.LinkSID{
+				WebsiteDomain: data0.LinkWebsiteDomain,
+				RelativeURL:   relativeURL,
+			}
+		}
+	}
+
+	result := new(model.CreatorLink)
+	if err := api.service.AddCreatorLink(ctx, data, result); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Add creator link failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+result.LinkWebsiteDomain+"-"+url.QueryEscape(result.LinkRelativeURL))
+	response(w, modelCreatorLink(result), http.StatusCreated)
+}
+
+func (api *api) GetCreatorLink(w http.ResponseWriter, r *http.Request, slug string, websiteDomain string, relativeURL string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	relativeURL, err := url.QueryUnescape(relativeURL)
+	if err != nil {
+		responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+		return
+	}
+
+	result, err := api.service.GetCreatorLinkBySID(ctx, model.CreatorLinkSID{
+		CreatorSlug: &slug,
+		LinkSID:     &model.LinkSID{WebsiteDomain: &websiteDomain, RelativeURL: relativeURL},
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Get creator link failed.")
+		return
+	}
+
+	response(w, modelCreatorLink(result), http.StatusOK)
+}
+
+func (api *api) UpdateCreatorLink(w http.ResponseWriter, r *http.Request, slug string, websiteDomain string, relativeURL string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	relativeURL, err := url.QueryUnescape(relativeURL)
+	if err != nil {
+		responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+		return
+	}
+
+	var data model.SetCreatorLink
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 UpdateCreatorLinkJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update creator link decode json body failed.")
+			return
+		}
+		data = model.SetCreatorLink{
+			CreatorID:   nil,
+			CreatorSlug: nil,
+			LinkID:      data0.LinkID,
+		}
+		if data0.LinkWebsiteDomain != nil && data0.LinkRelativeURL != nil {
+			data.LinkSID = &model.LinkSID{
+				WebsiteDomain: data0.LinkWebsiteDomain,
+				RelativeURL:   *data0.LinkRelativeURL,
+			}
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update creator link parse form failed.")
+			return
+		}
+		var data0 UpdateCreatorLinkFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update creator link decode form data failed.")
+			return
+		}
+		data = model.SetCreatorLink{
+			CreatorID:   nil,
+			CreatorSlug: nil,
+			LinkID:      data0.LinkID,
+		}
+		if data0.LinkWebsiteDomain != nil && data0.LinkRelativeURL != nil {
+			data.LinkSID = &model.LinkSID{
+				WebsiteDomain: data0.LinkWebsiteDomain,
+				RelativeURL:   *data0.LinkRelativeURL,
+			}
+		}
+	}
+
+	result := new(model.CreatorLink)
+	if err := api.service.UpdateCreatorLinkBySID(ctx, model.CreatorLinkSID{
+		CreatorSlug: &slug,
+		LinkSID:     &model.LinkSID{WebsiteDomain: &websiteDomain, RelativeURL: relativeURL},
+	}, data, result); err != nil {
+		if errors.As(err, &model.ErrNotFound) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Update creator link failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+result.LinkWebsiteDomain+"-"+url.QueryEscape(result.LinkRelativeURL))
+	response(w, modelCreatorLink(result), http.StatusOK)
+}
+
+func (api *api) DeleteCreatorLink(w http.ResponseWriter, r *http.Request, slug string, websiteDomain string, relativeURL string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	relativeURL, err := url.QueryUnescape(relativeURL)
+	if err != nil {
+		responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+		return
+	}
+
+	if err := api.service.DeleteCreatorLinkBySID(ctx, model.CreatorLinkSID{
+		CreatorSlug: &slug,
+		LinkSID:     &model.LinkSID{WebsiteDomain: &websiteDomain, RelativeURL: relativeURL},
+	}); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Delete creator link failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
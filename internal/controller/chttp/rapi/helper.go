@@ -1,11 +1,19 @@
 package rapi
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-bagicore/internal/controller/chttp/utilb"
 	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
@@ -40,6 +48,148 @@ func queryOrderBys(obs []string) model.OrderBys {
 	return orderBys
 }
 
+func queryInsensitiveContains(s string) model.DBInsensitiveLike {
+	s = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+	return model.DBInsensitiveLike("%" + s + "%")
+}
+
+func queryComicSearchConditions(q string) []any {
+	pattern := queryInsensitiveContains(q)
+	return []any{
+		model.DBConditionalKV{Key: model.DBComicCode, Value: pattern},
+		model.DBConditionalKV{Key: model.DBComicCode, Value: model.DBTrigramSimilar(q)},
+		model.DBCrossConditional{Table: model.DBComicTitle, Conditions: []any{
+			model.DBLogicalAND{},
+			model.DBConditionalKV{
+				Key:   model.DBComicGenericComicID,
+				Value: model.DBColumnValue(model.DBComic + "." + model.DBGenericID),
+			},
+			[]any{
+				model.DBConditionalKV{Key: model.DBComicTitleTitle, Value: pattern},
+				model.DBConditionalKV{Key: model.DBComicTitleTitle, Value: model.DBTrigramSimilar(q)},
+			},
+		}},
+	}
+}
+
+func queryComicInclude(include *[]string, limit *int, fields *[]string) model.ComicInclude {
+	result := model.ComicInclude{Relations: model.ComicIncludeDef.Relations}
+	if include != nil {
+		result.Relations = *include
+	}
+	if limit != nil {
+		result.Limit = *limit
+	}
+	if fields != nil {
+		result.Relations = slices.DeleteFunc(slices.Clone(result.Relations), func(relation string) bool {
+			field, _, _ := strings.Cut(relation, ".")
+			return !slices.Contains(*fields, field)
+		})
+	}
+	return result
+}
+
+func queryNullableBoolean(key, value string) (model.DBConditionalKV, error) {
+	switch value {
+	case "true":
+		return model.DBConditionalKV{Key: key, Value: model.DBBooleanIs(true)}, nil
+	case "false":
+		return model.DBConditionalKV{Key: key, Value: model.DBBooleanIs(false)}, nil
+	case "null":
+		return model.DBConditionalKV{Key: key, Value: model.DBIsNull{}}, nil
+	}
+	return model.DBConditionalKV{}, model.GenericError(value + " is not true, false or null")
+}
+
+func queryTimeRange(key string, after, before *time.Time) []any {
+	var conditions []any
+	if after != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: key, Value: model.DBGreaterThan{Value: *after}})
+	}
+	if before != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: key, Value: model.DBLessThan{Value: *before}})
+	}
+	return conditions
+}
+
+func queryTagSIDs(tags []string) ([]model.TagSID, error) {
+	var sids []model.TagSID
+	for _, tag := range tags {
+		namespace, slug, ok := strings.Cut(tag, ":")
+		if !ok {
+			return nil, model.GenericError("tag " + tag + " must be in namespace:slug format")
+		}
+
+		if err := (model.SetTag{Namespace: &namespace, Slug: &slug}).Validate(); err != nil {
+			return nil, model.GenericError("tag " + err.Error())
+		}
+
+		sids = append(sids, model.TagSID{Namespace: namespace, Slug: slug})
+	}
+	return sids, nil
+}
+
+func queryComicTagConditions(tags, excludeTags *[]string, tagMatch *string) ([]any, error) {
+	conditions := []any{model.DBLogicalAND{}}
+	if tags != nil && len(*tags) > 0 {
+		sids, err := queryTagSIDs(*tags)
+		if err != nil {
+			return nil, err
+		}
+
+		if tagMatch != nil && *tagMatch == "any" {
+			conditions = append(conditions, model.DBComicTagged(sids...))
+		} else {
+			for _, sid := range sids {
+				conditions = append(conditions, model.DBComicTagged(sid))
+			}
+		}
+	}
+	if excludeTags != nil && len(*excludeTags) > 0 {
+		sids, err := queryTagSIDs(*excludeTags)
+		if err != nil {
+			return nil, err
+		}
+
+		conditions = append(conditions, model.DBComicNotTagged(sids...))
+	}
+	return conditions, nil
+}
+
+func queryCursor(cursor string) (*model.Keyset, error) {
+	keyset := &model.Keyset{}
+	if cursor == "" {
+		return keyset, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, model.GenericError("cursor is not valid")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&keyset.After); err != nil || len(keyset.After) < 1 {
+		return nil, model.GenericError("cursor is not valid")
+	}
+	for i, value := range keyset.After {
+		switch value := value.(type) {
+		case nil, string, bool:
+			// Noop
+		case json.Number:
+			if n, err := value.Int64(); err == nil {
+				keyset.After[i] = n
+			} else if n, err := value.Float64(); err == nil {
+				keyset.After[i] = n
+			} else {
+				return nil, model.GenericError("cursor is not valid")
+			}
+		default:
+			return nil, model.GenericError("cursor is not valid")
+		}
+	}
+	return keyset, nil
+}
+
 func formDecode(form url.Values, v any) error {
 	return utilb.FormDecoder.Decode(v, form)
 }
@@ -55,10 +205,119 @@ func slicesModel[E, M any](ms []*M, mf func(*M) E) *[]E {
 	return es
 }
 
+// queryIfMatch carries the versions of the If-Match header of r in its
+// context, a wildcard matches any version so it carries none.
+func queryIfMatch(r *http.Request) context.Context {
+	ctx := r.Context()
+	header := strings.Join(r.Header.Values("If-Match"), ",")
+	if header == "" || strings.TrimSpace(header) == "*" {
+		return ctx
+	}
+
+	versions := []time.Time{}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+		version, _, _ := strings.Cut(tag[1:len(tag)-1], "-")
+		micro, err := strconv.ParseInt(version, 36, 64)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, time.UnixMicro(micro).UTC())
+	}
+	return model.ContextWithIfMatch(ctx, versions)
+}
+
+// etag is the entity tag of a row derived from its version, it covers the row
+// itself and not the relations embedded along it. Writes respond with it for
+// If-Match, reads with etagBody.
+func etag(createdAt time.Time, updatedAt *time.Time) string {
+	return `"` + strconv.FormatInt(model.Version(createdAt, updatedAt).UnixMicro(), 36) + `"`
+}
+
+// etagBody is the entity tag of the body v of a row read, the version of the
+// row is followed by a hash of v so the relations embedded along it and the
+// fields picked are covered too. If-Match only looks at the version.
+func etagBody(createdAt time.Time, updatedAt *time.Time, v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return etag(createdAt, updatedAt)
+	}
+	sum := sha256.Sum256(data)
+	return strings.TrimSuffix(etag(createdAt, updatedAt), `"`) + "-" + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// responseNotModified sets the entity tag and responds 304 if it matches the
+// If-None-Match header of r, which is then reported.
+func responseNotModified(w http.ResponseWriter, r *http.Request, tag string) bool {
+	w.Header().Set("ETag", tag)
+	header := strings.Join(r.Header.Values("If-None-Match"), ",")
+	for _, match := range strings.Split(header, ",") {
+		match = strings.TrimPrefix(strings.TrimSpace(match), "W/")
+		if match == "*" || match == tag {
+			w.WriteHeader(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
+
 func response(w http.ResponseWriter, v any, code int) {
 	utilb.ResponseJSON(w, v, code)
 }
 
+func responseNextLink(w http.ResponseWriter, r *http.Request, keyset *model.Keyset) {
+	if keyset == nil || len(keyset.Next) < 1 {
+		return
+	}
+
+	data, err := json.Marshal(keyset.Next)
+	if err != nil {
+		return
+	}
+	query := r.URL.Query()
+	query.Del("page")
+	query.Set("cursor", base64.RawURLEncoding.EncodeToString(data))
+	w.Header().Set("Link", "<"+r.URL.Path+"?"+query.Encode()+">; rel=\"next\"")
+}
+
+// responseFields keeps only the requested top level fields of an object or
+// of every object in a list.
+func responseFields(v any, fields *[]string) any {
+	if fields == nil {
+		return v
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var v0 any
+	if err := json.Unmarshal(data, &v0); err != nil {
+		return v
+	}
+	pick := func(v any) {
+		if m, ok := v.(map[string]any); ok {
+			for key := range m {
+				if !slices.Contains(*fields, key) {
+					delete(m, key)
+				}
+			}
+		}
+	}
+	switch v0 := v0.(type) {
+	case []any:
+		for _, v := range v0 {
+			pick(v)
+		}
+	default:
+		pick(v0)
+	}
+	return v0
+}
+
 type errorData = struct {
 	Message string `json:"message"`
 	Status  string `json:"status"`
@@ -80,14 +339,21 @@ func responseErr500(w http.ResponseWriter) {
 }
 
 func responseServiceErr(w http.ResponseWriter, err error) {
+	message, status := serviceErrMessage(err)
+	responseErr(w, message, status)
+}
+
+func serviceErrMessage(err error) (string, int) {
 	switch {
 	case errors.As(err, &model.ErrNotFound):
-		responseErr404(w)
+		return "Not found.", http.StatusNotFound
+	case errors.As(err, &model.ErrPrecondition):
+		return "Precondition failed.", http.StatusPreconditionFailed
 	case errors.As(err, &model.ErrGeneric):
-		responseErr(w, utila.CapitalPeriod(err.Error()), http.StatusBadRequest)
+		return utila.CapitalPeriod(err.Error()), http.StatusBadRequest
 	case errors.As(err, &model.ErrDatabase):
-		responseErr(w, "Database has encountered a problem.", http.StatusInternalServerError)
+		return "Database has encountered a problem.", http.StatusInternalServerError
 	default:
-		responseErr500(w)
+		return "Internal server error.", http.StatusInternalServerError
 	}
 }
@@ -62,6 +62,7 @@ func (api *api) AddLanguage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.IETF)
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
 	response(w, modelLanguage(result), http.StatusCreated)
 }
 
@@ -76,11 +77,15 @@ func (api *api) GetLanguage(w http.ResponseWriter, r *http.Request, ietf string)
 		return
 	}
 
-	response(w, modelLanguage(result), http.StatusOK)
+	body := modelLanguage(result)
+	if responseNotModified(w, r, etagBody(result.CreatedAt, result.UpdatedAt, body)) {
+		return
+	}
+	response(w, body, http.StatusOK)
 }
 
 func (api *api) UpdateLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
-	ctx := r.Context()
+	ctx := queryIfMatch(r)
 	log := api.logger.WithContext(ctx)
 
 	var data model.SetLanguage
@@ -127,13 +132,26 @@ func (api *api) UpdateLanguage(w http.ResponseWriter, r *http.Request, ietf stri
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.IETF)
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
 	response(w, modelLanguage(result), http.StatusOK)
 }
 
-func (api *api) DeleteLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
-	ctx := r.Context()
+func (api *api) DeleteLanguage(w http.ResponseWriter, r *http.Request, ietf string, params DeleteLanguageParams) {
+	ctx := queryIfMatch(r)
 	log := api.logger.WithContext(ctx)
 
+	if params.DryRun != nil && *params.DryRun {
+		result, err := api.service.PreviewDeleteLanguageByIETF(ctx, ietf)
+		if err != nil {
+			responseServiceErr(w, err)
+			log.ErrMessage(err, "Preview delete language failed.")
+			return
+		}
+
+		response(w, modelDeleteImpact(result), http.StatusOK)
+		return
+	}
+
 	if err := api.service.DeleteLanguageByIETF(ctx, ietf); err != nil {
 		responseServiceErr(w, err)
 		log.ErrMessage(err, "Delete language failed.")
@@ -143,6 +161,19 @@ func (api *api) DeleteLanguage(w http.ResponseWriter, r *http.Request, ietf stri
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) RestoreLanguage(w http.ResponseWriter, r *http.Request, ietf string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	if err := api.service.RestoreLanguageByIETF(ctx, ietf); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Restore language failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (api *api) ListLanguage(w http.ResponseWriter, r *http.Request, params ListLanguageParams) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -154,24 +185,45 @@ func (api *api) ListLanguage(w http.ResponseWriter, r *http.Request, params List
 	if params.Limit != nil {
 		pagination.Limit = *params.Limit
 	}
+	if params.Cursor != nil {
+		keyset, err := queryCursor(*params.Cursor)
+		if err != nil {
+			responseServiceErr(w, err)
+			return
+		}
+		pagination.Keyset = keyset
+	}
 
 	var orderBys model.OrderBys
 	if params.OrderBy != nil {
 		orderBys = queryOrderBys(*params.OrderBy)
 	}
 
-	totalCountCh := make(chan int, 1)
-	go func() {
-		count, err := api.service.CountLanguage(ctx, nil)
-		if err != nil {
-			totalCountCh <- -1
-			log.ErrMessage(err, "Count language failed.")
-			return
-		}
-		totalCountCh <- count
-	}()
+	conditions := []any{model.DBLogicalAND{}}
+	if params.IETF != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBLanguageIETF, Value: *params.IETF})
+	}
+	if params.Name != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBLanguageName, Value: queryInsensitiveContains(*params.Name)})
+	}
+	conditions = append(conditions, queryTimeRange(model.DBGenericCreatedAt, params.CreatedAfter, params.CreatedBefore)...)
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountLanguage(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count language failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
 
 	result0, err := api.service.ListLanguage(ctx, model.ListParams{
+		Conditions: conditions,
 		OrderBys:   orderBys,
 		Pagination: &pagination,
 	})
@@ -182,11 +234,126 @@ func (api *api) ListLanguage(w http.ResponseWriter, r *http.Request, params List
 	}
 
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	responseNextLink(w, r, pagination.Keyset)
 	var result []Language
 	for _, r := range result0 {
 		result = append(result, modelLanguage(r))
 	}
 	response(w, result, http.StatusOK)
 }
+
+func (api *api) ListLanguageWebsite(w http.ResponseWriter, r *http.Request, ietf string, params ListLanguageWebsiteParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.WebsitePaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBWebsiteTranslated(ietf)
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountWebsite(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count language website failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListWebsite(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List language website failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []Website
+	for _, r := range result0 {
+		result = append(result, modelWebsite(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
+func (api *api) ListLanguageLink(w http.ResponseWriter, r *http.Request, ietf string, params ListLanguageLinkParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.LinkPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBLinkTranslated(ietf)
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountLink(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count language link failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListLink(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List language link failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []Link
+	for _, r := range result0 {
+		result = append(result, modelLink(r))
+	}
+	response(w, result, http.StatusOK)
+}
@@ -23,6 +23,19 @@ func modelLink(m *model.Link) Link {
 	}
 }
 
+func addNestedLinks(ls []NewNestedLink) []model.AddLink {
+	ms := make([]model.AddLink, 0, len(ls))
+	for i := range ls {
+		l := ls[i]
+		ms = append(ms, model.AddLink{
+			WebsiteDomain: &l.WebsiteDomain,
+			RelativeURL:   l.RelativeURL,
+			MachineTL:     l.MachineTL,
+		})
+	}
+	return ms
+}
+
 func (api *api) AddLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -40,6 +53,7 @@ func (api *api) AddLink(w http.ResponseWriter, r *http.Request) {
 			WebsiteID:     data0.WebsiteID,
 			WebsiteDomain: data0.WebsiteDomain,
 			RelativeURL:   data0.RelativeURL,
+			URL:           data0.URL,
 			MachineTL:     data0.MachineTL,
 		}
 	case "application/x-www-form-urlencoded":
@@ -58,6 +72,7 @@ func (api *api) AddLink(w http.ResponseWriter, r *http.Request) {
 			WebsiteID:     data0.WebsiteID,
 			WebsiteDomain: data0.WebsiteDomain,
 			RelativeURL:   data0.RelativeURL,
+			URL:           data0.URL,
 			MachineTL:     data0.MachineTL,
 		}
 	}
@@ -70,9 +85,28 @@ func (api *api) AddLink(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.WebsiteDomain+"-"+url.QueryEscape(result.RelativeURL))
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
 	response(w, modelLink(result), http.StatusCreated)
 }
 
+func (api *api) ResolveLink(w http.ResponseWriter, r *http.Request, params ResolveLinkParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	result, err := api.service.ResolveLinkURL(ctx, params.URL)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Resolve link failed.")
+		return
+	}
+
+	body := modelLink(result)
+	if responseNotModified(w, r, etagBody(result.CreatedAt, result.UpdatedAt, body)) {
+		return
+	}
+	response(w, body, http.StatusOK)
+}
+
 func (api *api) GetLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -93,10 +127,14 @@ func (api *api) GetLink(w http.ResponseWriter, r *http.Request, websiteDomain st
 		return
 	}
 
-	response(w, modelLink(result), http.StatusOK)
+	body := modelLink(result)
+	if responseNotModified(w, r, etagBody(result.CreatedAt, result.UpdatedAt, body)) {
+		return
+	}
+	response(w, body, http.StatusOK)
 }
 
-func (api *api) UpdateLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
+func (api *api) PutLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
 
@@ -106,6 +144,58 @@ func (api *api) UpdateLink(w http.ResponseWriter, r *http.Request, websiteDomain
 		return
 	}
 
+	data := model.AddLink{WebsiteDomain: &websiteDomain, RelativeURL: relativeURL}
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 PutLinkJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Put link decode json body failed.")
+			return
+		}
+		data.MachineTL = data0.MachineTL
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Put link parse form failed.")
+			return
+		}
+		var data0 PutLinkFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Put link decode form data failed.")
+			return
+		}
+		data.MachineTL = data0.MachineTL
+	}
+
+	result := new(model.Link)
+	created, err := api.service.UpsertLink(ctx, data, result)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Put link failed.")
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		w.Header().Set("Location", r.URL.Path)
+		status = http.StatusCreated
+	}
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
+	response(w, modelLink(result), status)
+}
+
+func (api *api) UpdateLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
+	ctx := queryIfMatch(r)
+	log := api.logger.WithContext(ctx)
+
+	relativeURL, err := url.QueryUnescape(relativeURL)
+	if err != nil {
+		responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+		return
+	}
+
 	var data model.SetLink
 	switch r.Header.Get("Content-Type") {
 	case "application/json":
@@ -159,11 +249,12 @@ func (api *api) UpdateLink(w http.ResponseWriter, r *http.Request, websiteDomain
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.WebsiteDomain+"-"+url.QueryEscape(result.RelativeURL))
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
 	response(w, modelLink(result), http.StatusOK)
 }
 
 func (api *api) DeleteLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
-	ctx := r.Context()
+	ctx := queryIfMatch(r)
 	log := api.logger.WithContext(ctx)
 
 	relativeURL, err := url.QueryUnescape(relativeURL)
@@ -184,6 +275,28 @@ func (api *api) DeleteLink(w http.ResponseWriter, r *http.Request, websiteDomain
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) RestoreLink(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	relativeURL, err := url.QueryUnescape(relativeURL)
+	if err != nil {
+		responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+		return
+	}
+
+	if err := api.service.RestoreLinkBySID(ctx, model.LinkSID{
+		WebsiteDomain: &websiteDomain,
+		RelativeURL:   relativeURL,
+	}); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Restore link failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (api *api) ListLink(w http.ResponseWriter, r *http.Request, params ListLinkParams) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -195,24 +308,53 @@ func (api *api) ListLink(w http.ResponseWriter, r *http.Request, params ListLink
 	if params.Limit != nil {
 		pagination.Limit = *params.Limit
 	}
+	if params.Cursor != nil {
+		keyset, err := queryCursor(*params.Cursor)
+		if err != nil {
+			responseServiceErr(w, err)
+			return
+		}
+		pagination.Keyset = keyset
+	}
 
 	var orderBys model.OrderBys
 	if params.OrderBy != nil {
 		orderBys = queryOrderBys(*params.OrderBy)
 	}
 
-	totalCountCh := make(chan int, 1)
-	go func() {
-		count, err := api.service.CountLink(ctx, nil)
+	conditions := []any{model.DBLogicalAND{}}
+	if params.WebsiteDomain != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBWebsiteGenericWebsiteID, Value: model.DBWebsiteDomainToID(*params.WebsiteDomain)})
+	}
+	if params.RelativeURL != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBLinkRelativeURL, Value: queryInsensitiveContains(*params.RelativeURL)})
+	}
+	if params.MachineTL != nil {
+		machineTL, err := queryNullableBoolean(model.DBLinkMachineTL, *params.MachineTL)
 		if err != nil {
-			totalCountCh <- -1
-			log.ErrMessage(err, "Count link failed.")
+			responseServiceErr(w, err)
 			return
 		}
-		totalCountCh <- count
-	}()
+		conditions = append(conditions, machineTL)
+	}
+	conditions = append(conditions, queryTimeRange(model.DBGenericCreatedAt, params.CreatedAfter, params.CreatedBefore)...)
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountLink(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count link failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
 
 	result0, err := api.service.ListLink(ctx, model.ListParams{
+		Conditions: conditions,
 		OrderBys:   orderBys,
 		Pagination: &pagination,
 	})
@@ -223,8 +365,11 @@ func (api *api) ListLink(w http.ResponseWriter, r *http.Request, params ListLink
 	}
 
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	responseNextLink(w, r, pagination.Keyset)
 	var result []Link
 	for _, r := range result0 {
 		result = append(result, modelLink(r))
@@ -232,6 +377,130 @@ func (api *api) ListLink(w http.ResponseWriter, r *http.Request, params ListLink
 	response(w, result, http.StatusOK)
 }
 
+func (api *api) ListLinkComic(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, params ListLinkComicParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	relativeURL, err := url.QueryUnescape(relativeURL)
+	if err != nil {
+		responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+		return
+	}
+
+	pagination := model.Pagination{Page: 1, Limit: model.ComicPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBComicLinked(model.LinkSID{WebsiteDomain: &websiteDomain, RelativeURL: relativeURL})
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountComic(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count link comic failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListComic(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	}, model.ComicIncludeDef)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List link comic failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []Comic
+	for _, r := range result0 {
+		result = append(result, modelComic(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
+func (api *api) ListLinkComicChapter(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, params ListLinkComicChapterParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	relativeURL, err := url.QueryUnescape(relativeURL)
+	if err != nil {
+		responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+		return
+	}
+
+	pagination := model.Pagination{Page: 1, Limit: model.ComicChapterPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBComicChapterLinked(model.LinkSID{WebsiteDomain: &websiteDomain, RelativeURL: relativeURL})
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountComicChapter(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count link comic chapter failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListComicChapter(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List link comic chapter failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []ComicChapter
+	for _, r := range result0 {
+		result = append(result, modelComicChapter(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
 func modelLinkTLLanguage(m *model.LinkTLLanguage) LinkTLLanguage {
 	return LinkTLLanguage{
 		LanguageID:   m.LanguageID,
@@ -320,6 +589,37 @@ func (api *api) GetLinkTLLanguage(w http.ResponseWriter, r *http.Request, websit
 	response(w, modelLinkTLLanguage(result), http.StatusOK)
 }
 
+func (api *api) PutLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	relativeURL, err := url.QueryUnescape(relativeURL)
+	if err != nil {
+		responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+		return
+	}
+
+	data := model.AddLinkTLLanguage{
+		LinkSID:      &model.LinkSID{WebsiteDomain: &websiteDomain, RelativeURL: relativeURL},
+		LanguageIETF: &ietf,
+	}
+
+	result := new(model.LinkTLLanguage)
+	created, err := api.service.UpsertLinkTLLanguage(ctx, data, result)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Put link tl language failed.")
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		w.Header().Set("Location", r.URL.Path)
+		status = http.StatusCreated
+	}
+	response(w, modelLinkTLLanguage(result), status)
+}
+
 func (api *api) UpdateLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, ietf string) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -405,3 +705,68 @@ func (api *api) DeleteLinkTLLanguage(w http.ResponseWriter, r *http.Request, web
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (api *api) ListLinkTLLanguage(w http.ResponseWriter, r *http.Request, websiteDomain string, relativeURL string, params ListLinkTLLanguageParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	relativeURL, err := url.QueryUnescape(relativeURL)
+	if err != nil {
+		responseErr(w, "Invalid link relative url.", http.StatusBadRequest)
+		return
+	}
+
+	pagination := model.Pagination{Page: 1, Limit: model.LinkTLLanguagePaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBLinkGenericLinkID,
+		Value: model.DBLinkSIDToID(model.LinkSID{WebsiteDomain: &websiteDomain, RelativeURL: relativeURL}),
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountLinkTLLanguage(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count link tl language failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListLinkTLLanguage(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List link tl language failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []LinkTLLanguage
+	for _, r := range result0 {
+		result = append(result, modelLinkTLLanguage(r))
+	}
+	response(w, result, http.StatusOK)
+}
@@ -0,0 +1,93 @@
+package rapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/logger"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+// testListService keeps the conditions the last list was given.
+type testListService struct {
+	Service
+	conditions any
+}
+
+func (svc *testListService) ListLink(ctx context.Context, params model.ListParams) ([]*model.Link, error) {
+	svc.conditions = params.Conditions
+	return nil, nil
+}
+
+func (svc *testListService) CountLink(ctx context.Context, conds any) (int, error) {
+	return 0, nil
+}
+
+func (svc *testListService) ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, error) {
+	svc.conditions = params.Conditions
+	return nil, nil
+}
+
+func (svc *testListService) CountWebsite(ctx context.Context, conds any) (int, error) {
+	return 0, nil
+}
+
+type testOAuth struct{}
+
+func (testOAuth) ProcessTokenContext(ctx context.Context) (bool, error) { return false, nil }
+func (testOAuth) IsTokenExpiredError(err error) bool                    { return false }
+
+func testHandler(t *testing.T, svc Service) http.Handler {
+	sapi, err := GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+	iapi := NewAPI(svc, testOAuth{}, logger.New())
+	mux := chi.NewRouter()
+	mux.Route("/api", func(mux chi.Router) {
+		HandlerFromMuxWithBaseURL(iapi, mux.With(Middleware(sapi, iapi.Authentication)), "/v0")
+	})
+	return mux
+}
+
+func TestListMachineTL(t *testing.T) {
+	svc := &testListService{}
+	handler := testHandler(t, svc)
+
+	tests := []struct {
+		value  string
+		status int
+		want   any
+	}{
+		{"true", http.StatusOK, model.DBBooleanIs(true)},
+		{"false", http.StatusOK, model.DBBooleanIs(false)},
+		{"null", http.StatusOK, model.DBIsNull{}},
+		{"maybe", http.StatusBadRequest, nil},
+	}
+	for _, path := range []string{"/api/v0/links", "/api/v0/websites"} {
+		for _, tt := range tests {
+			svc.conditions = nil
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path+"?machine_tl="+tt.value, nil))
+			if w.Code != tt.status {
+				t.Errorf("%s machine_tl=%s status = %d, want %d: %s", path, tt.value, w.Code, tt.status, w.Body)
+				continue
+			}
+			if tt.want == nil {
+				continue
+			}
+			conditions, _ := svc.conditions.([]any)
+			if !slices.ContainsFunc(conditions, func(cond any) bool {
+				kv, ok := cond.(model.DBConditionalKV)
+				return ok && kv.Key == model.DBLinkMachineTL && kv.Value == tt.want
+			}) {
+				t.Errorf("%s machine_tl=%s conditions = %v, want %v", path, tt.value, conditions, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package rapi
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func modelSearchResult(m *model.SearchResult) SearchResult {
+	return SearchResult{
+		Kind:          m.Kind,
+		ID:            m.ID,
+		ComicCode:     m.ComicCode,
+		WebsiteDomain: m.WebsiteDomain,
+		RelativeURL:   m.RelativeURL,
+		Field:         m.Field,
+		Text:          m.Text,
+		Score:         m.Score,
+	}
+}
+
+func (api *api) Search(w http.ResponseWriter, r *http.Request, params SearchParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.SearchPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	searchParams := model.SearchParams{Query: params.Q, Pagination: &pagination}
+	if params.Kind != nil {
+		searchParams.Kinds = *params.Kind
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountSearch(ctx, searchParams)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count search failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.Search(ctx, searchParams)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Search failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []SearchResult
+	for _, r := range result0 {
+		result = append(result, modelSearchResult(r))
+	}
+	response(w, result, http.StatusOK)
+}
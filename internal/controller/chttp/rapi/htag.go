@@ -0,0 +1,296 @@
+package rapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func modelTag(m *model.Tag) Tag {
+	return Tag{
+		ID:        m.ID,
+		Namespace: m.Namespace,
+		Slug:      m.Slug,
+		Names:     m.Names,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func modelTagFacet(m *model.TagFacet) TagFacet {
+	return TagFacet{
+		ID:         m.ID,
+		Namespace:  m.Namespace,
+		Slug:       m.Slug,
+		Names:      m.Names,
+		ComicCount: m.ComicCount,
+		CreatedAt:  m.CreatedAt,
+		UpdatedAt:  m.UpdatedAt,
+	}
+}
+
+func (api *api) AddTag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data model.AddTag
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 AddTagJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add tag decode json body failed.")
+			return
+		}
+		data = model.AddTag{
+			Namespace: data0.Namespace,
+			Slug:      data0.Slug,
+			Names:     data0.Names,
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add tag parse form failed.")
+			return
+		}
+		var data0 AddTagFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Add tag decode form data failed.")
+			return
+		}
+		data = model.AddTag{
+			Namespace: data0.Namespace,
+			Slug:      data0.Slug,
+			Names:     data0.Names,
+		}
+	}
+
+	result := new(model.Tag)
+	if err := api.service.AddTag(ctx, data, result); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Add tag failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+result.Namespace+"/"+result.Slug)
+	response(w, modelTag(result), http.StatusCreated)
+}
+
+func (api *api) GetTag(w http.ResponseWriter, r *http.Request, namespace string, slug string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	result, err := api.service.GetTagBySID(ctx, model.TagSID{Namespace: namespace, Slug: slug})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Get tag failed.")
+		return
+	}
+
+	response(w, modelTag(result), http.StatusOK)
+}
+
+func (api *api) UpdateTag(w http.ResponseWriter, r *http.Request, namespace string, slug string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	var data model.SetTag
+	switch r.Header.Get("Content-Type") {
+	case "application/json":
+		var data0 UpdateTagJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&data0); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update tag decode json body failed.")
+			return
+		}
+		data = model.SetTag{
+			Namespace: data0.Namespace,
+			Slug:      data0.Slug,
+			Names:     data0.Names,
+			SetNull:   data0.SetNull,
+		}
+	case "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			responseErr(w, "Bad request body.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update tag parse form failed.")
+			return
+		}
+		var data0 UpdateTagFormdataRequestBody
+		if err := formDecode(r.PostForm, &data0); err != nil {
+			responseErr(w, "Bad form data.", http.StatusBadRequest)
+			log.ErrMessage(err, "Update tag decode form data failed.")
+			return
+		}
+		data = model.SetTag{
+			Namespace: data0.Namespace,
+			Slug:      data0.Slug,
+			Names:     data0.Names,
+			SetNull:   data0.SetNull,
+		}
+	}
+
+	result := new(model.Tag)
+	if err := api.service.UpdateTagBySID(ctx, model.TagSID{Namespace: namespace, Slug: slug}, data, result); err != nil {
+		if errors.As(err, &model.ErrNotFound) {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Update tag failed.")
+		return
+	}
+
+	w.Header().Set("Location", r.URL.Path+"/"+result.Namespace+"/"+result.Slug)
+	response(w, modelTag(result), http.StatusOK)
+}
+
+func (api *api) DeleteTag(w http.ResponseWriter, r *http.Request, namespace string, slug string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	if err := api.service.DeleteTagBySID(ctx, model.TagSID{Namespace: namespace, Slug: slug}); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Delete tag failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *api) ListTag(w http.ResponseWriter, r *http.Request, params ListTagParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: 10}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		keyset, err := queryCursor(*params.Cursor)
+		if err != nil {
+			responseServiceErr(w, err)
+			return
+		}
+		pagination.Keyset = keyset
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := map[string]any{}
+	if params.Namespace != nil {
+		conditions[model.DBTagNamespace] = *params.Namespace
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountTag(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count tag failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListTag(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List tag failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	responseNextLink(w, r, pagination.Keyset)
+	var result []Tag
+	for _, r := range result0 {
+		result = append(result, modelTag(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
+func (api *api) ListTagFacet(w http.ResponseWriter, r *http.Request, params ListTagFacetParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.TagFacetPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	comicConditions, err := queryComicTagConditions(params.Tag, params.ExcludeTag, params.TagMatch)
+	if err != nil {
+		responseServiceErr(w, err)
+		return
+	}
+
+	conditions := map[string]any{}
+	if params.Namespace != nil {
+		conditions[model.DBTagNamespace] = *params.Namespace
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountTagFacet(ctx, comicConditions, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count tag facet failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListTagFacet(ctx, comicConditions, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List tag facet failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []TagFacet
+	for _, r := range result0 {
+		result = append(result, modelTagFacet(r))
+	}
+	response(w, result, http.StatusOK)
+}
@@ -0,0 +1,95 @@
+package rapi
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+// modelTrashItem gives the trash item the path it is restored from, relative
+// to base.
+func modelTrashItem(m *model.TrashItem, base string) TrashItem {
+	var path string
+	switch m.Kind {
+	case model.TrashKindLanguage:
+		path = "/languages/" + *m.LanguageIETF
+	case model.TrashKindWebsite:
+		path = "/websites/" + *m.WebsiteDomain
+	case model.TrashKindLink:
+		path = "/links/" + *m.WebsiteDomain + "-" + url.QueryEscape(*m.RelativeURL)
+	case model.TrashKindComic:
+		path = "/comics/" + *m.ComicCode
+	case model.TrashKindComicChapter:
+		path = "/comics/" + *m.ComicCode + "/chapters/" + url.QueryEscape(*m.Chapter)
+		if m.Version != nil {
+			path += "+" + url.QueryEscape(*m.Version)
+		}
+	}
+	return TrashItem{
+		Kind:      m.Kind,
+		ID:        m.ID,
+		Path:      base + path + "/restore",
+		DeletedAt: m.DeletedAt,
+	}
+}
+
+func modelDeleteImpact(m *model.DeleteImpact) DeleteImpact {
+	return DeleteImpact{
+		Trashed: m.Trashed,
+		Purged:  m.Purged,
+	}
+}
+
+func (api *api) ListTrash(w http.ResponseWriter, r *http.Request, params ListTrashParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.TrashPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	trashParams := model.TrashParams{Pagination: &pagination}
+	if params.Kind != nil {
+		trashParams.Kinds = *params.Kind
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountTrash(ctx, trashParams)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count trash failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListTrash(ctx, trashParams)
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List trash failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	base := strings.TrimSuffix(r.URL.Path, "/trash")
+	var result []TrashItem
+	for _, r := range result0 {
+		result = append(result, modelTrashItem(r, base))
+	}
+	response(w, result, http.StatusOK)
+}
@@ -66,6 +66,7 @@ func (api *api) AddWebsite(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.Domain)
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
 	response(w, modelWebsite(result), http.StatusCreated)
 }
 
@@ -80,11 +81,15 @@ func (api *api) GetWebsite(w http.ResponseWriter, r *http.Request, domain string
 		return
 	}
 
-	response(w, modelWebsite(result), http.StatusOK)
+	body := modelWebsite(result)
+	if responseNotModified(w, r, etagBody(result.CreatedAt, result.UpdatedAt, body)) {
+		return
+	}
+	response(w, body, http.StatusOK)
 }
 
 func (api *api) UpdateWebsite(w http.ResponseWriter, r *http.Request, domain string) {
-	ctx := r.Context()
+	ctx := queryIfMatch(r)
 	log := api.logger.WithContext(ctx)
 
 	var data model.SetWebsite
@@ -133,13 +138,26 @@ func (api *api) UpdateWebsite(w http.ResponseWriter, r *http.Request, domain str
 	}
 
 	w.Header().Set("Location", r.URL.Path+"/"+result.Domain)
+	w.Header().Set("ETag", etag(result.CreatedAt, result.UpdatedAt))
 	response(w, modelWebsite(result), http.StatusOK)
 }
 
-func (api *api) DeleteWebsite(w http.ResponseWriter, r *http.Request, domain string) {
-	ctx := r.Context()
+func (api *api) DeleteWebsite(w http.ResponseWriter, r *http.Request, domain string, params DeleteWebsiteParams) {
+	ctx := queryIfMatch(r)
 	log := api.logger.WithContext(ctx)
 
+	if params.DryRun != nil && *params.DryRun {
+		result, err := api.service.PreviewDeleteWebsiteByDomain(ctx, domain)
+		if err != nil {
+			responseServiceErr(w, err)
+			log.ErrMessage(err, "Preview delete website failed.")
+			return
+		}
+
+		response(w, modelDeleteImpact(result), http.StatusOK)
+		return
+	}
+
 	if err := api.service.DeleteWebsiteByDomain(ctx, domain); err != nil {
 		responseServiceErr(w, err)
 		log.ErrMessage(err, "Delete website failed.")
@@ -149,6 +167,19 @@ func (api *api) DeleteWebsite(w http.ResponseWriter, r *http.Request, domain str
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (api *api) RestoreWebsite(w http.ResponseWriter, r *http.Request, domain string) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	if err := api.service.RestoreWebsiteByDomain(ctx, domain); err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "Restore website failed.")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (api *api) ListWebsite(w http.ResponseWriter, r *http.Request, params ListWebsiteParams) {
 	ctx := r.Context()
 	log := api.logger.WithContext(ctx)
@@ -160,24 +191,53 @@ func (api *api) ListWebsite(w http.ResponseWriter, r *http.Request, params ListW
 	if params.Limit != nil {
 		pagination.Limit = *params.Limit
 	}
+	if params.Cursor != nil {
+		keyset, err := queryCursor(*params.Cursor)
+		if err != nil {
+			responseServiceErr(w, err)
+			return
+		}
+		pagination.Keyset = keyset
+	}
 
 	var orderBys model.OrderBys
 	if params.OrderBy != nil {
 		orderBys = queryOrderBys(*params.OrderBy)
 	}
 
-	totalCountCh := make(chan int, 1)
-	go func() {
-		count, err := api.service.CountWebsite(ctx, nil)
+	conditions := []any{model.DBLogicalAND{}}
+	if params.Domain != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBWebsiteDomain, Value: *params.Domain})
+	}
+	if params.Name != nil {
+		conditions = append(conditions, model.DBConditionalKV{Key: model.DBWebsiteName, Value: queryInsensitiveContains(*params.Name)})
+	}
+	if params.MachineTL != nil {
+		machineTL, err := queryNullableBoolean(model.DBWebsiteMachineTL, *params.MachineTL)
 		if err != nil {
-			totalCountCh <- -1
-			log.ErrMessage(err, "Count website failed.")
+			responseServiceErr(w, err)
 			return
 		}
-		totalCountCh <- count
-	}()
+		conditions = append(conditions, machineTL)
+	}
+	conditions = append(conditions, queryTimeRange(model.DBGenericCreatedAt, params.CreatedAfter, params.CreatedBefore)...)
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountWebsite(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count website failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
 
 	result0, err := api.service.ListWebsite(ctx, model.ListParams{
+		Conditions: conditions,
 		OrderBys:   orderBys,
 		Pagination: &pagination,
 	})
@@ -188,8 +248,11 @@ func (api *api) ListWebsite(w http.ResponseWriter, r *http.Request, params ListW
 	}
 
 	wHeader := w.Header()
-	wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
 	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	responseNextLink(w, r, pagination.Keyset)
 	var result []Website
 	for _, r := range result0 {
 		result = append(result, modelWebsite(r))
@@ -197,6 +260,65 @@ func (api *api) ListWebsite(w http.ResponseWriter, r *http.Request, params ListW
 	response(w, result, http.StatusOK)
 }
 
+func (api *api) ListWebsiteLink(w http.ResponseWriter, r *http.Request, domain string, params ListWebsiteLinkParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.LinkPaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBWebsiteGenericWebsiteID,
+		Value: model.DBWebsiteDomainToID(domain),
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountLink(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count website link failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListLink(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List website link failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []Link
+	for _, r := range result0 {
+		result = append(result, modelLink(r))
+	}
+	response(w, result, http.StatusOK)
+}
+
 func modelWebsiteTLLanguage(m *model.WebsiteTLLanguage) WebsiteTLLanguage {
 	return WebsiteTLLanguage{
 		LanguageID:   m.LanguageID,
@@ -346,3 +468,62 @@ func (api *api) DeleteWebsiteTLLanguage(w http.ResponseWriter, r *http.Request,
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (api *api) ListWebsiteTLLanguage(w http.ResponseWriter, r *http.Request, domain string, params ListWebsiteTLLanguageParams) {
+	ctx := r.Context()
+	log := api.logger.WithContext(ctx)
+
+	pagination := model.Pagination{Page: 1, Limit: model.WebsiteTLLanguagePaginationDef}
+	if params.Page != nil {
+		pagination.Page = *params.Page
+	}
+	if params.Limit != nil {
+		pagination.Limit = *params.Limit
+	}
+
+	var orderBys model.OrderBys
+	if params.OrderBy != nil {
+		orderBys = queryOrderBys(*params.OrderBy)
+	}
+
+	conditions := model.DBConditionalKV{
+		Key:   model.DBWebsiteGenericWebsiteID,
+		Value: model.DBWebsiteDomainToID(domain),
+	}
+
+	var totalCountCh chan int
+	if params.Count == nil || *params.Count {
+		totalCountCh = make(chan int, 1)
+		go func() {
+			count, err := api.service.CountWebsiteTLLanguage(ctx, conditions)
+			if err != nil {
+				totalCountCh <- -1
+				log.ErrMessage(err, "Count website tl language failed.")
+				return
+			}
+			totalCountCh <- count
+		}()
+	}
+
+	result0, err := api.service.ListWebsiteTLLanguage(ctx, model.ListParams{
+		Conditions: conditions,
+		OrderBys:   orderBys,
+		Pagination: &pagination,
+	})
+	if err != nil {
+		responseServiceErr(w, err)
+		log.ErrMessage(err, "List website tl language failed.")
+		return
+	}
+
+	wHeader := w.Header()
+	if totalCountCh != nil {
+		wHeader.Set("X-Total-Count", strconv.Itoa(<-totalCountCh))
+	}
+	wHeader.Set("X-Pagination-Limit", strconv.Itoa(pagination.Limit))
+	var result []WebsiteTLLanguage
+	for _, r := range result0 {
+		result = append(result, modelWebsiteTLLanguage(r))
+	}
+	response(w, result, http.StatusOK)
+}
@@ -7,5 +7,6 @@ import (
 
 var (
 	URLParam            = chi.URLParamFromCtx
+	RouteContext        = chi.RouteContext
 	WrapResponseWritter = middleware.NewWrapResponseWriter
 )
@@ -66,6 +66,8 @@ func ResponseJSONServiceErr(w http.ResponseWriter, err error) {
 	switch {
 	case errors.As(err, &model.ErrNotFound):
 		ResponseJSONErr404(w)
+	case errors.As(err, &model.ErrPrecondition):
+		ResponseJSONErr(w, "Precondition failed.", http.StatusPreconditionFailed)
 	case errors.As(err, &model.ErrGeneric):
 		ResponseJSONErr(w, utila.CapitalPeriod(err.Error()), http.StatusBadRequest)
 	case errors.As(err, &model.ErrDatabase):
@@ -18,16 +18,17 @@ type (
 
 	service chttp.Service
 	oauth   chttp.OAuth
+	redis   chttp.Redis
 )
 
-func New(svc service, oa oauth, cfg Config, log logger.Logger) Controller {
+func New(svc service, oa oauth, rdb redis, cfg Config, log logger.Logger) Controller {
 	controller := Controller{}
 
 	var cHTTP *chttp.HTTP
 	controller.http = func() (http.Handler, error) {
 		var err error
 		if cHTTP == nil {
-			cHTTP, err = chttp.New(svc, oa, cfg.HTTP, log.WithName("HTTP"))
+			cHTTP, err = chttp.New(svc, oa, rdb, cfg.HTTP, log.WithName("HTTP"))
 		}
 		return cHTTP, err
 	}
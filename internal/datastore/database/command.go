@@ -3,27 +3,111 @@ package database
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
 )
 
 const (
-	CodeErrForeign    = "23503"
-	CodeErrExists     = "23505"
-	CodeErrValidation = "23514"
+	CodeErrForeign       = "23503"
+	CodeErrExists        = "23505"
+	CodeErrValidation    = "23514"
+	CodeErrSerialization = "40001"
+	TxRetryMax           = 5
+	TxRetryDelay         = 20 * time.Millisecond
 )
 
+type (
+	txKey struct{}
+
+	querier interface {
+		Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+		Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+		SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults
+	}
+)
+
+// WithTx runs fn in a transaction carried by its context, so the commands
+// given that context join the transaction. A nested call joins the outer
+// transaction, and the whole transaction is retried on serialization
+// failures which CockroachDB asks clients to handle.
+func (db Database) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	var err error
+	for i := 0; i < TxRetryMax; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(i) * TxRetryDelay):
+			}
+		}
+
+		if err = db.tx(ctx, fn); !isSerializationError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// WithSavepoint runs fn like WithTx, but a nested call runs it behind a
+// savepoint so its failure only rolls back what fn did and leaves the outer
+// transaction usable.
+func (db Database) WithSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+	if !ok {
+		return db.WithTx(ctx, fn)
+	}
+
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return databaseError(err)
+	}
+	defer savepoint.Rollback(context.WithoutCancel(ctx))
+
+	if err := fn(context.WithValue(ctx, txKey{}, savepoint)); err != nil {
+		return err
+	}
+
+	return databaseError(savepoint.Commit(ctx))
+}
+
+func (db Database) tx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := db.client.Begin(ctx)
+	if err != nil {
+		return databaseError(err)
+	}
+	defer tx.Rollback(context.WithoutCancel(ctx))
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	return databaseError(tx.Commit(ctx))
+}
+
+func (db Database) querier(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return db.client
+}
+
 func (db Database) Exec(ctx context.Context, sql string, args ...any) error {
-	_, err := db.client.Exec(ctx, sql, args...)
+	_, err := db.querier(ctx).Exec(ctx, sql, args...)
 
 	return databaseError(err)
 }
 
 func (db Database) QueryAll(ctx context.Context, dst any, sql string, args ...any) error {
-	rows, err := db.client.Query(ctx, sql, args...)
+	rows, err := db.querier(ctx).Query(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
@@ -41,7 +125,7 @@ func (db Database) QueryAll(ctx context.Context, dst any, sql string, args ...an
 }
 
 func (db Database) QueryOne(ctx context.Context, dst any, sql string, args ...any) error {
-	rows, err := db.client.Query(ctx, sql, args...)
+	rows, err := db.querier(ctx).Query(ctx, sql, args...)
 	if err != nil {
 		return err
 	}
@@ -58,6 +142,49 @@ func (db Database) QueryOne(ctx context.Context, dst any, sql string, args ...an
 	return nil
 }
 
+// BatchQuery is a query sent by QueryBatch, its rows are scanned into Dst
+// and Done is called after if set.
+type BatchQuery struct {
+	SQL  string
+	Args []any
+	Dst  any
+	Done func()
+}
+
+// QueryBatch sends the queries in a single round trip.
+func (db Database) QueryBatch(ctx context.Context, queries []BatchQuery) error {
+	if len(queries) < 1 {
+		return nil
+	}
+
+	batch := &pgx.Batch{}
+	for _, query := range queries {
+		batch.Queue(query.SQL, query.Args...)
+	}
+	results := db.querier(ctx).SendBatch(ctx, batch)
+	defer results.Close()
+
+	for _, query := range queries {
+		rows, err := results.Query()
+		if err != nil {
+			return databaseError(err)
+		}
+		if err := pgxscan.ScanAll(query.Dst, rows); err != nil {
+			return databaseError(err)
+		}
+		if query.Done != nil {
+			query.Done()
+		}
+	}
+
+	return results.Close()
+}
+
+func isSerializationError(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == CodeErrSerialization
+}
+
 func databaseError(err error) error {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
@@ -16,12 +17,15 @@ import (
 
 type (
 	Database struct {
-		client *pgxpool.Pool
+		client         *pgxpool.Pool
+		provider       string
+		trashRetention time.Duration
 	}
 
 	Config struct {
-		URL      string `conf:"url"`
-		Provider string `conf:"provider"`
+		URL            string        `conf:"url"`
+		Provider       string        `conf:"provider"`
+		TrashRetention time.Duration `conf:"trash_retention"`
 	}
 )
 
@@ -36,9 +40,13 @@ func New(ctx context.Context, cfg Config) (*Database, error) {
 		return nil, err
 	}
 
-	db := &Database{client: client}
+	db := &Database{
+		client:         client,
+		provider:       strings.ToLower(cfg.Provider),
+		trashRetention: cfg.TrashRetention,
+	}
 
-	if err := db.Migrate(ctx, strings.ToLower(cfg.Provider)); err != nil {
+	if err := db.Migrate(ctx, db.provider); err != nil {
 		return nil, err
 	}
 
@@ -77,6 +85,14 @@ func (db Database) Migrate(ctx context.Context, provider string) error {
 	return nil
 }
 
+func (db Database) isCRDB() bool {
+	switch db.provider {
+	case "crdb", "cockroachdb":
+		return true
+	}
+	return false
+}
+
 type migrationLogger struct{}
 
 func (l *migrationLogger) Fatalf(format string, v ...interface{}) { panic(fmt.Sprintf(format, v...)) }
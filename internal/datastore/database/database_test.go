@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"os"
+	"testing"
+)
+
+// testDatabase connects to BAGICORE_DATABASE_URL, the provider is read from
+// BAGICORE_DATABASE_PROVIDER and defaults to pg.
+func testDatabase(tb testing.TB) *Database {
+	url := os.Getenv("BAGICORE_DATABASE_URL")
+	if url == "" {
+		tb.Skip("BAGICORE_DATABASE_URL is not set")
+	}
+	provider := os.Getenv("BAGICORE_DATABASE_PROVIDER")
+	if provider == "" {
+		provider = "pg"
+	}
+
+	db, err := New(context.Background(), Config{URL: url, Provider: provider})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() { db.Close() })
+	return db
+}
+
+// TestWithSavepoint fails a command behind a savepoint, the transaction around
+// it goes on.
+func TestWithSavepoint(t *testing.T) {
+	db := testDatabase(t)
+	ctx := context.Background()
+
+	if err := db.WithTx(ctx, func(ctx context.Context) error {
+		if err := db.WithSavepoint(ctx, func(ctx context.Context) error {
+			return db.Exec(ctx, "SELECT 1/0")
+		}); err == nil {
+			t.Error("division by zero gave no error")
+		}
+
+		var dst int
+		return db.QueryOne(ctx, &dst, "SELECT 1")
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,54 @@
+package database
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func (db Database) AddAuditEvent(ctx context.Context, data model.AddAuditEvent) error {
+	return db.GenericAdd(ctx, model.DBAuditEvent, map[string]any{
+		model.DBAuditEventActor:      data.Actor,
+		model.DBAuditEventOperation:  data.Operation,
+		model.DBAuditEventEntity:     data.Entity,
+		model.DBAuditEventEntitySID:  data.EntitySID,
+		model.DBComicGenericComicID:  data.ComicID,
+		model.DBAuditEventBeforeData: data.Before,
+		model.DBAuditEventAfterData:  data.After,
+	}, nil)
+}
+
+// AddAuditEvents records the events in a single insert.
+func (db Database) AddAuditEvents(ctx context.Context, data []model.AddAuditEvent) error {
+	data0 := make([]map[string]any, 0, len(data))
+	for _, data := range data {
+		data0 = append(data0, map[string]any{
+			model.DBAuditEventActor:      data.Actor,
+			model.DBAuditEventOperation:  data.Operation,
+			model.DBAuditEventEntity:     data.Entity,
+			model.DBAuditEventEntitySID:  data.EntitySID,
+			model.DBComicGenericComicID:  data.ComicID,
+			model.DBAuditEventBeforeData: data.Before,
+			model.DBAuditEventAfterData:  data.After,
+		})
+	}
+	return db.BatchAdd(ctx, model.DBAuditEvent, data0, nil)
+}
+
+func (db Database) ListAuditEvent(ctx context.Context, params model.ListParams) ([]*model.AuditEvent, error) {
+	result := []*model.AuditEvent{}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID, Sort: "desc"})
+	}
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.AuditPaginationDef}
+	}
+	if err := db.GenericList(ctx, model.DBAuditEvent, params, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (db Database) CountAuditEvent(ctx context.Context, conds any) (int, error) {
+	return db.GenericCount(ctx, model.DBAuditEvent, conds)
+}
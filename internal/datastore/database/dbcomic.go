@@ -14,7 +14,12 @@ const (
 
 func (db Database) AddComic(ctx context.Context, data model.AddComic, v *model.Comic) error {
 	if err := db.GenericAdd(ctx, model.DBComic, map[string]any{
-		model.DBComicCode: data.Code,
+		model.DBComicCode:      data.Code,
+		model.DBComicStatus:    data.Status,
+		model.DBComicType:      data.Type,
+		model.DBComicStartYear: data.StartYear,
+		model.DBComicSynopsis:  data.Synopsis,
+		model.DBComicCoverURL:  data.CoverURL,
 	}, v); err != nil {
 		return comicSetError(err)
 	}
@@ -34,6 +39,24 @@ func (db Database) UpdateComic(ctx context.Context, data model.SetComic, conds a
 	if data.Code != nil {
 		data0[model.DBComicCode] = data.Code
 	}
+	if data.Status != nil {
+		data0[model.DBComicStatus] = data.Status
+	}
+	if data.Type != nil {
+		data0[model.DBComicType] = data.Type
+	}
+	if data.StartYear != nil {
+		data0[model.DBComicStartYear] = data.StartYear
+	}
+	if data.Synopsis != nil {
+		data0[model.DBComicSynopsis] = data.Synopsis
+	}
+	if data.CoverURL != nil {
+		data0[model.DBComicCoverURL] = data.CoverURL
+	}
+	for _, null := range data.SetNull {
+		data0[null] = nil
+	}
 	if err := db.GenericUpdate(ctx, model.DBComic, data0, conds, v); err != nil {
 		return comicSetError(err)
 	}
@@ -44,6 +67,51 @@ func (db Database) DeleteComic(ctx context.Context, conds any, v *model.Comic) e
 	return db.GenericDelete(ctx, model.DBComic, conds, v)
 }
 
+func (db Database) RestoreComic(ctx context.Context, conds any, v *model.Comic) error {
+	if err := db.GenericRestore(ctx, model.DBComic, conds, v); err != nil {
+		return comicSetError(err)
+	}
+	return nil
+}
+
+// AddComicCodeAlias keeps the old code of the comic leading to it, an
+// alias taken by another comic before moves over.
+func (db Database) AddComicCodeAlias(ctx context.Context, code string, comicID uint) error {
+	cols, vals, args := SetInsert(map[string]any{
+		model.DBComicCode:           code,
+		model.DBComicGenericComicID: comicID,
+	})
+	sql := "INSERT INTO " + model.DBComicCodeAlias + " (" + cols + ") VALUES (" + vals + ")"
+	sql += " ON CONFLICT (" + model.DBComicCode + ")"
+	sql += " DO UPDATE SET " + model.DBComicGenericComicID + " = EXCLUDED." + model.DBComicGenericComicID
+	sql += ", " + model.DBGenericCreatedAt + " = EXCLUDED." + model.DBGenericCreatedAt
+	return db.Exec(ctx, sql, args...)
+}
+
+func (db Database) DeleteComicCodeAlias(ctx context.Context, code string) error {
+	args := []any{}
+	cond := SetWhere(model.DBConditionalKV{Key: model.DBComicCode, Value: code}, &args)
+	sql := "DELETE FROM " + model.DBComicCodeAlias + " WHERE " + cond
+	return db.Exec(ctx, sql, args...)
+}
+
+// ResolveComicCodeAlias gives the code the comic under the alias has now,
+// an alias shadowed by a comic of its own, even one in the trash, resolves to
+// nothing.
+func (db Database) ResolveComicCodeAlias(ctx context.Context, code string) (string, error) {
+	var dst string
+	args := []any{code}
+	sql := "SELECT b." + model.DBComicCode
+	sql += " FROM " + model.DBComicCodeAlias + " a JOIN " + model.DBComic + " b"
+	sql += " ON b." + model.DBGenericID + " = a." + model.DBComicGenericComicID
+	sql += " WHERE a." + model.DBComicCode + " = $1 AND " + notDeletedSQL("b")
+	sql += " AND NOT EXISTS (SELECT 1 FROM " + model.DBComic + " WHERE " + model.DBComicCode + " = $1)"
+	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+		return "", err
+	}
+	return dst, nil
+}
+
 func (db Database) ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, error) {
 	result := []*model.Comic{}
 	if len(params.OrderBys) < 1 {
@@ -70,7 +138,231 @@ func comicSetError(err error) error {
 	var errDatabase model.DatabaseError
 	if errors.As(err, &errDatabase) {
 		if errDatabase.Code == CodeErrExists && errDatabase.Name == NameErrComicKey {
-			return model.GenericError("same code already exists")
+			return model.ErrComicCodeExists
+		}
+	}
+	return err
+}
+
+const (
+	NameErrComicTitleFKey0 = "comic_title_comic_id_fkey"
+	NameErrComicTitleFKey1 = "comic_title_language_id_fkey"
+	NameErrComicTitleKey0  = "comic_title_comic_id_rid_key"
+	NameErrComicTitleKey1  = "comic_title_comic_id_language_id_title_key"
+	NameErrComicTitleKey2  = "comic_title_comic_id_language_id_primary_key"
+)
+
+func (db Database) AddComicTitle(ctx context.Context, data model.AddComicTitle, v *model.ComicTitle) error {
+	var comicID any
+	switch {
+	case data.ComicID != nil:
+		comicID = data.ComicID
+	case data.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*data.ComicCode)
+	}
+	var languageID any
+	switch {
+	case data.LanguageID != nil:
+		languageID = data.LanguageID
+	case data.LanguageIETF != nil:
+		languageID = model.DBLanguageIETFToID(*data.LanguageIETF)
+	}
+	data0 := map[string]any{
+		model.DBComicGenericComicID:       comicID,
+		model.DBComicTitleRID:             data.RID,
+		model.DBLanguageGenericLanguageID: languageID,
+		model.DBComicTitleTitle:           data.Title,
+	}
+	if data.IsPrimary != nil {
+		data0[model.DBComicTitleIsPrimary] = data.IsPrimary
+	}
+	if data.Romanized != nil {
+		data0[model.DBComicTitleRomanized] = data.Romanized
+	}
+	cols, vals, args := SetInsert(data0)
+	sql := "INSERT INTO " + model.DBComicTitle + " (" + cols + ") VALUES (" + vals + ")"
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT w." + model.DBGenericID
+		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicTitleRID
+		sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
+		sql += ", w." + model.DBComicTitleIsPrimary + ", w." + model.DBComicTitleRomanized
+		sql += ", l." + model.DBLanguageIETF + " AS " + model.DBComicTitleLanguageIETF
+		sql += " FROM data w JOIN " + model.DBLanguage + " l"
+		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return comicTitleSetError(err)
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return comicTitleSetError(err)
+		}
+	}
+	return nil
+}
+
+func (db Database) GetComicTitle(ctx context.Context, conds any) (*model.ComicTitle, error) {
+	var result model.ComicTitle
+	args := []any{}
+	cond := SetWhere(conds, &args)
+	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicTitleRID
+	sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
+	sql += ", w." + model.DBComicTitleIsPrimary + ", w." + model.DBComicTitleRomanized
+	sql += ", l." + model.DBLanguageIETF + " AS " + model.DBComicTitleLanguageIETF
+	sql += " FROM " + model.DBComicTitle + " w JOIN " + model.DBLanguage + " l"
+	sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+	sql += ")"
+	sql += " WHERE " + cond
+	if err := db.QueryOne(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (db Database) UpdateComicTitle(ctx context.Context, data model.SetComicTitle, conds any, v *model.ComicTitle) error {
+	data0 := map[string]any{}
+	switch {
+	case data.ComicID != nil:
+		data0[model.DBComicGenericComicID] = data.ComicID
+	case data.ComicCode != nil:
+		data0[model.DBComicGenericComicID] = model.DBComicCodeToID(*data.ComicCode)
+	}
+	if data.RID != nil {
+		data0[model.DBComicTitleRID] = data.RID
+	}
+	switch {
+	case data.LanguageID != nil:
+		data0[model.DBLanguageGenericLanguageID] = data.LanguageID
+	case data.LanguageIETF != nil:
+		data0[model.DBLanguageGenericLanguageID] = model.DBLanguageIETFToID(*data.LanguageIETF)
+	}
+	if data.Title != nil {
+		data0[model.DBComicTitleTitle] = data.Title
+	}
+	if data.IsPrimary != nil {
+		data0[model.DBComicTitleIsPrimary] = data.IsPrimary
+	}
+	if data.Romanized != nil {
+		data0[model.DBComicTitleRomanized] = data.Romanized
+	}
+	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
+	sets, args := SetUpdate(data0)
+	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sql := "UPDATE " + model.DBComicTitle + " SET " + sets + " WHERE " + cond
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT w." + model.DBGenericID
+		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicTitleRID
+		sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
+		sql += ", w." + model.DBComicTitleIsPrimary + ", w." + model.DBComicTitleRomanized
+		sql += ", l." + model.DBLanguageIETF + " AS " + model.DBComicTitleLanguageIETF
+		sql += " FROM data w JOIN " + model.DBLanguage + " l"
+		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return comicTitleSetError(err)
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return comicTitleSetError(err)
+		}
+	}
+	return nil
+}
+
+func (db Database) DeleteComicTitle(ctx context.Context, conds any, v *model.ComicTitle) error {
+	args := []any{}
+	cond := SetWhere(conds, &args)
+	sql := "DELETE FROM " + model.DBComicTitle + " WHERE " + cond
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT w." + model.DBGenericID
+		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicTitleRID
+		sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
+		sql += ", w." + model.DBComicTitleIsPrimary + ", w." + model.DBComicTitleRomanized
+		sql += ", l." + model.DBLanguageIETF + " AS " + model.DBComicTitleLanguageIETF
+		sql += " FROM data w JOIN " + model.DBLanguage + " l"
+		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return err
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db Database) ListComicTitle(ctx context.Context, params model.ListParams) ([]*model.ComicTitle, error) {
+	result := []*model.ComicTitle{}
+	args := []any{}
+	sql := listComicTitleSQL(&params, &args)
+	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func listComicTitleSQL(params *model.ListParams, args *[]any) string {
+	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicTitleRID
+	sql += ", w." + model.DBLanguageGenericLanguageID + ", w." + model.DBComicTitleTitle
+	sql += ", w." + model.DBComicTitleIsPrimary + ", w." + model.DBComicTitleRomanized
+	sql += ", l." + model.DBLanguageIETF + " AS " + model.DBComicTitleLanguageIETF
+	sql += " FROM " + model.DBComicTitle + " w JOIN " + model.DBLanguage + " l"
+	sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
+	sql += ")"
+	SetPartition(params)
+	if cond := SetWhere(params.Conditions, args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicTitleIsPrimary, Sort: "desc"})
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	}
+	sql += " ORDER BY " + SetOrderBys(params.OrderBys, args)
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicTitlePaginationDef}
+	}
+	if lmof := SetPagination(*params.Pagination, args); lmof != "" {
+		sql += lmof
+	}
+	return SetPartitionSelect(*params, sql, args)
+}
+
+func (db Database) CountComicTitle(ctx context.Context, conds any) (int, error) {
+	return db.GenericCount(ctx, model.DBComicTitle, conds)
+}
+
+func comicTitleSetError(err error) error {
+	var errDatabase model.DatabaseError
+	if errors.As(err, &errDatabase) {
+		if errDatabase.Code == CodeErrForeign {
+			switch errDatabase.Name {
+			case NameErrComicTitleFKey0:
+				return model.GenericError("comic does not exist")
+			case NameErrComicTitleFKey1:
+				return model.GenericError("language does not exist")
+			}
+		}
+		if errDatabase.Code == CodeErrExists {
+			switch errDatabase.Name {
+			case NameErrComicTitleKey0:
+				return model.GenericError("same comic id + rid already exists")
+			case NameErrComicTitleKey1:
+				return model.GenericError("same comic id + language id + title already exists")
+			case NameErrComicTitleKey2:
+				return model.GenericError("primary title for the language already exists")
+			}
 		}
 	}
 	return err
@@ -83,6 +375,15 @@ const (
 )
 
 func (db Database) AddComicLink(ctx context.Context, data model.AddComicLink, v *model.ComicLink) error {
+	return db.addComicLink(ctx, data, false, v)
+}
+
+// UpsertComicLink adds the comic link or gets the existing one as it is.
+func (db Database) UpsertComicLink(ctx context.Context, data model.AddComicLink, v *model.ComicLink) error {
+	return db.addComicLink(ctx, data, true, v)
+}
+
+func (db Database) addComicLink(ctx context.Context, data model.AddComicLink, upsert bool, v *model.ComicLink) error {
 	var comicID any
 	switch {
 	case data.ComicID != nil:
@@ -102,6 +403,10 @@ func (db Database) AddComicLink(ctx context.Context, data model.AddComicLink, v
 		model.DBLinkGenericLinkID:   linkID,
 	})
 	sql := "INSERT INTO " + model.DBComicLink + " (" + cols + ") VALUES (" + vals + ")"
+	if upsert {
+		target := "(" + model.DBComicGenericComicID + ", " + model.DBLinkGenericLinkID + ")"
+		sql += SetUpsert(target, nil, &args)
+	}
 	if v != nil {
 		sql += " RETURNING *"
 		sql = "WITH data AS (" + sql + ")"
@@ -114,7 +419,19 @@ func (db Database) AddComicLink(ctx context.Context, data model.AddComicLink, v
 		sql += " JOIN " + model.DBWebsite + " c"
 		sql += " ON b." + model.DBWebsiteGenericWebsiteID + " = c." + model.DBGenericID
 		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
-			return comicLinkSetError(err)
+			if !upsert || !errors.As(err, &model.ErrNotFound) {
+				return comicLinkSetError(err)
+			}
+			// The upsert changed nothing and returned no row, the row is
+			// read as it is.
+			result, err := db.GetComicLink(ctx, map[string]any{
+				model.DBComicGenericComicID: comicID,
+				model.DBLinkGenericLinkID:   linkID,
+			})
+			if err != nil {
+				return err
+			}
+			*v = *result
 		}
 	} else {
 		if err := db.Exec(ctx, sql, args...); err != nil {
@@ -222,6 +539,7 @@ func (db Database) ListComicLink(ctx context.Context, params model.ListParams) (
 	sql += " JOIN " + model.DBWebsite + " c"
 	sql += " ON b." + model.DBWebsiteGenericWebsiteID + " = c." + model.DBGenericID
 	sql += ")"
+	SetPartition(&params)
 	if cond := SetWhere(params.Conditions, &args); cond != "" {
 		sql += " WHERE " + cond
 	}
@@ -235,6 +553,7 @@ func (db Database) ListComicLink(ctx context.Context, params model.ListParams) (
 	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
+	sql = SetPartitionSelect(params, sql, &args)
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
 		return nil, err
 	}
@@ -263,12 +582,424 @@ func comicLinkSetError(err error) error {
 	return err
 }
 
+const (
+	NameErrComicCreatorPKey  = "comic_creator_pkey"
+	NameErrComicCreatorFKey0 = "comic_creator_comic_id_fkey"
+	NameErrComicCreatorFKey1 = "comic_creator_creator_id_fkey"
+)
+
+func (db Database) AddComicCreator(ctx context.Context, data model.AddComicCreator, v *model.ComicCreator) error {
+	var comicID any
+	switch {
+	case data.ComicID != nil:
+		comicID = data.ComicID
+	case data.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*data.ComicCode)
+	}
+	var creatorID any
+	switch {
+	case data.CreatorID != nil:
+		creatorID = data.CreatorID
+	case data.CreatorSlug != nil:
+		creatorID = model.DBCreatorSlugToID(*data.CreatorSlug)
+	}
+	cols, vals, args := SetInsert(map[string]any{
+		model.DBComicGenericComicID:     comicID,
+		model.DBCreatorGenericCreatorID: creatorID,
+		model.DBComicCreatorRole:        data.Role,
+	})
+	sql := "INSERT INTO " + model.DBComicCreator + " (" + cols + ") VALUES (" + vals + ")"
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+		sql += ", a." + model.DBComicGenericComicID + ", a." + model.DBCreatorGenericCreatorID
+		sql += ", a." + model.DBComicCreatorRole
+		sql += ", b." + model.DBComicCode + " AS comic_code"
+		sql += ", c." + model.DBCreatorSlug + " AS creator_slug, c." + model.DBCreatorName + " AS creator_name"
+		sql += " FROM data a JOIN " + model.DBComic + " b"
+		sql += " ON a." + model.DBComicGenericComicID + " = b." + model.DBGenericID
+		sql += " JOIN " + model.DBCreator + " c"
+		sql += " ON a." + model.DBCreatorGenericCreatorID + " = c." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return comicCreatorSetError(err)
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return comicCreatorSetError(err)
+		}
+	}
+	return nil
+}
+
+func (db Database) GetComicCreator(ctx context.Context, conds any) (*model.ComicCreator, error) {
+	var result model.ComicCreator
+	args := []any{}
+	cond := SetWhere(conds, &args)
+	sql := "SELECT * FROM (SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+	sql += ", a." + model.DBComicGenericComicID + ", a." + model.DBCreatorGenericCreatorID
+	sql += ", a." + model.DBComicCreatorRole
+	sql += ", b." + model.DBComicCode + " AS comic_code"
+	sql += ", c." + model.DBCreatorSlug + " AS creator_slug, c." + model.DBCreatorName + " AS creator_name"
+	sql += " FROM " + model.DBComicCreator + " a JOIN " + model.DBComic + " b"
+	sql += " ON a." + model.DBComicGenericComicID + " = b." + model.DBGenericID
+	sql += " JOIN " + model.DBCreator + " c"
+	sql += " ON a." + model.DBCreatorGenericCreatorID + " = c." + model.DBGenericID
+	sql += ")"
+	sql += " WHERE " + cond
+	if err := db.QueryOne(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (db Database) UpdateComicCreator(ctx context.Context, data model.SetComicCreator, conds any, v *model.ComicCreator) error {
+	data0 := map[string]any{}
+	switch {
+	case data.ComicID != nil:
+		data0[model.DBComicGenericComicID] = data.ComicID
+	case data.ComicCode != nil:
+		data0[model.DBComicGenericComicID] = model.DBComicCodeToID(*data.ComicCode)
+	}
+	switch {
+	case data.CreatorID != nil:
+		data0[model.DBCreatorGenericCreatorID] = data.CreatorID
+	case data.CreatorSlug != nil:
+		data0[model.DBCreatorGenericCreatorID] = model.DBCreatorSlugToID(*data.CreatorSlug)
+	}
+	if data.Role != nil {
+		data0[model.DBComicCreatorRole] = data.Role
+	}
+	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
+	sets, args := SetUpdate(data0)
+	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sql := "UPDATE " + model.DBComicCreator + " SET " + sets + " WHERE " + cond
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+		sql += ", a." + model.DBComicGenericComicID + ", a." + model.DBCreatorGenericCreatorID
+		sql += ", a." + model.DBComicCreatorRole
+		sql += ", b." + model.DBComicCode + " AS comic_code"
+		sql += ", c." + model.DBCreatorSlug + " AS creator_slug, c." + model.DBCreatorName + " AS creator_name"
+		sql += " FROM data a JOIN " + model.DBComic + " b"
+		sql += " ON a." + model.DBComicGenericComicID + " = b." + model.DBGenericID
+		sql += " JOIN " + model.DBCreator + " c"
+		sql += " ON a." + model.DBCreatorGenericCreatorID + " = c." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return comicCreatorSetError(err)
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return comicCreatorSetError(err)
+		}
+	}
+	return nil
+}
+
+func (db Database) DeleteComicCreator(ctx context.Context, conds any, v *model.ComicCreator) error {
+	args := []any{}
+	cond := SetWhere(conds, &args)
+	sql := "DELETE FROM " + model.DBComicCreator + " WHERE " + cond
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+		sql += ", a." + model.DBComicGenericComicID + ", a." + model.DBCreatorGenericCreatorID
+		sql += ", a." + model.DBComicCreatorRole
+		sql += ", b." + model.DBComicCode + " AS comic_code"
+		sql += ", c." + model.DBCreatorSlug + " AS creator_slug, c." + model.DBCreatorName + " AS creator_name"
+		sql += " FROM data a JOIN " + model.DBComic + " b"
+		sql += " ON a." + model.DBComicGenericComicID + " = b." + model.DBGenericID
+		sql += " JOIN " + model.DBCreator + " c"
+		sql += " ON a." + model.DBCreatorGenericCreatorID + " = c." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return err
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db Database) ListComicCreator(ctx context.Context, params model.ListParams) ([]*model.ComicCreator, error) {
+	result := []*model.ComicCreator{}
+	args := []any{}
+	sql := listComicCreatorSQL(&params, &args)
+	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func listComicCreatorSQL(params *model.ListParams, args *[]any) string {
+	sql := "SELECT * FROM (SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+	sql += ", a." + model.DBComicGenericComicID + ", a." + model.DBCreatorGenericCreatorID
+	sql += ", a." + model.DBComicCreatorRole
+	sql += ", b." + model.DBComicCode + " AS comic_code"
+	sql += ", c." + model.DBCreatorSlug + " AS creator_slug, c." + model.DBCreatorName + " AS creator_name"
+	sql += " FROM " + model.DBComicCreator + " a JOIN " + model.DBComic + " b"
+	sql += " ON a." + model.DBComicGenericComicID + " = b." + model.DBGenericID
+	sql += " JOIN " + model.DBCreator + " c"
+	sql += " ON a." + model.DBCreatorGenericCreatorID + " = c." + model.DBGenericID
+	sql += ")"
+	SetPartition(params)
+	if cond := SetWhere(params.Conditions, args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicCreatorRole})
+	}
+	sql += " ORDER BY " + SetOrderBys(params.OrderBys, args)
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicCreatorPaginationDef}
+	}
+	if lmof := SetPagination(*params.Pagination, args); lmof != "" {
+		sql += lmof
+	}
+	return SetPartitionSelect(*params, sql, args)
+}
+
+func (db Database) CountComicCreator(ctx context.Context, conds any) (int, error) {
+	return db.GenericCount(ctx, model.DBComicCreator, conds)
+}
+
+func comicCreatorSetError(err error) error {
+	var errDatabase model.DatabaseError
+	if errors.As(err, &errDatabase) {
+		if errDatabase.Code == CodeErrForeign {
+			switch errDatabase.Name {
+			case NameErrComicCreatorFKey0:
+				return model.GenericError("comic does not exist")
+			case NameErrComicCreatorFKey1:
+				return model.GenericError("creator does not exist")
+			}
+		}
+		if errDatabase.Code == CodeErrExists && errDatabase.Name == NameErrComicCreatorPKey {
+			return model.GenericError("same creator id + role already exists")
+		}
+	}
+	return err
+}
+
+const (
+	NameErrComicTagPKey  = "comic_tag_pkey"
+	NameErrComicTagFKey0 = "comic_tag_comic_id_fkey"
+	NameErrComicTagFKey1 = "comic_tag_tag_id_fkey"
+)
+
+func (db Database) AddComicTag(ctx context.Context, data model.AddComicTag, v *model.ComicTag) error {
+	var comicID any
+	switch {
+	case data.ComicID != nil:
+		comicID = data.ComicID
+	case data.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*data.ComicCode)
+	}
+	var tagID any
+	switch {
+	case data.TagID != nil:
+		tagID = data.TagID
+	case data.TagSID != nil:
+		tagID = model.DBTagSIDToID(*data.TagSID)
+	}
+	cols, vals, args := SetInsert(map[string]any{
+		model.DBComicGenericComicID: comicID,
+		model.DBTagGenericTagID:     tagID,
+	})
+	sql := "INSERT INTO " + model.DBComicTag + " (" + cols + ") VALUES (" + vals + ")"
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+		sql += ", a." + model.DBComicGenericComicID + ", a." + model.DBTagGenericTagID
+		sql += ", b." + model.DBComicCode + " AS comic_code"
+		sql += ", c." + model.DBTagNamespace + " AS " + model.DBComicTagNamespace
+		sql += ", c." + model.DBTagSlug + " AS " + model.DBComicTagSlug + ", c." + model.DBTagNames + " AS tag_names"
+		sql += " FROM data a JOIN " + model.DBComic + " b"
+		sql += " ON a." + model.DBComicGenericComicID + " = b." + model.DBGenericID
+		sql += " JOIN " + model.DBTag + " c"
+		sql += " ON a." + model.DBTagGenericTagID + " = c." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return comicTagSetError(err)
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return comicTagSetError(err)
+		}
+	}
+	return nil
+}
+
+func (db Database) GetComicTag(ctx context.Context, conds any) (*model.ComicTag, error) {
+	var result model.ComicTag
+	args := []any{}
+	cond := SetWhere(conds, &args)
+	sql := "SELECT * FROM (SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+	sql += ", a." + model.DBComicGenericComicID + ", a." + model.DBTagGenericTagID
+	sql += ", b." + model.DBComicCode + " AS comic_code"
+	sql += ", c." + model.DBTagNamespace + " AS " + model.DBComicTagNamespace
+	sql += ", c." + model.DBTagSlug + " AS " + model.DBComicTagSlug + ", c." + model.DBTagNames + " AS tag_names"
+	sql += " FROM " + model.DBComicTag + " a JOIN " + model.DBComic + " b"
+	sql += " ON a." + model.DBComicGenericComicID + " = b." + model.DBGenericID
+	sql += " JOIN " + model.DBTag + " c"
+	sql += " ON a." + model.DBTagGenericTagID + " = c." + model.DBGenericID
+	sql += ")"
+	sql += " WHERE " + cond
+	if err := db.QueryOne(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (db Database) UpdateComicTag(ctx context.Context, data model.SetComicTag, conds any, v *model.ComicTag) error {
+	data0 := map[string]any{}
+	switch {
+	case data.ComicID != nil:
+		data0[model.DBComicGenericComicID] = data.ComicID
+	case data.ComicCode != nil:
+		data0[model.DBComicGenericComicID] = model.DBComicCodeToID(*data.ComicCode)
+	}
+	switch {
+	case data.TagID != nil:
+		data0[model.DBTagGenericTagID] = data.TagID
+	case data.TagSID != nil:
+		data0[model.DBTagGenericTagID] = model.DBTagSIDToID(*data.TagSID)
+	}
+	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
+	sets, args := SetUpdate(data0)
+	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sql := "UPDATE " + model.DBComicTag + " SET " + sets + " WHERE " + cond
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+		sql += ", a." + model.DBComicGenericComicID + ", a." + model.DBTagGenericTagID
+		sql += ", b." + model.DBComicCode + " AS comic_code"
+		sql += ", c." + model.DBTagNamespace + " AS " + model.DBComicTagNamespace
+		sql += ", c." + model.DBTagSlug + " AS " + model.DBComicTagSlug + ", c." + model.DBTagNames + " AS tag_names"
+		sql += " FROM data a JOIN " + model.DBComic + " b"
+		sql += " ON a." + model.DBComicGenericComicID + " = b." + model.DBGenericID
+		sql += " JOIN " + model.DBTag + " c"
+		sql += " ON a." + model.DBTagGenericTagID + " = c." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return comicTagSetError(err)
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return comicTagSetError(err)
+		}
+	}
+	return nil
+}
+
+func (db Database) DeleteComicTag(ctx context.Context, conds any, v *model.ComicTag) error {
+	args := []any{}
+	cond := SetWhere(conds, &args)
+	sql := "DELETE FROM " + model.DBComicTag + " WHERE " + cond
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+		sql += ", a." + model.DBComicGenericComicID + ", a." + model.DBTagGenericTagID
+		sql += ", b." + model.DBComicCode + " AS comic_code"
+		sql += ", c." + model.DBTagNamespace + " AS " + model.DBComicTagNamespace
+		sql += ", c." + model.DBTagSlug + " AS " + model.DBComicTagSlug + ", c." + model.DBTagNames + " AS tag_names"
+		sql += " FROM data a JOIN " + model.DBComic + " b"
+		sql += " ON a." + model.DBComicGenericComicID + " = b." + model.DBGenericID
+		sql += " JOIN " + model.DBTag + " c"
+		sql += " ON a." + model.DBTagGenericTagID + " = c." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return err
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db Database) ListComicTag(ctx context.Context, params model.ListParams) ([]*model.ComicTag, error) {
+	result := []*model.ComicTag{}
+	args := []any{}
+	sql := listComicTagSQL(&params, &args)
+	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func listComicTagSQL(params *model.ListParams, args *[]any) string {
+	sql := "SELECT * FROM (SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+	sql += ", a." + model.DBComicGenericComicID + ", a." + model.DBTagGenericTagID
+	sql += ", b." + model.DBComicCode + " AS comic_code"
+	sql += ", c." + model.DBTagNamespace + " AS " + model.DBComicTagNamespace
+	sql += ", c." + model.DBTagSlug + " AS " + model.DBComicTagSlug + ", c." + model.DBTagNames + " AS tag_names"
+	sql += " FROM " + model.DBComicTag + " a JOIN " + model.DBComic + " b"
+	sql += " ON a." + model.DBComicGenericComicID + " = b." + model.DBGenericID
+	sql += " JOIN " + model.DBTag + " c"
+	sql += " ON a." + model.DBTagGenericTagID + " = c." + model.DBGenericID
+	sql += ")"
+	SetPartition(params)
+	if cond := SetWhere(params.Conditions, args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicTagNamespace})
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicTagSlug})
+	}
+	sql += " ORDER BY " + SetOrderBys(params.OrderBys, args)
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicTagPaginationDef}
+	}
+	if lmof := SetPagination(*params.Pagination, args); lmof != "" {
+		sql += lmof
+	}
+	return SetPartitionSelect(*params, sql, args)
+}
+
+func (db Database) CountComicTag(ctx context.Context, conds any) (int, error) {
+	return db.GenericCount(ctx, model.DBComicTag, conds)
+}
+
+func comicTagSetError(err error) error {
+	var errDatabase model.DatabaseError
+	if errors.As(err, &errDatabase) {
+		if errDatabase.Code == CodeErrForeign {
+			switch errDatabase.Name {
+			case NameErrComicTagFKey0:
+				return model.GenericError("comic does not exist")
+			case NameErrComicTagFKey1:
+				return model.GenericError("tag does not exist")
+			}
+		}
+		if errDatabase.Code == CodeErrExists && errDatabase.Name == NameErrComicTagPKey {
+			return model.GenericError("same tag id already exists")
+		}
+	}
+	return err
+}
+
 const (
 	NameErrComicChapterFKey = "comic_chapter_comic_id_fkey"
 	NameErrComicChapterKey  = "comic_chapter_comic_id_chapter_version_key"
+	NameErrComicChapterKey0 = "comic_chapter_comic_id_chapter_key"
 )
 
 func (db Database) AddComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) error {
+	return db.addComicChapter(ctx, data, false, v)
+}
+
+// UpsertComicChapter adds the comic chapter or updates the one with the same
+// comic id + chapter + version, release time is only updated when not zero.
+func (db Database) UpsertComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) error {
+	return db.addComicChapter(ctx, data, true, v)
+}
+
+func (db Database) addComicChapter(ctx context.Context, data model.AddComicChapter, upsert bool, v *model.ComicChapter) error {
 	var comicID any
 	switch {
 	case data.ComicID != nil:
@@ -281,8 +1012,27 @@ func (db Database) AddComicChapter(ctx context.Context, data model.AddComicChapt
 		model.DBComicChapterChapter:    data.Chapter,
 		model.DBComicChapterVersion:    data.Version,
 		model.DBComicChapterReleasedAt: data.ReleasedAt,
+		model.DBComicChapterNumber:     model.ComicChapterNumber(data.Chapter),
 	})
 	sql := "INSERT INTO " + model.DBComicChapter + " (" + cols + ") VALUES (" + vals + ")"
+	if upsert {
+		// The key left the trash out as a partial index, which is inferred
+		// from its columns. CockroachDB keeps null versions apart in it, a
+		// chapter without one is keyed by the index of its own.
+		target := "(" + model.DBComicGenericComicID + ", " + model.DBComicChapterChapter
+		target += ", " + model.DBComicChapterVersion + ")"
+		target += " WHERE " + model.DBGenericDeletedAt + " IS NULL"
+		if db.isCRDB() && data.Version == nil {
+			target = "(" + model.DBComicGenericComicID + ", " + model.DBComicChapterChapter + ")"
+			target += " WHERE " + model.DBGenericDeletedAt + " IS NULL"
+			target += " AND " + model.DBComicChapterVersion + " IS NULL"
+		}
+		update := []string{model.DBComicChapterNumber}
+		if !data.ReleasedAt.IsZero() {
+			update = append(update, model.DBComicChapterReleasedAt)
+		}
+		sql += SetUpsert(target, update, &args)
+	}
 	if v != nil {
 		sql += " RETURNING *"
 		sql = "WITH data AS (" + sql + ")"
@@ -290,11 +1040,29 @@ func (db Database) AddComicChapter(ctx context.Context, data model.AddComicChapt
 		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicChapterChapter
 		sql += ", w." + model.DBComicChapterVersion + ", w." + model.DBComicChapterReleasedAt
+		sql += ", w." + model.DBComicChapterNumber
 		sql += ", l." + model.DBComicCode + " AS comic_code"
 		sql += " FROM data w JOIN " + model.DBComic + " l"
 		sql += " ON w." + model.DBComicGenericComicID + " = l." + model.DBGenericID
 		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
-			return comicChapterSetError(err)
+			if !upsert || !errors.As(err, &model.ErrNotFound) {
+				return comicChapterSetError(err)
+			}
+			// The upsert changed nothing and returned no row, the row is
+			// read as it is.
+			result, err := db.GetComicChapter(ctx, model.DBConditionalKV{
+				Key: model.DBGenericID,
+				Value: model.DBComicChapterSIDToID(model.ComicChapterSID{
+					ComicID:   data.ComicID,
+					ComicCode: data.ComicCode,
+					Chapter:   data.Chapter,
+					Version:   data.Version,
+				}),
+			})
+			if err != nil {
+				return err
+			}
+			*v = *result
 		}
 	} else {
 		if err := db.Exec(ctx, sql, args...); err != nil {
@@ -304,18 +1072,61 @@ func (db Database) AddComicChapter(ctx context.Context, data model.AddComicChapt
 	return nil
 }
 
-func (db Database) GetComicChapter(ctx context.Context, conds any) (*model.ComicChapter, error) {
-	var result model.ComicChapter
-	args := []any{}
-	cond := SetWhere(conds, &args)
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
+// AddComicChapters adds the comic chapters in a single insert, the links of
+// the data are left out.
+func (db Database) AddComicChapters(ctx context.Context, data []model.AddComicChapter, v *[]*model.ComicChapter) error {
+	data0 := make([]map[string]any, 0, len(data))
+	for _, data := range data {
+		var comicID any
+		switch {
+		case data.ComicID != nil:
+			comicID = data.ComicID
+		case data.ComicCode != nil:
+			comicID = model.DBComicCodeToID(*data.ComicCode)
+		}
+		data0 = append(data0, map[string]any{
+			model.DBComicGenericComicID:    comicID,
+			model.DBComicChapterChapter:    data.Chapter,
+			model.DBComicChapterVersion:    data.Version,
+			model.DBComicChapterReleasedAt: data.ReleasedAt,
+			model.DBComicChapterNumber:     model.ComicChapterNumber(data.Chapter),
+		})
+	}
+	if v == nil {
+		if err := db.BatchAdd(ctx, model.DBComicChapter, data0, nil); err != nil {
+			return comicChapterSetError(err)
+		}
+		return nil
+	}
+	cols, valx, args := SetBulkInsert(data0)
+	sql := "INSERT INTO " + model.DBComicChapter + " (" + cols + ") VALUES"
+	for i, vals := range valx {
+		if i > 0 {
+			sql += ", "
+		}
+		sql += " (" + vals + ")"
+	}
+	sql += " RETURNING *"
+	sql = "WITH data AS (" + sql + ")"
+	sql += " SELECT w." + model.DBGenericID
 	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicChapterChapter
 	sql += ", w." + model.DBComicChapterVersion + ", w." + model.DBComicChapterReleasedAt
+	sql += ", w." + model.DBComicChapterNumber
 	sql += ", l." + model.DBComicCode + " AS comic_code"
-	sql += " FROM " + model.DBComicChapter + " w JOIN " + model.DBComic + " l"
+	sql += " FROM data w JOIN " + model.DBComic + " l"
 	sql += " ON w." + model.DBComicGenericComicID + " = l." + model.DBGenericID
-	sql += ")"
+	if err := db.QueryAll(ctx, v, sql, args...); err != nil {
+		return comicChapterSetError(err)
+	}
+	return nil
+}
+
+func (db Database) GetComicChapter(ctx context.Context, conds any) (*model.ComicChapter, error) {
+	var result model.ComicChapter
+	args := []any{}
+	cond := SetWhere(conds, &args)
+	sql := "SELECT * FROM (" + comicChapterSelect() + ")"
 	sql += " WHERE " + cond
 	if err := db.QueryOne(ctx, &result, sql, args...); err != nil {
 		return nil, err
@@ -333,6 +1144,7 @@ func (db Database) UpdateComicChapter(ctx context.Context, data model.SetComicCh
 	}
 	if data.Chapter != nil {
 		data0[model.DBComicChapterChapter] = data.Chapter
+		data0[model.DBComicChapterNumber] = model.ComicChapterNumber(*data.Chapter)
 	}
 	if data.Version != nil {
 		data0[model.DBComicChapterVersion] = data.Version
@@ -342,10 +1154,13 @@ func (db Database) UpdateComicChapter(ctx context.Context, data model.SetComicCh
 	}
 	for _, null := range data.SetNull {
 		data0[null] = nil
+		if null == model.DBComicChapterChapter {
+			data0[model.DBComicChapterNumber] = nil
+		}
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
 	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	cond := SetWhere([]any{notDeleted(model.DBComicChapter, conds), SetUpdateWhere(data0)}, &args)
 	sql := "UPDATE " + model.DBComicChapter + " SET " + sets + " WHERE " + cond
 	if v != nil {
 		sql += " RETURNING *"
@@ -354,6 +1169,7 @@ func (db Database) UpdateComicChapter(ctx context.Context, data model.SetComicCh
 		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicChapterChapter
 		sql += ", w." + model.DBComicChapterVersion + ", w." + model.DBComicChapterReleasedAt
+		sql += ", w." + model.DBComicChapterNumber
 		sql += ", l." + model.DBComicCode + " AS comic_code"
 		sql += " FROM data w JOIN " + model.DBComic + " l"
 		sql += " ON w." + model.DBComicGenericComicID + " = l." + model.DBGenericID
@@ -370,8 +1186,8 @@ func (db Database) UpdateComicChapter(ctx context.Context, data model.SetComicCh
 
 func (db Database) DeleteComicChapter(ctx context.Context, conds any, v *model.ComicChapter) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
-	sql := "DELETE FROM " + model.DBComicChapter + " WHERE " + cond
+	sql := "UPDATE " + model.DBComicChapter + " SET " + model.DBGenericDeletedAt + " = " + SetValue(time.Now().UTC(), &args)
+	sql += " WHERE " + SetWhere(notDeleted(model.DBComicChapter, conds), &args)
 	if v != nil {
 		sql += " RETURNING *"
 		sql = "WITH data AS (" + sql + ")"
@@ -379,6 +1195,32 @@ func (db Database) DeleteComicChapter(ctx context.Context, conds any, v *model.C
 		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
 		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicChapterChapter
 		sql += ", w." + model.DBComicChapterVersion + ", w." + model.DBComicChapterReleasedAt
+		sql += ", w." + model.DBComicChapterNumber
+		sql += ", l." + model.DBComicCode + " AS comic_code"
+		sql += " FROM data w JOIN " + model.DBComic + " l"
+		sql += " ON w." + model.DBComicGenericComicID + " = l." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return err
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db Database) RestoreComicChapter(ctx context.Context, conds any, v *model.ComicChapter) error {
+	args := []any{}
+	sql := restoreSQL(model.DBComicChapter, conds, &args)
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT w." + model.DBGenericID
+		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicChapterChapter
+		sql += ", w." + model.DBComicChapterVersion + ", w." + model.DBComicChapterReleasedAt
+		sql += ", w." + model.DBComicChapterNumber
 		sql += ", l." + model.DBComicCode + " AS comic_code"
 		sql += " FROM data w JOIN " + model.DBComic + " l"
 		sql += " ON w." + model.DBComicGenericComicID + " = l." + model.DBGenericID
@@ -396,39 +1238,80 @@ func (db Database) DeleteComicChapter(ctx context.Context, conds any, v *model.C
 func (db Database) ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, error) {
 	result := []*model.ComicChapter{}
 	args := []any{}
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
-	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicChapterChapter
-	sql += ", w." + model.DBComicChapterVersion + ", w." + model.DBComicChapterReleasedAt
-	sql += ", l." + model.DBComicCode + " AS comic_code"
-	sql += " FROM " + model.DBComicChapter + " w JOIN " + model.DBComic + " l"
-	sql += " ON w." + model.DBComicGenericComicID + " = l." + model.DBGenericID
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
-		sql += " WHERE " + cond
+	sql, err := listComicChapterSQL(&params, &args)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
+		return nil, err
 	}
+	SetKeysetNext(params, &result)
+	return result, nil
+}
+
+func listComicChapterSQL(params *model.ListParams, args *[]any) (string, error) {
+	sql := "SELECT * FROM (" + comicChapterSelect() + ")"
 	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicChapterNumber, Null: "last"})
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBComicChapterReleasedAt})
 	}
-	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	if err := SetKeyset(params); err != nil {
+		return "", err
+	}
+	SetPartition(params)
+	if cond := SetWhere(params.Conditions, args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	sql += " ORDER BY " + SetOrderBys(params.OrderBys, args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.ComicChapterPaginationDef}
 	}
-	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+	if lmof := SetPagination(*params.Pagination, args); lmof != "" {
 		sql += lmof
 	}
-	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
-		return nil, err
-	}
-	return result, nil
+	return SetPartitionSelect(*params, sql, args), nil
 }
 
 func (db Database) CountComicChapter(ctx context.Context, conds any) (int, error) {
-	return db.GenericCount(ctx, model.DBComicChapter, conds)
+	var dst int
+	args := []any{}
+	sql := "SELECT COUNT(*) FROM (" + comicChapterSelect() + ")"
+	if cond := SetWhere(conds, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+		return -1, err
+	}
+	return dst, nil
 }
 
 func (db Database) ExistsComicChapter(ctx context.Context, conds any) (bool, error) {
-	return db.GenericExists(ctx, model.DBComicChapter, conds)
+	var dst bool
+	args := []any{}
+	sql := "SELECT EXISTS(SELECT 1 FROM (" + comicChapterSelect() + ")"
+	if cond := SetWhere(conds, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	sql += ")"
+	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+		return false, err
+	}
+	return dst, nil
+}
+
+// comicChapterSelect lists the comic chapters with the code of their comic,
+// those in the trash or of a comic in the trash left out.
+func comicChapterSelect() (sql string) {
+	sql = "SELECT w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBComicGenericComicID + ", w." + model.DBComicChapterChapter
+	sql += ", w." + model.DBComicChapterVersion + ", w." + model.DBComicChapterReleasedAt
+	sql += ", w." + model.DBComicChapterNumber
+	sql += ", l." + model.DBComicCode + " AS comic_code"
+	sql += " FROM " + model.DBComicChapter + " w JOIN " + model.DBComic + " l"
+	sql += " ON w." + model.DBComicGenericComicID + " = l." + model.DBGenericID
+	sql += " WHERE " + notDeletedSQL("w", "l")
+	return
 }
 
 func comicChapterSetError(err error) error {
@@ -437,7 +1320,7 @@ func comicChapterSetError(err error) error {
 		if errDatabase.Code == CodeErrForeign && errDatabase.Name == NameErrComicChapterFKey {
 			return model.GenericError("comic does not exist")
 		}
-		if errDatabase.Code == CodeErrExists && errDatabase.Name == NameErrComicChapterKey {
+		if errDatabase.Code == CodeErrExists && (errDatabase.Name == NameErrComicChapterKey || errDatabase.Name == NameErrComicChapterKey0) {
 			return model.GenericError("same comic id + chapter + version already exists")
 		}
 	}
@@ -451,6 +1334,16 @@ const (
 )
 
 func (db Database) AddComicChapterLink(ctx context.Context, data model.AddComicChapterLink, v *model.ComicChapterLink) error {
+	return db.addComicChapterLink(ctx, data, false, v)
+}
+
+// UpsertComicChapterLink adds the comic chapter link or gets the existing one
+// as it is.
+func (db Database) UpsertComicChapterLink(ctx context.Context, data model.AddComicChapterLink, v *model.ComicChapterLink) error {
+	return db.addComicChapterLink(ctx, data, true, v)
+}
+
+func (db Database) addComicChapterLink(ctx context.Context, data model.AddComicChapterLink, upsert bool, v *model.ComicChapterLink) error {
 	var chapterID any
 	switch {
 	case data.ChapterID != nil:
@@ -470,6 +1363,10 @@ func (db Database) AddComicChapterLink(ctx context.Context, data model.AddComicC
 		model.DBLinkGenericLinkID:            linkID,
 	})
 	sql := "INSERT INTO " + model.DBComicChapterLink + " (" + cols + ") VALUES (" + vals + ")"
+	if upsert {
+		target := "(" + model.DBComicChapterGenericChapterID + ", " + model.DBLinkGenericLinkID + ")"
+		sql += SetUpsert(target, nil, &args)
+	}
 	if v != nil {
 		sql += " RETURNING *"
 		sql = "WITH data AS (" + sql + ")"
@@ -482,7 +1379,19 @@ func (db Database) AddComicChapterLink(ctx context.Context, data model.AddComicC
 		sql += " JOIN " + model.DBWebsite + " c"
 		sql += " ON b." + model.DBWebsiteGenericWebsiteID + " = c." + model.DBGenericID
 		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
-			return comicChapterLinkSetError(err)
+			if !upsert || !errors.As(err, &model.ErrNotFound) {
+				return comicChapterLinkSetError(err)
+			}
+			// The upsert changed nothing and returned no row, the row is
+			// read as it is.
+			result, err := db.GetComicChapterLink(ctx, map[string]any{
+				model.DBComicChapterGenericChapterID: chapterID,
+				model.DBLinkGenericLinkID:            linkID,
+			})
+			if err != nil {
+				return err
+			}
+			*v = *result
 		}
 	} else {
 		if err := db.Exec(ctx, sql, args...); err != nil {
@@ -590,6 +1499,7 @@ func (db Database) ListComicChapterLink(ctx context.Context, params model.ListPa
 	sql += " JOIN " + model.DBWebsite + " c"
 	sql += " ON b." + model.DBWebsiteGenericWebsiteID + " = c." + model.DBGenericID
 	sql += ")"
+	SetPartition(&params)
 	if cond := SetWhere(params.Conditions, &args); cond != "" {
 		sql += " WHERE " + cond
 	}
@@ -603,6 +1513,7 @@ func (db Database) ListComicChapterLink(ctx context.Context, params model.ListPa
 	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
+	sql = SetPartitionSelect(params, sql, &args)
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+const testUpsertCode = "up000001"
+
+// TestUpsertComicChapterUnchanged sends a comic chapter again, it is left as
+// it is until the release time changes and a missing one keeps it.
+func TestUpsertComicChapterUnchanged(t *testing.T) {
+	db := testDatabase(t)
+	ctx := context.Background()
+
+	comic := new(model.Comic)
+	if err := db.AddComic(ctx, model.AddComic{Code: testUpsertCode}, comic); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = db.Exec(ctx, "DELETE FROM "+model.DBComic+" WHERE id = $1", comic.ID)
+	})
+
+	releasedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	added := new(model.ComicChapter)
+	data := model.AddComicChapter{ComicID: &comic.ID, Chapter: "1", ReleasedAt: releasedAt}
+	if err := db.UpsertComicChapter(ctx, data, added); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, releasedAt0 := range []time.Time{releasedAt, {}} {
+		data.ReleasedAt = releasedAt0
+		result := new(model.ComicChapter)
+		if err := db.UpsertComicChapter(ctx, data, result); err != nil {
+			t.Fatal(err)
+		}
+		if result.ID != added.ID || result.UpdatedAt != nil || !result.ReleasedAt.Equal(releasedAt) {
+			t.Errorf("upsert released at %v = %+v, want %+v as it is", releasedAt0, result, added)
+		}
+	}
+
+	data.ReleasedAt = releasedAt.Add(time.Hour)
+	result := new(model.ComicChapter)
+	if err := db.UpsertComicChapter(ctx, data, result); err != nil {
+		t.Fatal(err)
+	}
+	if result.UpdatedAt == nil || !result.ReleasedAt.Equal(data.ReleasedAt) {
+		t.Errorf("upsert a new release time = %+v, want it updated", result)
+	}
+}
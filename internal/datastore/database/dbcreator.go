@@ -0,0 +1,275 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+const (
+	NameErrCreatorKey = "creator_slug_key"
+)
+
+func (db Database) AddCreator(ctx context.Context, data model.AddCreator, v *model.Creator) error {
+	if err := db.GenericAdd(ctx, model.DBCreator, map[string]any{
+		model.DBCreatorSlug:           data.Slug,
+		model.DBCreatorName:           data.Name,
+		model.DBCreatorAlternateNames: data.AlternateNames,
+	}, v); err != nil {
+		return creatorSetError(err)
+	}
+	return nil
+}
+
+func (db Database) GetCreator(ctx context.Context, conds any) (*model.Creator, error) {
+	var result model.Creator
+	if err := db.GenericGet(ctx, model.DBCreator, conds, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (db Database) UpdateCreator(ctx context.Context, data model.SetCreator, conds any, v *model.Creator) error {
+	data0 := map[string]any{}
+	if data.Slug != nil {
+		data0[model.DBCreatorSlug] = data.Slug
+	}
+	if data.Name != nil {
+		data0[model.DBCreatorName] = data.Name
+	}
+	if data.AlternateNames != nil {
+		data0[model.DBCreatorAlternateNames] = data.AlternateNames
+	}
+	for _, null := range data.SetNull {
+		data0[null] = nil
+	}
+	if err := db.GenericUpdate(ctx, model.DBCreator, data0, conds, v); err != nil {
+		return creatorSetError(err)
+	}
+	return nil
+}
+
+func (db Database) DeleteCreator(ctx context.Context, conds any, v *model.Creator) error {
+	return db.GenericDelete(ctx, model.DBCreator, conds, v)
+}
+
+func (db Database) ListCreator(ctx context.Context, params model.ListParams) ([]*model.Creator, error) {
+	result := []*model.Creator{}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBCreatorSlug})
+	}
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.CreatorPaginationDef}
+	}
+	if err := db.GenericList(ctx, model.DBCreator, params, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (db Database) CountCreator(ctx context.Context, conds any) (int, error) {
+	return db.GenericCount(ctx, model.DBCreator, conds)
+}
+
+func (db Database) ExistsCreator(ctx context.Context, conds any) (bool, error) {
+	return db.GenericExists(ctx, model.DBCreator, conds)
+}
+
+func creatorSetError(err error) error {
+	var errDatabase model.DatabaseError
+	if errors.As(err, &errDatabase) {
+		if errDatabase.Code == CodeErrExists && errDatabase.Name == NameErrCreatorKey {
+			return model.GenericError("same slug already exists")
+		}
+	}
+	return err
+}
+
+const (
+	NameErrCreatorLinkPKey  = "creator_link_pkey"
+	NameErrCreatorLinkFKey0 = "creator_link_creator_id_fkey"
+	NameErrCreatorLinkFKey1 = "creator_link_link_id_fkey"
+)
+
+func (db Database) AddCreatorLink(ctx context.Context, data model.AddCreatorLink, v *model.CreatorLink) error {
+	var creatorID any
+	switch {
+	case data.CreatorID != nil:
+		creatorID = data.CreatorID
+	case data.CreatorSlug != nil:
+		creatorID = model.DBCreatorSlugToID(*data.CreatorSlug)
+	}
+	var linkID any
+	switch {
+	case data.LinkID != nil:
+		linkID = data.LinkID
+	case data.LinkSID != nil:
+		linkID = model.DBLinkSIDToID(*data.LinkSID)
+	}
+	cols, vals, args := SetInsert(map[string]any{
+		model.DBCreatorGenericCreatorID: creatorID,
+		model.DBLinkGenericLinkID:       linkID,
+	})
+	sql := "INSERT INTO " + model.DBCreatorLink + " (" + cols + ") VALUES (" + vals + ")"
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+		sql += ", a." + model.DBCreatorGenericCreatorID + ", a." + model.DBLinkGenericLinkID
+		sql += ", b." + model.DBLinkRelativeURL + " AS link_relative_url"
+		sql += ", c." + model.DBWebsiteDomain + " AS link_website_domain"
+		sql += " FROM data a JOIN " + model.DBLink + " b"
+		sql += " ON a." + model.DBLinkGenericLinkID + " = b." + model.DBGenericID
+		sql += " JOIN " + model.DBWebsite + " c"
+		sql += " ON b." + model.DBWebsiteGenericWebsiteID + " = c." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return creatorLinkSetError(err)
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return creatorLinkSetError(err)
+		}
+	}
+	return nil
+}
+
+func (db Database) GetCreatorLink(ctx context.Context, conds any) (*model.CreatorLink, error) {
+	var result model.CreatorLink
+	args := []any{}
+	cond := SetWhere(conds, &args)
+	sql := "SELECT * FROM (SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+	sql += ", a." + model.DBCreatorGenericCreatorID + ", a." + model.DBLinkGenericLinkID
+	sql += ", b." + model.DBLinkRelativeURL + " AS link_relative_url"
+	sql += ", c." + model.DBWebsiteDomain + " AS link_website_domain"
+	sql += " FROM " + model.DBCreatorLink + " a JOIN " + model.DBLink + " b"
+	sql += " ON a." + model.DBLinkGenericLinkID + " = b." + model.DBGenericID
+	sql += " JOIN " + model.DBWebsite + " c"
+	sql += " ON b." + model.DBWebsiteGenericWebsiteID + " = c." + model.DBGenericID
+	sql += ")"
+	sql += " WHERE " + cond
+	if err := db.QueryOne(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (db Database) UpdateCreatorLink(ctx context.Context, data model.SetCreatorLink, conds any, v *model.CreatorLink) error {
+	data0 := map[string]any{}
+	switch {
+	case data.CreatorID != nil:
+		data0[model.DBCreatorGenericCreatorID] = data.CreatorID
+	case data.CreatorSlug != nil:
+		data0[model.DBCreatorGenericCreatorID] = model.DBCreatorSlugToID(*data.CreatorSlug)
+	}
+	switch {
+	case data.LinkID != nil:
+		data0[model.DBLinkGenericLinkID] = data.LinkID
+	case data.LinkSID != nil:
+		data0[model.DBLinkGenericLinkID] = model.DBLinkSIDToID(*data.LinkSID)
+	}
+	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
+	sets, args := SetUpdate(data0)
+	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	sql := "UPDATE " + model.DBCreatorLink + " SET " + sets + " WHERE " + cond
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+		sql += ", a." + model.DBCreatorGenericCreatorID + ", a." + model.DBLinkGenericLinkID
+		sql += ", b." + model.DBLinkRelativeURL + " AS link_relative_url"
+		sql += ", c." + model.DBWebsiteDomain + " AS link_website_domain"
+		sql += " FROM data a JOIN " + model.DBLink + " b"
+		sql += " ON a." + model.DBLinkGenericLinkID + " = b." + model.DBGenericID
+		sql += " JOIN " + model.DBWebsite + " c"
+		sql += " ON b." + model.DBWebsiteGenericWebsiteID + " = c." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return creatorLinkSetError(err)
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return creatorLinkSetError(err)
+		}
+	}
+	return nil
+}
+
+func (db Database) DeleteCreatorLink(ctx context.Context, conds any, v *model.CreatorLink) error {
+	args := []any{}
+	cond := SetWhere(conds, &args)
+	sql := "DELETE FROM " + model.DBCreatorLink + " WHERE " + cond
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+		sql += ", a." + model.DBCreatorGenericCreatorID + ", a." + model.DBLinkGenericLinkID
+		sql += ", b." + model.DBLinkRelativeURL + " AS link_relative_url"
+		sql += ", c." + model.DBWebsiteDomain + " AS link_website_domain"
+		sql += " FROM data a JOIN " + model.DBLink + " b"
+		sql += " ON a." + model.DBLinkGenericLinkID + " = b." + model.DBGenericID
+		sql += " JOIN " + model.DBWebsite + " c"
+		sql += " ON b." + model.DBWebsiteGenericWebsiteID + " = c." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return err
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db Database) ListCreatorLink(ctx context.Context, params model.ListParams) ([]*model.CreatorLink, error) {
+	result := []*model.CreatorLink{}
+	args := []any{}
+	sql := "SELECT * FROM (SELECT a." + model.DBGenericCreatedAt + ", a." + model.DBGenericUpdatedAt
+	sql += ", a." + model.DBCreatorGenericCreatorID + ", a." + model.DBLinkGenericLinkID
+	sql += ", b." + model.DBLinkRelativeURL + " AS link_relative_url"
+	sql += ", c." + model.DBWebsiteDomain + " AS link_website_domain"
+	sql += " FROM " + model.DBCreatorLink + " a JOIN " + model.DBLink + " b"
+	sql += " ON a." + model.DBLinkGenericLinkID + " = b." + model.DBGenericID
+	sql += " JOIN " + model.DBWebsite + " c"
+	sql += " ON b." + model.DBWebsiteGenericWebsiteID + " = c." + model.DBGenericID
+	sql += ")"
+	if cond := SetWhere(params.Conditions, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBLinkGenericLinkID})
+	}
+	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.CreatorLinkPaginationDef}
+	}
+	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+		sql += lmof
+	}
+	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (db Database) CountCreatorLink(ctx context.Context, conds any) (int, error) {
+	return db.GenericCount(ctx, model.DBCreatorLink, conds)
+}
+
+func creatorLinkSetError(err error) error {
+	var errDatabase model.DatabaseError
+	if errors.As(err, &errDatabase) {
+		if errDatabase.Code == CodeErrForeign {
+			switch errDatabase.Name {
+			case NameErrCreatorLinkFKey0:
+				return model.GenericError("creator does not exist")
+			case NameErrCreatorLinkFKey1:
+				return model.GenericError("link does not exist")
+			}
+		}
+		if errDatabase.Code == CodeErrExists && errDatabase.Name == NameErrCreatorLinkPKey {
+			return model.GenericError("same link id already exists")
+		}
+	}
+	return err
+}
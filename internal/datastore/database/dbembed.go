@@ -0,0 +1,300 @@
+package database
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+type (
+	embedComicLink struct {
+		ComicID uint
+		model.Link
+	}
+
+	embedComicChapterLink struct {
+		ChapterID uint
+		model.Link
+	}
+
+	embedLinkTLLanguage struct {
+		LinkID uint
+		model.Language
+	}
+)
+
+// EmbedComic loads the included relations of comics in two round trips, the
+// first for the relations of comics and the second for those of their links
+// and chapters. Every relation is capped per parent by the include limit or
+// its default. Relations left out stay nil so they can be told apart from
+// empty ones.
+func (db Database) EmbedComic(ctx context.Context, result []*model.Comic, include model.ComicInclude) error {
+	if len(result) < 1 {
+		return nil
+	}
+
+	comics := make(map[uint]*model.Comic, len(result))
+	ids := make([]uint, 0, len(result))
+	for _, r := range result {
+		comics[r.ID] = r
+		ids = append(ids, r.ID)
+	}
+	var (
+		queries  []BatchQuery
+		links    []*model.Link
+		chapters []*model.ComicChapter
+	)
+	if include.Has(model.ComicIncludeTitles) {
+		titles := []*model.ComicTitle{}
+		limit := include.LimitOf(model.ComicTitlePaginationDef, model.ComicTitlePaginationMax)
+		params := embedListParams(model.DBComicGenericComicID, ids, limit)
+		args := []any{}
+		queries = append(queries, BatchQuery{
+			SQL:  listComicTitleSQL(&params, &args),
+			Args: args,
+			Dst:  &titles,
+			Done: func() {
+				for _, r := range result {
+					r.Titles = []*model.ComicTitle{}
+				}
+				for _, title := range titles {
+					if r, ok := comics[title.ComicID]; ok {
+						r.Titles = append(r.Titles, title)
+					}
+				}
+			},
+		})
+	}
+	if include.Has(model.ComicIncludeCreators) {
+		creators := []*model.ComicCreator{}
+		limit := include.LimitOf(model.ComicCreatorPaginationDef, model.ComicCreatorPaginationMax)
+		params := embedListParams(model.DBComicGenericComicID, ids, limit)
+		args := []any{}
+		queries = append(queries, BatchQuery{
+			SQL:  listComicCreatorSQL(&params, &args),
+			Args: args,
+			Dst:  &creators,
+			Done: func() {
+				for _, r := range result {
+					r.Creators = []*model.ComicCreator{}
+				}
+				for _, creator := range creators {
+					if r, ok := comics[creator.ComicID]; ok {
+						r.Creators = append(r.Creators, creator)
+					}
+				}
+			},
+		})
+	}
+	if include.Has(model.ComicIncludeTags) {
+		tags := []*model.ComicTag{}
+		limit := include.LimitOf(model.ComicTagPaginationDef, model.ComicTagPaginationMax)
+		params := embedListParams(model.DBComicGenericComicID, ids, limit)
+		args := []any{}
+		queries = append(queries, BatchQuery{
+			SQL:  listComicTagSQL(&params, &args),
+			Args: args,
+			Dst:  &tags,
+			Done: func() {
+				for _, r := range result {
+					r.Tags = []*model.ComicTag{}
+				}
+				for _, tag := range tags {
+					if r, ok := comics[tag.ComicID]; ok {
+						r.Tags = append(r.Tags, tag)
+					}
+				}
+			},
+		})
+	}
+	if include.Has(model.ComicIncludeLinks) || include.Has(model.ComicIncludeLinkTLs) {
+		comicLinks := []*embedComicLink{}
+		limit := include.LimitOf(model.ComicLinkPaginationDef, model.ComicLinkPaginationMax)
+		params := embedListParams(model.DBComicGenericComicID, ids, limit)
+		args := []any{}
+		queries = append(queries, BatchQuery{
+			SQL:  embedLinkSQL(model.DBComicLink, model.DBComicGenericComicID, &params, &args),
+			Args: args,
+			Dst:  &comicLinks,
+			Done: func() {
+				for _, r := range result {
+					r.Links = []*model.Link{}
+				}
+				for _, link := range comicLinks {
+					if r, ok := comics[link.ComicID]; ok {
+						r.Links = append(r.Links, &link.Link)
+						links = append(links, &link.Link)
+					}
+				}
+			},
+		})
+	}
+	if include.Has(model.ComicIncludeChapters) || include.Has(model.ComicIncludeChLinks) {
+		chapters0 := []*model.ComicChapter{}
+		limit := include.LimitOf(model.ComicChapterPaginationDef, model.ComicChapterPaginationMax)
+		params := embedListParams(model.DBComicGenericComicID, ids, limit)
+		args := []any{}
+		sql, err := listComicChapterSQL(&params, &args)
+		if err != nil {
+			return err
+		}
+		queries = append(queries, BatchQuery{
+			SQL:  sql,
+			Args: args,
+			Dst:  &chapters0,
+			Done: func() {
+				for _, r := range result {
+					r.Chapters = []*model.ComicChapter{}
+				}
+				for _, chapter := range chapters0 {
+					if r, ok := comics[chapter.ComicID]; ok {
+						r.Chapters = append(r.Chapters, chapter)
+						chapters = append(chapters, chapter)
+					}
+				}
+			},
+		})
+	}
+	if err := db.QueryBatch(ctx, queries); err != nil {
+		return err
+	}
+
+	queries = nil
+	if include.Has(model.ComicIncludeLinkTLs) && len(links) > 0 {
+		limit := include.LimitOf(model.LinkTLLanguagePaginationDef, model.LinkTLLanguagePaginationMax)
+		queries = append(queries, embedLinkTLLanguagesQuery(links, limit))
+	}
+	if include.Has(model.ComicIncludeChLinks) && len(chapters) > 0 {
+		limit := include.LimitOf(model.ComicChapterLinkPaginationDef, model.ComicChapterLinkPaginationMax)
+		queries = append(queries, embedComicChapterLinksQuery(chapters, limit))
+	}
+	return db.QueryBatch(ctx, queries)
+}
+
+// EmbedComicChapterLinks loads the links of chapters in a single query, a
+// positive limit caps the links of every chapter.
+func (db Database) EmbedComicChapterLinks(ctx context.Context, result []*model.ComicChapter, limit int) error {
+	if len(result) < 1 {
+		return nil
+	}
+
+	return db.QueryBatch(ctx, []BatchQuery{embedComicChapterLinksQuery(result, limit)})
+}
+
+func embedComicChapterLinksQuery(result []*model.ComicChapter, limit int) BatchQuery {
+	chapters := make(map[uint]*model.ComicChapter, len(result))
+	ids := make([]uint, 0, len(result))
+	for _, r := range result {
+		chapters[r.ID] = r
+		ids = append(ids, r.ID)
+	}
+	chapterLinks := []*embedComicChapterLink{}
+	params := embedListParams(model.DBComicChapterGenericChapterID, ids, limit)
+	args := []any{}
+	return BatchQuery{
+		SQL:  embedLinkSQL(model.DBComicChapterLink, model.DBComicChapterGenericChapterID, &params, &args),
+		Args: args,
+		Dst:  &chapterLinks,
+		Done: func() {
+			for _, r := range result {
+				r.Links = []*model.Link{}
+			}
+			for _, link := range chapterLinks {
+				if r, ok := chapters[link.ChapterID]; ok {
+					r.Links = append(r.Links, &link.Link)
+				}
+			}
+		},
+	}
+}
+
+// EmbedLinkTLLanguages loads the translation languages of links in a single
+// query, a positive limit caps the languages of every link.
+func (db Database) EmbedLinkTLLanguages(ctx context.Context, result []*model.Link, limit int) error {
+	if len(result) < 1 {
+		return nil
+	}
+
+	return db.QueryBatch(ctx, []BatchQuery{embedLinkTLLanguagesQuery(result, limit)})
+}
+
+func embedLinkTLLanguagesQuery(result []*model.Link, limit int) BatchQuery {
+	links := make(map[uint][]*model.Link, len(result))
+	ids := make([]uint, 0, len(result))
+	for _, r := range result {
+		if _, ok := links[r.ID]; !ok {
+			ids = append(ids, r.ID)
+		}
+		links[r.ID] = append(links[r.ID], r)
+	}
+	tlLanguages := []*embedLinkTLLanguage{}
+	params := embedListParams(model.DBLinkGenericLinkID, ids, limit)
+	args := []any{}
+	return BatchQuery{
+		SQL:  embedLanguageSQL(model.DBLinkTLLanguage, model.DBLinkGenericLinkID, &params, &args),
+		Args: args,
+		Dst:  &tlLanguages,
+		Done: func() {
+			for _, r := range result {
+				r.TLLanguages = []*model.Language{}
+			}
+			for _, tlLanguage := range tlLanguages {
+				for _, r := range links[tlLanguage.LinkID] {
+					r.TLLanguages = append(r.TLLanguages, &tlLanguage.Language)
+				}
+			}
+		},
+	}
+}
+
+// embedLinkSQL selects the links joined through the table t along with the
+// key of their parent.
+func embedLinkSQL(t, key string, params *model.ListParams, args *[]any) string {
+	sql := "SELECT * FROM (SELECT a." + key + ", w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBLinkRelativeURL
+	sql += ", w." + model.DBLinkMachineTL
+	sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
+	sql += " FROM " + t + " a JOIN " + model.DBLink + " w"
+	sql += " ON a." + model.DBLinkGenericLinkID + " = w." + model.DBGenericID
+	sql += " JOIN " + model.DBWebsite + " l"
+	sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
+	sql += " WHERE " + notDeletedSQL("w", "l")
+	sql += ")"
+	SetPartition(params)
+	if cond := SetWhere(params.Conditions, args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	}
+	sql += " ORDER BY " + SetOrderBys(params.OrderBys, args)
+	if params.Pagination != nil {
+		sql += SetPagination(*params.Pagination, args)
+	}
+	return SetPartitionSelect(*params, sql, args)
+}
+
+// embedLanguageSQL selects the languages joined through the table t along
+// with the key of their parent.
+func embedLanguageSQL(t, key string, params *model.ListParams, args *[]any) string {
+	sql := "SELECT * FROM (SELECT a." + key + ", w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBLanguageIETF + ", w." + model.DBLanguageName
+	sql += " FROM " + t + " a JOIN " + model.DBLanguage + " w"
+	sql += " ON a." + model.DBLanguageGenericLanguageID + " = w." + model.DBGenericID
+	sql += " WHERE " + notDeletedSQL("w")
+	sql += ")"
+	SetPartition(params)
+	if cond := SetWhere(params.Conditions, args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
+	}
+	sql += " ORDER BY " + SetOrderBys(params.OrderBys, args)
+	if params.Pagination != nil {
+		sql += SetPagination(*params.Pagination, args)
+	}
+	return SetPartitionSelect(*params, sql, args)
+}
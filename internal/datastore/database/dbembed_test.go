@@ -0,0 +1,284 @@
+package database
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+const (
+	benchComics     = 50
+	benchComicLinks = 5
+	benchChapters   = 20
+	benchDomain     = "bench.example"
+)
+
+// BenchmarkEmbedComic compares loading the relations of a page of comics at
+// once with the fan out of list queries it replaced, and with loading them
+// comic by comic as getting a single comic does. Every variant caps the
+// relations the same way and is checked to embed the same rows first. It
+// needs a database, see testDatabase.
+func BenchmarkEmbedComic(b *testing.B) {
+	db, comics := benchEmbedComicSetup(b)
+	include := model.ComicIncludeDef
+
+	variants := []struct {
+		name string
+		fn   func(ctx context.Context) error
+	}{
+		{"FanOut", func(ctx context.Context) error {
+			return benchEmbedComicFanOut(ctx, db, comics, include)
+		}},
+		{"PerComic", func(ctx context.Context) error {
+			for _, comic := range comics {
+				if err := db.EmbedComic(ctx, []*model.Comic{comic}, include); err != nil {
+					return err
+				}
+			}
+			return nil
+		}},
+		{"Batch", func(ctx context.Context) error {
+			return db.EmbedComic(ctx, comics, include)
+		}},
+	}
+	want := -1
+	for _, variant := range variants {
+		if err := variant.fn(context.Background()); err != nil {
+			b.Fatal(err)
+		}
+		rows := benchEmbedComicRows(comics)
+		if want < 0 {
+			want = rows
+		} else if rows != want {
+			b.Fatalf("%s embeds %d rows, want %d", variant.name, rows, want)
+		}
+	}
+	for _, variant := range variants {
+		b.Run(variant.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := variant.fn(context.Background()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// benchEmbedComicRows counts the rows embedded in comics.
+func benchEmbedComicRows(comics []*model.Comic) int {
+	rows := 0
+	for _, comic := range comics {
+		rows += len(comic.Titles) + len(comic.Creators) + len(comic.Tags) + len(comic.Links) + len(comic.Chapters)
+		for _, chapter := range comic.Chapters {
+			rows += len(chapter.Links)
+		}
+	}
+	return rows
+}
+
+func benchEmbedComicSetup(b *testing.B) (*Database, []*model.Comic) {
+	db := testDatabase(b)
+	ctx := context.Background()
+
+	// Only the rows seeded here are deleted after, the comics by their IDs and
+	// the links along with the website they belong to.
+	var websiteID uint
+	if err := db.QueryOne(ctx, &websiteID,
+		"INSERT INTO "+model.DBWebsite+" (domain, name) VALUES ($1, 'Bench') RETURNING id", benchDomain,
+	); err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() {
+		_ = db.Exec(ctx, "DELETE FROM "+model.DBWebsite+" WHERE id = $1", websiteID)
+	})
+	var comicIDs []uint
+	if err := db.QueryAll(ctx, &comicIDs,
+		"INSERT INTO "+model.DBComic+" (code) SELECT 'bn' || lpad(i::text, 6, '0')"+
+			" FROM generate_series(1, "+strconv.Itoa(benchComics)+") AS i RETURNING id",
+	); err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() {
+		_ = db.Exec(ctx, "DELETE FROM "+model.DBComic+" WHERE id = ANY($1)", comicIDs)
+	})
+
+	comic := " FROM " + model.DBComic + " c"
+	website := " FROM " + model.DBWebsite + " w"
+	seeds := []string{
+		"INSERT INTO " + model.DBLink + " (website_id, relative_url) SELECT w.id, '/c/' || c.code || '/' || i::text" +
+			website + " CROSS JOIN " + model.DBComic + " c CROSS JOIN generate_series(1, " + strconv.Itoa(benchComicLinks) + ") AS i" +
+			" WHERE w.id = $1 AND c.id = ANY($2)",
+		"INSERT INTO " + model.DBComicLink + " (comic_id, link_id) SELECT c.id, l.id" +
+			comic + " JOIN " + model.DBLink + " l ON l.relative_url LIKE '/c/' || c.code || '/%'" +
+			" WHERE l.website_id = $1 AND c.id = ANY($2)",
+		"INSERT INTO " + model.DBComicChapter + " (comic_id, chapter, released_at) SELECT c.id, i::text, now()" +
+			comic + " CROSS JOIN generate_series(1, " + strconv.Itoa(benchChapters) + ") AS i" +
+			" WHERE c.id = ANY($2)",
+		"INSERT INTO " + model.DBLink + " (website_id, relative_url) SELECT w.id, '/ch/' || ch.id::text" +
+			website + " CROSS JOIN " + model.DBComicChapter + " ch" +
+			" WHERE w.id = $1 AND ch.comic_id = ANY($2)",
+		"INSERT INTO " + model.DBComicChapterLink + " (chapter_id, link_id) SELECT ch.id, l.id" +
+			" FROM " + model.DBComicChapter + " ch JOIN " + model.DBLink + " l ON l.relative_url = '/ch/' || ch.id::text" +
+			" WHERE l.website_id = $1 AND ch.comic_id = ANY($2)",
+	}
+	for _, seed := range seeds {
+		if err := db.Exec(ctx, seed, websiteID, comicIDs); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	comics, err := db.ListComic(ctx, model.ListParams{
+		Conditions: model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: comicIDs}},
+		Pagination: &model.Pagination{},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return db, comics
+}
+
+// benchEmbedComicFanOut is the previous implementation, the service loader
+// EmbedComic replaced. It runs a list query for every relation and another for
+// the links behind them, then stitches them by nested loops.
+func benchEmbedComicFanOut(ctx context.Context, db *Database, result []*model.Comic, include model.ComicInclude) error {
+	ids := make([]uint, 0, len(result))
+	for _, r := range result {
+		ids = append(ids, r.ID)
+	}
+	params := func(key string, ids []uint, def, max int) model.ListParams {
+		return embedListParams(key, ids, include.LimitOf(def, max))
+	}
+	listLink := func(ctx context.Context, linkIDs []uint) (map[uint]*model.Link, error) {
+		links, err := db.ListLink(ctx, model.ListParams{
+			Conditions: model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: linkIDs}},
+			Pagination: &model.Pagination{},
+		})
+		if err != nil {
+			return nil, err
+		}
+		result := map[uint]*model.Link{}
+		for _, link := range links {
+			result[link.ID] = link
+		}
+		return result, nil
+	}
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		titles, err := db.ListComicTitle(gctx, params(model.DBComicGenericComicID, ids,
+			model.ComicTitlePaginationDef, model.ComicTitlePaginationMax))
+		if err != nil {
+			return err
+		}
+		for _, r := range result {
+			r.Titles = []*model.ComicTitle{}
+			for _, title := range titles {
+				if r.ID == title.ComicID {
+					r.Titles = append(r.Titles, title)
+				}
+			}
+		}
+		return nil
+	})
+	g.Go(func() error {
+		creators, err := db.ListComicCreator(gctx, params(model.DBComicGenericComicID, ids,
+			model.ComicCreatorPaginationDef, model.ComicCreatorPaginationMax))
+		if err != nil {
+			return err
+		}
+		for _, r := range result {
+			r.Creators = []*model.ComicCreator{}
+			for _, creator := range creators {
+				if r.ID == creator.ComicID {
+					r.Creators = append(r.Creators, creator)
+				}
+			}
+		}
+		return nil
+	})
+	g.Go(func() error {
+		tags, err := db.ListComicTag(gctx, params(model.DBComicGenericComicID, ids,
+			model.ComicTagPaginationDef, model.ComicTagPaginationMax))
+		if err != nil {
+			return err
+		}
+		for _, r := range result {
+			r.Tags = []*model.ComicTag{}
+			for _, tag := range tags {
+				if r.ID == tag.ComicID {
+					r.Tags = append(r.Tags, tag)
+				}
+			}
+		}
+		return nil
+	})
+	g.Go(func() error {
+		comicLinks, err := db.ListComicLink(gctx, params(model.DBComicGenericComicID, ids,
+			model.ComicLinkPaginationDef, model.ComicLinkPaginationMax))
+		if err != nil {
+			return err
+		}
+		linkIDs := []uint{}
+		for _, link := range comicLinks {
+			linkIDs = append(linkIDs, link.LinkID)
+		}
+		links, err := listLink(gctx, linkIDs)
+		if err != nil {
+			return err
+		}
+		for _, r := range result {
+			r.Links = []*model.Link{}
+			for _, link := range comicLinks {
+				if r.ID == link.ComicID {
+					r.Links = append(r.Links, links[link.LinkID])
+				}
+			}
+		}
+		return nil
+	})
+	g.Go(func() error {
+		chapters, err := db.ListComicChapter(gctx, params(model.DBComicGenericComicID, ids,
+			model.ComicChapterPaginationDef, model.ComicChapterPaginationMax))
+		if err != nil {
+			return err
+		}
+		chapterIDs := make([]uint, 0, len(chapters))
+		for _, chapter := range chapters {
+			chapterIDs = append(chapterIDs, chapter.ID)
+		}
+		chapterLinks, err := db.ListComicChapterLink(gctx, params(model.DBComicChapterGenericChapterID, chapterIDs,
+			model.ComicChapterLinkPaginationDef, model.ComicChapterLinkPaginationMax))
+		if err != nil {
+			return err
+		}
+		linkIDs := []uint{}
+		for _, link := range chapterLinks {
+			linkIDs = append(linkIDs, link.LinkID)
+		}
+		links, err := listLink(gctx, linkIDs)
+		if err != nil {
+			return err
+		}
+		for _, chapter := range chapters {
+			chapter.Links = []*model.Link{}
+			for _, link := range chapterLinks {
+				if chapter.ID == link.ChapterID {
+					chapter.Links = append(chapter.Links, links[link.LinkID])
+				}
+			}
+		}
+		for _, r := range result {
+			r.Chapters = []*model.ComicChapter{}
+			for _, chapter := range chapters {
+				if r.ID == chapter.ComicID {
+					r.Chapters = append(r.Chapters, chapter)
+				}
+			}
+		}
+		return nil
+	})
+	return g.Wait()
+}
@@ -0,0 +1,143 @@
+package database
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	bagicore "github.com/mahmudindes/orenocomic-bagicore"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+type cascadeKey struct {
+	Table    string
+	Column   string
+	RefTable string
+}
+
+// cascadeKeys mirrors the foreign keys of the migrations that delete their
+// rows along with the row they reference, every one of them references id.
+var cascadeKeys = []cascadeKey{
+	{model.DBWebsiteTLLanguage, model.DBWebsiteGenericWebsiteID, model.DBWebsite},
+	{model.DBWebsiteTLLanguage, model.DBLanguageGenericLanguageID, model.DBLanguage},
+	{model.DBLink, model.DBWebsiteGenericWebsiteID, model.DBWebsite},
+	{model.DBLinkTLLanguage, model.DBLinkGenericLinkID, model.DBLink},
+	{model.DBLinkTLLanguage, model.DBLanguageGenericLanguageID, model.DBLanguage},
+	{model.DBComicLink, model.DBComicGenericComicID, model.DBComic},
+	{model.DBComicLink, model.DBLinkGenericLinkID, model.DBLink},
+	{model.DBComicChapter, model.DBComicGenericComicID, model.DBComic},
+	{model.DBComicChapterLink, model.DBComicChapterGenericChapterID, model.DBComicChapter},
+	{model.DBComicChapterLink, model.DBLinkGenericLinkID, model.DBLink},
+	{model.DBComicTitle, model.DBComicGenericComicID, model.DBComic},
+	{model.DBComicTitle, model.DBLanguageGenericLanguageID, model.DBLanguage},
+	{model.DBCreatorLink, model.DBCreatorGenericCreatorID, model.DBCreator},
+	{model.DBCreatorLink, model.DBLinkGenericLinkID, model.DBLink},
+	{model.DBComicCreator, model.DBComicGenericComicID, model.DBComic},
+	{model.DBComicCreator, model.DBCreatorGenericCreatorID, model.DBCreator},
+	{model.DBComicTag, model.DBComicGenericComicID, model.DBComic},
+	{model.DBComicTag, model.DBTagGenericTagID, model.DBTag},
+	{model.DBWebsiteDomainAlias, model.DBWebsiteGenericWebsiteID, model.DBWebsite},
+	{model.DBComicCodeAlias, model.DBComicGenericComicID, model.DBComic},
+}
+
+// DeleteImpact counts the rows that deleting the rows of the table t matching
+// conds moves to the trash, those of the soft delete tables that go along with
+// them included, and those that purging them takes along. It only reads.
+func (db Database) DeleteImpact(ctx context.Context, t string, conds any) (*model.DeleteImpact, error) {
+	result := &model.DeleteImpact{}
+	for _, impact := range []struct {
+		dst     *map[string]int
+		trashed bool
+	}{
+		{&result.Trashed, true},
+		{&result.Purged, false},
+	} {
+		var dst []struct {
+			Name  string
+			Count int
+		}
+		args := []any{}
+		*impact.dst = map[string]int{}
+		sql := impactSelect(t, conds, impact.trashed, &args)
+		if sql == "" {
+			continue
+		}
+		if err := db.QueryAll(ctx, &dst, sql, args...); err != nil {
+			return nil, err
+		}
+		for _, d := range dst {
+			(*impact.dst)[strings.TrimPrefix(d.Name, bagicore.ID+".")] = d.Count
+		}
+	}
+	return result, nil
+}
+
+// impactSelect walks the cascading foreign keys from the table t, the rows of
+// a table are hit when any of their keys references a row hit before them. If
+// trashed is set the walk keeps to the trashable tables and to the rows out
+// of the trash, the rows of t counted as well, otherwise every row is hit
+// since a purge deletes those in the trash all the same.
+func impactSelect(t string, conds any, trashed bool, args *[]any) (sql string) {
+	keys := cascadeKeys
+	if trashed {
+		keys = slices.DeleteFunc(slices.Clone(keys), func(key cascadeKey) bool {
+			return !slices.Contains(softDeleteTables, key.Table) || !slices.Contains(softDeleteTables, key.RefTable)
+		})
+	}
+
+	tables := []string{t}
+	for i := 0; i < len(tables); i++ {
+		for _, key := range keys {
+			if key.RefTable == tables[i] && !slices.Contains(tables, key.Table) {
+				tables = append(tables, key.Table)
+			}
+		}
+	}
+
+	where := map[string]string{}
+	if trashed {
+		where[t] = SetWhere(notDeleted(t, conds), args)
+	} else {
+		where[t] = SetWhere(conds, args)
+	}
+	var whereOf func(table string) string
+	whereOf = func(table string) string {
+		if cond, ok := where[table]; ok {
+			return cond
+		}
+		cond := ""
+		for _, key := range keys {
+			if key.Table != table || !slices.Contains(tables, key.RefTable) {
+				continue
+			}
+			if cond != "" {
+				cond += " OR "
+			}
+			cond += key.Column + " IN (SELECT " + model.DBGenericID + " FROM " + key.RefTable
+			if refCond := whereOf(key.RefTable); refCond != "" {
+				cond += " WHERE " + refCond
+			}
+			cond += ")"
+		}
+		if trashed {
+			cond = "(" + cond + ") AND " + model.DBGenericDeletedAt + " IS NULL"
+		}
+		where[table] = cond
+		return cond
+	}
+
+	counted := tables
+	if !trashed {
+		counted = tables[1:]
+	}
+	for i, table := range counted {
+		if i > 0 {
+			sql += " UNION ALL "
+		}
+		sql += "SELECT '" + table + "' AS name, COUNT(*) AS count FROM " + table
+		if cond := whereOf(table); cond != "" {
+			sql += " WHERE " + cond
+		}
+	}
+	return
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"io/fs"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-bagicore/embedded"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+// TestCascadeKeys keeps cascadeKeys in step with the foreign keys created by
+// the migrations.
+func TestCascadeKeys(t *testing.T) {
+	re := regexp.MustCompile(`ALTER TABLE ONLY (\S+) ADD CONSTRAINT \S+\s+` +
+		`FOREIGN KEY \((\w+)\) REFERENCES (\S+)\(id\) ON DELETE CASCADE;`)
+	files, err := fs.Glob(embedded.PGMigrations, "migrations/*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []cascadeKey
+	for _, file := range files {
+		b, err := fs.ReadFile(embedded.PGMigrations, file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		up, _, _ := strings.Cut(string(b), "-- +goose Down")
+		for _, m := range re.FindAllStringSubmatch(up, -1) {
+			keys = append(keys, cascadeKey{Table: m[1], Column: m[2], RefTable: m[3]})
+		}
+	}
+	for _, key := range keys {
+		if !slices.Contains(cascadeKeys, key) {
+			t.Errorf("cascadeKeys misses %+v", key)
+		}
+	}
+	for _, key := range cascadeKeys {
+		if !slices.Contains(keys, key) {
+			t.Errorf("cascadeKeys has %+v not in migrations", key)
+		}
+	}
+}
+
+func TestImpactSelect(t *testing.T) {
+	args := []any{}
+	sql := impactSelect(model.DBLanguage, model.DBConditionalKV{Key: model.DBLanguageIETF, Value: "en"}, false, &args)
+	language := "language_id IN (SELECT id FROM " + model.DBLanguage + " WHERE ietf = $1)"
+	want := "SELECT '" + model.DBWebsiteTLLanguage + "' AS name, COUNT(*) AS count FROM " + model.DBWebsiteTLLanguage +
+		" WHERE " + language +
+		" UNION ALL SELECT '" + model.DBLinkTLLanguage + "' AS name, COUNT(*) AS count FROM " + model.DBLinkTLLanguage +
+		" WHERE " + language +
+		" UNION ALL SELECT '" + model.DBComicTitle + "' AS name, COUNT(*) AS count FROM " + model.DBComicTitle +
+		" WHERE " + language
+	if sql != want {
+		t.Errorf("sql = %q, want %q", sql, want)
+	}
+	if !slices.Equal(args, []any{"en"}) {
+		t.Errorf("args = %v, want [en]", args)
+	}
+}
+
+// TestImpactSelectTrashed keeps the walk of a soft delete to the rows out of
+// the trash of the trashable tables, the deleted one counted as well.
+func TestImpactSelectTrashed(t *testing.T) {
+	args := []any{}
+	sql := impactSelect(model.DBWebsite, model.DBConditionalKV{Key: model.DBWebsiteDomain, Value: "example.com"}, true, &args)
+	website := "domain = $1 AND deleted_at IS NULL"
+	want := "SELECT '" + model.DBWebsite + "' AS name, COUNT(*) AS count FROM " + model.DBWebsite +
+		" WHERE " + website +
+		" UNION ALL SELECT '" + model.DBLink + "' AS name, COUNT(*) AS count FROM " + model.DBLink +
+		" WHERE (website_id IN (SELECT id FROM " + model.DBWebsite + " WHERE " + website + ")) AND deleted_at IS NULL"
+	if sql != want {
+		t.Errorf("sql = %q, want %q", sql, want)
+	}
+	if !slices.Equal(args, []any{"example.com"}) {
+		t.Errorf("args = %v, want [example.com]", args)
+	}
+}
+
+// TestCascadeDeleteSQL keeps the rows a soft delete takes along to the trash
+// in step with those the impact report counts as trashed.
+func TestCascadeDeleteSQL(t *testing.T) {
+	updated := regexp.MustCompile(`^UPDATE (\S+) SET `)
+	counted := regexp.MustCompile(`SELECT '(\S+)' AS name`)
+	for _, table := range softDeleteTables {
+		var got []string
+		for _, query := range cascadeDeleteSQL(table, "SELECT id FROM "+table, nil, time.Now()) {
+			got = append(got, updated.FindStringSubmatch(query.SQL)[1])
+		}
+		var want []string
+		for _, m := range counted.FindAllStringSubmatch(impactSelect(table, nil, true, &[]any{}), -1) {
+			if m[1] != table {
+				want = append(want, m[1])
+			}
+		}
+		slices.Sort(got)
+		slices.Sort(want)
+		if !slices.Equal(got, want) {
+			t.Errorf("deleting %s trashes %v, the impact report counts %v", table, got, want)
+		}
+	}
+}
@@ -47,6 +47,13 @@ func (db Database) DeleteLanguage(ctx context.Context, conds any, v *model.Langu
 	return db.GenericDelete(ctx, model.DBLanguage, conds, v)
 }
 
+func (db Database) RestoreLanguage(ctx context.Context, conds any, v *model.Language) error {
+	if err := db.GenericRestore(ctx, model.DBLanguage, conds, v); err != nil {
+		return languageSetError(err)
+	}
+	return nil
+}
+
 func (db Database) ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, error) {
 	result := []*model.Language{}
 	if len(params.OrderBys) < 1 {
@@ -65,6 +72,10 @@ func (db Database) CountLanguage(ctx context.Context, conds any) (int, error) {
 	return db.GenericCount(ctx, model.DBLanguage, conds)
 }
 
+func (db Database) ExistsLanguage(ctx context.Context, conds any) (bool, error) {
+	return db.GenericExists(ctx, model.DBLanguage, conds)
+}
+
 func languageSetError(err error) error {
 	var errDatabase model.DatabaseError
 	if errors.As(err, &errDatabase) {
@@ -14,6 +14,16 @@ const (
 )
 
 func (db Database) AddLink(ctx context.Context, data model.AddLink, v *model.Link) error {
+	return db.addLink(ctx, data, false, v)
+}
+
+// UpsertLink adds the link or updates the one with the same website id +
+// relative url, machine tl is only updated when set.
+func (db Database) UpsertLink(ctx context.Context, data model.AddLink, v *model.Link) error {
+	return db.addLink(ctx, data, true, v)
+}
+
+func (db Database) addLink(ctx context.Context, data model.AddLink, upsert bool, v *model.Link) error {
 	var websiteID any
 	switch {
 	case data.WebsiteID != nil:
@@ -27,6 +37,15 @@ func (db Database) AddLink(ctx context.Context, data model.AddLink, v *model.Lin
 		model.DBLinkMachineTL:           data.MachineTL,
 	})
 	sql := "INSERT INTO " + model.DBLink + " (" + cols + ") VALUES (" + vals + ")"
+	if upsert {
+		target := "(" + model.DBWebsiteGenericWebsiteID + ", " + model.DBLinkRelativeURL + ")"
+		target += " WHERE " + model.DBGenericDeletedAt + " IS NULL"
+		update := []string{}
+		if data.MachineTL != nil {
+			update = append(update, model.DBLinkMachineTL)
+		}
+		sql += SetUpsert(target, update, &args)
+	}
 	if v != nil {
 		sql += " RETURNING *"
 		sql = "WITH data AS (" + sql + ")"
@@ -38,7 +57,23 @@ func (db Database) AddLink(ctx context.Context, data model.AddLink, v *model.Lin
 		sql += " FROM data w JOIN " + model.DBWebsite + " l"
 		sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
 		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
-			return linkSetError(err)
+			if !upsert || !errors.As(err, &model.ErrNotFound) {
+				return linkSetError(err)
+			}
+			// The upsert changed nothing and returned no row, the row is
+			// read as it is.
+			result, err := db.GetLink(ctx, model.DBConditionalKV{
+				Key: model.DBGenericID,
+				Value: model.DBLinkSIDToID(model.LinkSID{
+					WebsiteID:     data.WebsiteID,
+					WebsiteDomain: data.WebsiteDomain,
+					RelativeURL:   data.RelativeURL,
+				}),
+			})
+			if err != nil {
+				return err
+			}
+			*v = *result
 		}
 	} else {
 		if err := db.Exec(ctx, sql, args...); err != nil {
@@ -52,14 +87,7 @@ func (db Database) GetLink(ctx context.Context, conds any) (*model.Link, error)
 	var result model.Link
 	args := []any{}
 	cond := SetWhere(conds, &args)
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
-	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBLinkRelativeURL
-	sql += ", w." + model.DBLinkMachineTL
-	sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
-	sql += " FROM " + model.DBLink + " w JOIN " + model.DBWebsite + " l"
-	sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
-	sql += ")"
+	sql := "SELECT * FROM (" + linkSelect() + ")"
 	sql += " WHERE " + cond
 	if err := db.QueryOne(ctx, &result, sql, args...); err != nil {
 		return nil, err
@@ -86,7 +114,7 @@ func (db Database) UpdateLink(ctx context.Context, data model.SetLink, conds any
 	}
 	data0[model.DBGenericUpdatedAt] = time.Now().UTC()
 	sets, args := SetUpdate(data0)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data0)}, &args)
+	cond := SetWhere([]any{notDeleted(model.DBLink, conds), SetUpdateWhere(data0)}, &args)
 	sql := "UPDATE " + model.DBLink + " SET " + sets + " WHERE " + cond
 	if v != nil {
 		sql += " RETURNING *"
@@ -111,8 +139,8 @@ func (db Database) UpdateLink(ctx context.Context, data model.SetLink, conds any
 
 func (db Database) DeleteLink(ctx context.Context, conds any, v *model.Link) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
-	sql := "DELETE FROM " + model.DBLink + " WHERE " + cond
+	sql := "UPDATE " + model.DBLink + " SET " + model.DBGenericDeletedAt + " = " + SetValue(time.Now().UTC(), &args)
+	sql += " WHERE " + SetWhere(notDeleted(model.DBLink, conds), &args)
 	if v != nil {
 		sql += " RETURNING *"
 		sql = "WITH data AS (" + sql + ")"
@@ -134,23 +162,43 @@ func (db Database) DeleteLink(ctx context.Context, conds any, v *model.Link) err
 	return nil
 }
 
+func (db Database) RestoreLink(ctx context.Context, conds any, v *model.Link) error {
+	args := []any{}
+	sql := restoreSQL(model.DBLink, conds, &args)
+	if v != nil {
+		sql += " RETURNING *"
+		sql = "WITH data AS (" + sql + ")"
+		sql += " SELECT w." + model.DBGenericID
+		sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+		sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBLinkRelativeURL
+		sql += ", w." + model.DBLinkMachineTL
+		sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
+		sql += " FROM data w JOIN " + model.DBWebsite + " l"
+		sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
+		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
+			return linkSetError(err)
+		}
+	} else {
+		if err := db.Exec(ctx, sql, args...); err != nil {
+			return linkSetError(err)
+		}
+	}
+	return nil
+}
+
 func (db Database) ListLink(ctx context.Context, params model.ListParams) ([]*model.Link, error) {
 	result := []*model.Link{}
 	args := []any{}
-	sql := "SELECT * FROM (SELECT w." + model.DBGenericID
-	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
-	sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBLinkRelativeURL
-	sql += ", w." + model.DBLinkMachineTL
-	sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
-	sql += " FROM " + model.DBLink + " w JOIN " + model.DBWebsite + " l"
-	sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
-	sql += ")"
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
-		sql += " WHERE " + cond
-	}
+	sql := "SELECT * FROM (" + linkSelect() + ")"
 	if len(params.OrderBys) < 1 {
 		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBGenericID})
 	}
+	if err := SetKeyset(&params); err != nil {
+		return nil, err
+	}
+	if cond := SetWhere(params.Conditions, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
 	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
 	if params.Pagination == nil {
 		params.Pagination = &model.Pagination{Page: 1, Limit: model.LinkPaginationDef}
@@ -161,20 +209,57 @@ func (db Database) ListLink(ctx context.Context, params model.ListParams) ([]*mo
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
 		return nil, err
 	}
+	SetKeysetNext(params, &result)
 	return result, nil
 }
 
 func (db Database) CountLink(ctx context.Context, conds any) (int, error) {
-	return db.GenericCount(ctx, model.DBLink, conds)
+	var dst int
+	args := []any{}
+	sql := "SELECT COUNT(*) FROM (" + linkSelect() + ")"
+	if cond := SetWhere(conds, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+		return -1, err
+	}
+	return dst, nil
 }
 
 func (db Database) ExistsLink(ctx context.Context, conds any) (bool, error) {
-	return db.GenericExists(ctx, model.DBLink, conds)
+	var dst bool
+	args := []any{}
+	sql := "SELECT EXISTS(SELECT 1 FROM (" + linkSelect() + ")"
+	if cond := SetWhere(conds, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	sql += ")"
+	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+		return false, err
+	}
+	return dst, nil
+}
+
+// linkSelect lists the links with the domain of their website, those in the
+// trash or of a website in the trash left out.
+func linkSelect() (sql string) {
+	sql = "SELECT w." + model.DBGenericID
+	sql += ", w." + model.DBGenericCreatedAt + ", w." + model.DBGenericUpdatedAt
+	sql += ", w." + model.DBWebsiteGenericWebsiteID + ", w." + model.DBLinkRelativeURL
+	sql += ", w." + model.DBLinkMachineTL
+	sql += ", l." + model.DBWebsiteDomain + " AS website_domain"
+	sql += " FROM " + model.DBLink + " w JOIN " + model.DBWebsite + " l"
+	sql += " ON w." + model.DBWebsiteGenericWebsiteID + " = l." + model.DBGenericID
+	sql += " WHERE " + notDeletedSQL("w", "l")
+	return
 }
 
 func linkSetError(err error) error {
 	var errDatabase model.DatabaseError
 	if errors.As(err, &errDatabase) {
+		if errDatabase.Code == CodeErrForeign && errDatabase.Name == NameErrLinkFKey {
+			return model.GenericError("website does not exist")
+		}
 		if errDatabase.Code == CodeErrExists && errDatabase.Name == NameErrLinkKey {
 			return model.GenericError("same website id + path already exists")
 		}
@@ -189,6 +274,16 @@ const (
 )
 
 func (db Database) AddLinkTLLanguage(ctx context.Context, data model.AddLinkTLLanguage, v *model.LinkTLLanguage) error {
+	return db.addLinkTLLanguage(ctx, data, false, v)
+}
+
+// UpsertLinkTLLanguage adds the link tl language or gets the existing one as
+// it is.
+func (db Database) UpsertLinkTLLanguage(ctx context.Context, data model.AddLinkTLLanguage, v *model.LinkTLLanguage) error {
+	return db.addLinkTLLanguage(ctx, data, true, v)
+}
+
+func (db Database) addLinkTLLanguage(ctx context.Context, data model.AddLinkTLLanguage, upsert bool, v *model.LinkTLLanguage) error {
 	var linkID any
 	switch {
 	case data.LinkID != nil:
@@ -208,6 +303,10 @@ func (db Database) AddLinkTLLanguage(ctx context.Context, data model.AddLinkTLLa
 		model.DBLanguageGenericLanguageID: languageID,
 	})
 	sql := "INSERT INTO " + model.DBLinkTLLanguage + " (" + cols + ") VALUES (" + vals + ")"
+	if upsert {
+		target := "(" + model.DBLinkGenericLinkID + ", " + model.DBLanguageGenericLanguageID + ")"
+		sql += SetUpsert(target, nil, &args)
+	}
 	if v != nil {
 		sql += " RETURNING *"
 		sql = "WITH data AS (" + sql + ")"
@@ -217,7 +316,19 @@ func (db Database) AddLinkTLLanguage(ctx context.Context, data model.AddLinkTLLa
 		sql += " FROM data w JOIN " + model.DBLanguage + " l"
 		sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
 		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
-			return linkTLLanguageSetError(err)
+			if !upsert || !errors.As(err, &model.ErrNotFound) {
+				return linkTLLanguageSetError(err)
+			}
+			// The upsert changed nothing and returned no row, the row is
+			// read as it is.
+			result, err := db.GetLinkTLLanguage(ctx, map[string]any{
+				model.DBLinkGenericLinkID:         linkID,
+				model.DBLanguageGenericLanguageID: languageID,
+			})
+			if err != nil {
+				return err
+			}
+			*v = *result
 		}
 	} else {
 		if err := db.Exec(ctx, sql, args...); err != nil {
@@ -313,6 +424,7 @@ func (db Database) ListLinkTLLanguage(ctx context.Context, params model.ListPara
 	sql += " FROM " + model.DBLinkTLLanguage + " w JOIN " + model.DBLanguage + " l"
 	sql += " ON w." + model.DBLanguageGenericLanguageID + " = l." + model.DBGenericID
 	sql += ")"
+	SetPartition(&params)
 	if cond := SetWhere(params.Conditions, &args); cond != "" {
 		sql += " WHERE " + cond
 	}
@@ -326,6 +438,7 @@ func (db Database) ListLinkTLLanguage(ctx context.Context, params model.ListPara
 	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
 		sql += lmof
 	}
+	sql = SetPartitionSelect(params, sql, &args)
 	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
 		return nil, err
 	}
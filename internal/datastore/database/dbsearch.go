@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func (db Database) Search(ctx context.Context, query string, params model.ListParams) ([]*model.SearchResult, error) {
+	result := []*model.SearchResult{}
+	args := []any{}
+	sql := "SELECT * FROM (" + db.searchSelect(query, &args) + ")"
+	if cond := SetWhere(params.Conditions, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	sql += " ORDER BY " + model.DBSearchScore + " DESC, " + model.DBSearchKind + ", " + model.DBGenericID
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.SearchPaginationDef}
+	}
+	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+		sql += lmof
+	}
+	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (db Database) CountSearch(ctx context.Context, query string, conds any) (int, error) {
+	var dst int
+	args := []any{}
+	sql := "SELECT COUNT(*) FROM (" + db.searchSelect(query, &args) + ")"
+	if cond := SetWhere(conds, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+		return -1, err
+	}
+	return dst, nil
+}
+
+// searchSelect matches the query against every searchable column. PostgreSQL
+// ranks with tsvector and pg_trgm, CockroachDB falls back to trigram only.
+func (db Database) searchSelect(query string, args *[]any) (sql string) {
+	pattern := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(query)
+	*args = append(*args, query, "%"+pattern+"%")
+	q := "$" + strconv.Itoa(len(*args)-1)
+	p := "$" + strconv.Itoa(len(*args))
+
+	match := func(col string, tsv bool) (cond, score string) {
+		cond = col + " ILIKE " + p + " OR " + col + " % " + q
+		score = "similarity(" + col + ", " + q + ")::float8"
+		if tsv && !db.isCRDB() {
+			vector := "to_tsvector('simple', " + col + ")"
+			tsquery := "plainto_tsquery('simple', " + q + ")"
+			cond += " OR " + vector + " @@ " + tsquery
+			score = "GREATEST(" + score + ", ts_rank(" + vector + ", " + tsquery + ")::float8)"
+		}
+		return
+	}
+
+	// Comic
+	cond, score := match("a."+model.DBComicCode, false)
+	sql = "SELECT '" + model.SearchKindComic + "' AS " + model.DBSearchKind + ", a." + model.DBGenericID
+	sql += ", a." + model.DBComicCode + " AS comic_code, NULL::text AS website_domain, NULL::text AS relative_url"
+	sql += ", '" + model.SearchFieldCode + "' AS field, a." + model.DBComicCode + " AS text"
+	sql += ", " + score + " AS " + model.DBSearchScore
+	sql += " FROM " + model.DBComic + " a WHERE (" + cond + ") AND " + notDeletedSQL("a")
+	// Comic Title
+	cond, score = match("b."+model.DBComicTitleTitle, true)
+	sql += " UNION ALL SELECT '" + model.SearchKindComic + "', a." + model.DBGenericID
+	sql += ", a." + model.DBComicCode + ", NULL::text, NULL::text"
+	sql += ", CASE WHEN b." + model.DBComicTitleIsPrimary + " THEN '" + model.SearchFieldTitle + "'"
+	sql += " ELSE '" + model.SearchFieldAltTitle + "' END, b." + model.DBComicTitleTitle
+	sql += ", " + score
+	sql += " FROM " + model.DBComic + " a JOIN " + model.DBComicTitle + " b"
+	sql += " ON b." + model.DBComicGenericComicID + " = a." + model.DBGenericID
+	sql += " WHERE (" + cond + ") AND " + notDeletedSQL("a")
+	// Website
+	cond, score = match("a."+model.DBWebsiteName, true)
+	sql += " UNION ALL SELECT '" + model.SearchKindWebsite + "', a." + model.DBGenericID
+	sql += ", NULL::text, a." + model.DBWebsiteDomain + ", NULL::text"
+	sql += ", '" + model.SearchFieldName + "', a." + model.DBWebsiteName
+	sql += ", " + score
+	sql += " FROM " + model.DBWebsite + " a WHERE (" + cond + ") AND " + notDeletedSQL("a")
+	cond, score = match("a."+model.DBWebsiteDomain, false)
+	sql += " UNION ALL SELECT '" + model.SearchKindWebsite + "', a." + model.DBGenericID
+	sql += ", NULL::text, a." + model.DBWebsiteDomain + ", NULL::text"
+	sql += ", '" + model.SearchFieldDomain + "', a." + model.DBWebsiteDomain
+	sql += ", " + score
+	sql += " FROM " + model.DBWebsite + " a WHERE (" + cond + ") AND " + notDeletedSQL("a")
+	// Link
+	cond, score = match("a."+model.DBLinkRelativeURL, false)
+	sql += " UNION ALL SELECT '" + model.SearchKindLink + "', a." + model.DBGenericID
+	sql += ", NULL::text, b." + model.DBWebsiteDomain + ", a." + model.DBLinkRelativeURL
+	sql += ", '" + model.SearchFieldRelativeURL + "', a." + model.DBLinkRelativeURL
+	sql += ", " + score
+	sql += " FROM " + model.DBLink + " a JOIN " + model.DBWebsite + " b"
+	sql += " ON b." + model.DBGenericID + " = a." + model.DBWebsiteGenericWebsiteID
+	sql += " WHERE (" + cond + ") AND " + notDeletedSQL("a", "b")
+	return
+}
@@ -0,0 +1,137 @@
+package database
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+const (
+	NameErrTagKey = "tag_namespace_slug_key"
+)
+
+func (db Database) AddTag(ctx context.Context, data model.AddTag, v *model.Tag) error {
+	if err := db.GenericAdd(ctx, model.DBTag, map[string]any{
+		model.DBTagNamespace: data.Namespace,
+		model.DBTagSlug:      data.Slug,
+		model.DBTagNames:     data.Names,
+	}, v); err != nil {
+		return tagSetError(err)
+	}
+	return nil
+}
+
+func (db Database) GetTag(ctx context.Context, conds any) (*model.Tag, error) {
+	var result model.Tag
+	if err := db.GenericGet(ctx, model.DBTag, conds, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (db Database) UpdateTag(ctx context.Context, data model.SetTag, conds any, v *model.Tag) error {
+	data0 := map[string]any{}
+	if data.Namespace != nil {
+		data0[model.DBTagNamespace] = data.Namespace
+	}
+	if data.Slug != nil {
+		data0[model.DBTagSlug] = data.Slug
+	}
+	if data.Names != nil {
+		data0[model.DBTagNames] = data.Names
+	}
+	for _, null := range data.SetNull {
+		data0[null] = nil
+	}
+	if err := db.GenericUpdate(ctx, model.DBTag, data0, conds, v); err != nil {
+		return tagSetError(err)
+	}
+	return nil
+}
+
+func (db Database) DeleteTag(ctx context.Context, conds any, v *model.Tag) error {
+	return db.GenericDelete(ctx, model.DBTag, conds, v)
+}
+
+func (db Database) ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, error) {
+	result := []*model.Tag{}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBTagNamespace})
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBTagSlug})
+	}
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.TagPaginationDef}
+	}
+	if err := db.GenericList(ctx, model.DBTag, params, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (db Database) CountTag(ctx context.Context, conds any) (int, error) {
+	return db.GenericCount(ctx, model.DBTag, conds)
+}
+
+func (db Database) ExistsTag(ctx context.Context, conds any) (bool, error) {
+	return db.GenericExists(ctx, model.DBTag, conds)
+}
+
+func (db Database) ListTagFacet(ctx context.Context, comicConds any, params model.ListParams) ([]*model.TagFacet, error) {
+	result := []*model.TagFacet{}
+	args := []any{}
+	sql := "SELECT * FROM (" + tagFacetSelect(comicConds, &args) + ")"
+	if cond := SetWhere(params.Conditions, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if len(params.OrderBys) < 1 {
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBTagFacetComicCount, Sort: "desc"})
+		params.OrderBys = append(params.OrderBys, model.OrderBy{Field: model.DBTagSlug})
+	}
+	sql += " ORDER BY " + SetOrderBys(params.OrderBys, &args)
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.TagFacetPaginationDef}
+	}
+	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+		sql += lmof
+	}
+	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (db Database) CountTagFacet(ctx context.Context, comicConds any, conds any) (int, error) {
+	var dst int
+	args := []any{}
+	sql := "SELECT COUNT(*) FROM (" + tagFacetSelect(comicConds, &args) + ")"
+	if cond := SetWhere(conds, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+		return -1, err
+	}
+	return dst, nil
+}
+
+func tagFacetSelect(comicConds any, args *[]any) (sql string) {
+	sql = "SELECT a.*, COUNT(b." + model.DBComicGenericComicID + ") AS " + model.DBTagFacetComicCount
+	sql += " FROM " + model.DBTag + " a JOIN " + model.DBComicTag + " b"
+	sql += " ON b." + model.DBTagGenericTagID + " = a." + model.DBGenericID
+	sql += " WHERE b." + model.DBComicGenericComicID + " IN (SELECT " + model.DBGenericID + " FROM " + model.DBComic
+	if cond := SetWhere(notDeleted(model.DBComic, comicConds), args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	sql += ") GROUP BY a." + model.DBGenericID
+	return
+}
+
+func tagSetError(err error) error {
+	var errDatabase model.DatabaseError
+	if errors.As(err, &errDatabase) {
+		if errDatabase.Code == CodeErrExists && errDatabase.Name == NameErrTagKey {
+			return model.GenericError("same namespace + slug already exists")
+		}
+	}
+	return err
+}
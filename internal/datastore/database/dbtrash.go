@@ -0,0 +1,128 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func (db Database) ListTrash(ctx context.Context, params model.ListParams) ([]*model.TrashItem, error) {
+	result := []*model.TrashItem{}
+	args := []any{}
+	sql := "SELECT * FROM (" + trashSelect() + ")"
+	if cond := SetWhere(params.Conditions, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	sql += " ORDER BY " + model.DBGenericDeletedAt + " DESC, " + model.DBTrashKind + ", " + model.DBGenericID
+	if params.Pagination == nil {
+		params.Pagination = &model.Pagination{Page: 1, Limit: model.TrashPaginationDef}
+	}
+	if lmof := SetPagination(*params.Pagination, &args); lmof != "" {
+		sql += lmof
+	}
+	if err := db.QueryAll(ctx, &result, sql, args...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (db Database) CountTrash(ctx context.Context, conds any) (int, error) {
+	var dst int
+	args := []any{}
+	sql := "SELECT COUNT(*) FROM (" + trashSelect() + ")"
+	if cond := SetWhere(conds, &args); cond != "" {
+		sql += " WHERE " + cond
+	}
+	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+		return -1, err
+	}
+	return dst, nil
+}
+
+// PurgeTrash deletes the rows that have been in the trash for longer than the
+// retention, the join rows behind them go along by cascade. A row that still
+// has rows of the soft delete tables referencing it, out of the trash or in
+// it for less long, stays until they are gone so none is lost uncounted. A
+// retention not set would purge the whole trash, it is refused instead.
+func (db Database) PurgeTrash(ctx context.Context) (int, error) {
+	if db.trashRetention <= 0 {
+		return -1, errors.New("trash retention " + db.trashRetention.String() + " is not positive")
+	}
+
+	total := 0
+	args := []any{time.Now().UTC().Add(-db.trashRetention)}
+	if err := db.WithTx(ctx, func(ctx context.Context) error {
+		total = 0
+		// Children go first so their parents no longer hold on to them.
+		for i := len(softDeleteTables) - 1; i >= 0; i-- {
+			var dst int
+			sql := "WITH data AS (" + purgeSQL(softDeleteTables[i]) + " RETURNING 1)"
+			sql += " SELECT COUNT(*) FROM data"
+			if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+				return err
+			}
+			total += dst
+		}
+		return nil
+	}); err != nil {
+		return -1, err
+	}
+	return total, nil
+}
+
+// purgeSQL deletes the rows of the table t in the trash since before $1 that
+// no row of a soft delete table references.
+func purgeSQL(t string) (sql string) {
+	sql = "DELETE FROM " + t + " a WHERE a." + model.DBGenericDeletedAt + " < $1"
+	for _, key := range cascadeKeys {
+		if key.RefTable != t || !slices.Contains(softDeleteTables, key.Table) {
+			continue
+		}
+		sql += " AND NOT EXISTS (SELECT 1 FROM " + key.Table + " b"
+		sql += " WHERE b." + key.Column + " = a." + model.DBGenericID + ")"
+	}
+	return
+}
+
+// trashSelect lists the rows in the trash of every soft delete table, a link
+// or comic chapter comes with the key of its parent.
+func trashSelect() (sql string) {
+	deleted := " WHERE a." + model.DBGenericDeletedAt + " IS NOT NULL"
+	// Language
+	sql = "SELECT '" + model.TrashKindLanguage + "' AS " + model.DBTrashKind + ", a." + model.DBGenericID
+	sql += ", a." + model.DBLanguageIETF + " AS language_ietf"
+	sql += ", NULL::text AS website_domain, NULL::text AS relative_url"
+	sql += ", NULL::text AS comic_code, NULL::text AS chapter, NULL::text AS version"
+	sql += ", a." + model.DBGenericDeletedAt
+	sql += " FROM " + model.DBLanguage + " a" + deleted
+	// Website
+	sql += " UNION ALL SELECT '" + model.TrashKindWebsite + "', a." + model.DBGenericID
+	sql += ", NULL::text, a." + model.DBWebsiteDomain + ", NULL::text"
+	sql += ", NULL::text, NULL::text, NULL::text"
+	sql += ", a." + model.DBGenericDeletedAt
+	sql += " FROM " + model.DBWebsite + " a" + deleted
+	// Link
+	sql += " UNION ALL SELECT '" + model.TrashKindLink + "', a." + model.DBGenericID
+	sql += ", NULL::text, b." + model.DBWebsiteDomain + ", a." + model.DBLinkRelativeURL
+	sql += ", NULL::text, NULL::text, NULL::text"
+	sql += ", a." + model.DBGenericDeletedAt
+	sql += " FROM " + model.DBLink + " a JOIN " + model.DBWebsite + " b"
+	sql += " ON b." + model.DBGenericID + " = a." + model.DBWebsiteGenericWebsiteID + deleted
+	// Comic
+	sql += " UNION ALL SELECT '" + model.TrashKindComic + "', a." + model.DBGenericID
+	sql += ", NULL::text, NULL::text, NULL::text"
+	sql += ", a." + model.DBComicCode + ", NULL::text, NULL::text"
+	sql += ", a." + model.DBGenericDeletedAt
+	sql += " FROM " + model.DBComic + " a" + deleted
+	// Comic Chapter
+	sql += " UNION ALL SELECT '" + model.TrashKindComicChapter + "', a." + model.DBGenericID
+	sql += ", NULL::text, NULL::text, NULL::text"
+	sql += ", b." + model.DBComicCode + ", a." + model.DBComicChapterChapter + ", a." + model.DBComicChapterVersion
+	sql += ", a." + model.DBGenericDeletedAt
+	sql += " FROM " + model.DBComicChapter + " a JOIN " + model.DBComic + " b"
+	sql += " ON b." + model.DBGenericID + " = a." + model.DBComicGenericComicID + deleted
+	return
+}
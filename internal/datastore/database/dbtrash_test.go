@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+const (
+	testTrashDomain = "trash.example"
+	testTrashCode   = "tr000001"
+)
+
+// TestTrashReAdd adds a website and a comic again after deleting them, the one
+// in the trash cannot be restored over the new one until that is deleted too.
+func TestTrashReAdd(t *testing.T) {
+	db := testDatabase(t)
+	ctx := context.Background()
+
+	cleanup := func() {
+		_ = db.Exec(ctx, "DELETE FROM "+model.DBComic+" WHERE "+model.DBComicCode+" = $1", testTrashCode)
+		_ = db.Exec(ctx, "DELETE FROM "+model.DBWebsite+" WHERE "+model.DBWebsiteDomain+" = $1", testTrashDomain)
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	websiteConds := model.DBConditionalKV{Key: model.DBWebsiteDomain, Value: testTrashDomain}
+	comicConds := model.DBConditionalKV{Key: model.DBComicCode, Value: testTrashCode}
+	steps := []struct {
+		name string
+		fn   func() error
+	}{
+		{"add website", func() error {
+			return db.AddWebsite(ctx, model.AddWebsite{Domain: testTrashDomain, Name: "Trash"}, nil)
+		}},
+		{"delete website", func() error { return db.DeleteWebsite(ctx, websiteConds, nil) }},
+		{"add website again", func() error {
+			return db.AddWebsite(ctx, model.AddWebsite{Domain: testTrashDomain, Name: "Trash"}, nil)
+		}},
+		{"add comic", func() error { return db.AddComic(ctx, model.AddComic{Code: testTrashCode}, nil) }},
+		{"delete comic", func() error { return db.DeleteComic(ctx, comicConds, nil) }},
+		{"add comic again", func() error { return db.AddComic(ctx, model.AddComic{Code: testTrashCode}, nil) }},
+	}
+	for _, step := range steps {
+		if err := step.fn(); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+	}
+
+	if err := db.RestoreWebsite(ctx, websiteConds, nil); !errors.As(err, &model.ErrGeneric) {
+		t.Fatalf("restore website over the new one: got %v, want a generic error", err)
+	}
+	if err := db.RestoreComic(ctx, comicConds, nil); !errors.Is(err, model.ErrComicCodeExists) {
+		t.Fatalf("restore comic over the new one: got %v, want %v", err, model.ErrComicCodeExists)
+	}
+
+	// The trash holds two comics under the code now, restoring takes back the
+	// one deleted last.
+	latest := new(model.Comic)
+	if err := db.DeleteComic(ctx, comicConds, latest); err != nil {
+		t.Fatal(err)
+	}
+	restored := new(model.Comic)
+	if err := db.RestoreComic(ctx, comicConds, restored); err != nil {
+		t.Fatal(err)
+	}
+	if restored.ID != latest.ID {
+		t.Fatalf("restored comic %d, want the one deleted last %d", restored.ID, latest.ID)
+	}
+}
+
+// TestTrashCascade deletes a website with a link, the link goes to the trash
+// along with it and comes back when it is restored.
+func TestTrashCascade(t *testing.T) {
+	db := testDatabase(t)
+	ctx := context.Background()
+
+	cleanup := func() {
+		_ = db.Exec(ctx, "DELETE FROM "+model.DBWebsite+" WHERE "+model.DBWebsiteDomain+" = $1", testTrashDomain)
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	website := new(model.Website)
+	if err := db.AddWebsite(ctx, model.AddWebsite{Domain: testTrashDomain, Name: "Trash"}, website); err != nil {
+		t.Fatal(err)
+	}
+	link := new(model.Link)
+	if err := db.AddLink(ctx, model.AddLink{WebsiteID: &website.ID, RelativeURL: "/trash"}, link); err != nil {
+		t.Fatal(err)
+	}
+
+	websiteConds := model.DBConditionalKV{Key: model.DBWebsiteDomain, Value: testTrashDomain}
+	linkConds := model.DBConditionalKV{Key: model.DBGenericID, Value: link.ID}
+	if err := db.DeleteWebsite(ctx, websiteConds, nil); err != nil {
+		t.Fatal(err)
+	}
+	trashed, err := db.CountTrash(ctx, map[string]any{
+		model.DBTrashKind: model.TrashKindLink,
+		model.DBGenericID: link.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trashed != 1 {
+		t.Fatal("link is not in the trash along with its website")
+	}
+
+	if err := db.RestoreWebsite(ctx, websiteConds, nil); err != nil {
+		t.Fatal(err)
+	}
+	exists, err := db.ExistsLink(ctx, linkConds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Fatal("link is not restored along with its website")
+	}
+}
+
+// TestPurgeTrashRetention refuses to purge without a retention, before the
+// database is reached.
+func TestPurgeTrashRetention(t *testing.T) {
+	for _, retention := range []time.Duration{0, -time.Hour} {
+		db := Database{trashRetention: retention}
+		if _, err := db.PurgeTrash(context.Background()); err == nil {
+			t.Errorf("PurgeTrash with retention %v gave no error", retention)
+		}
+	}
+}
+
+func TestPurgeSQL(t *testing.T) {
+	want := "DELETE FROM " + model.DBWebsite + " a WHERE a.deleted_at < $1" +
+		" AND NOT EXISTS (SELECT 1 FROM " + model.DBLink + " b WHERE b.website_id = a.id)"
+	if sql := purgeSQL(model.DBWebsite); sql != want {
+		t.Errorf("sql = %q, want %q", sql, want)
+	}
+	want = "DELETE FROM " + model.DBLink + " a WHERE a.deleted_at < $1"
+	if sql := purgeSQL(model.DBLink); sql != want {
+		t.Errorf("sql = %q, want %q", sql, want)
+	}
+}
@@ -58,6 +58,51 @@ func (db Database) DeleteWebsite(ctx context.Context, conds any, v *model.Websit
 	return db.GenericDelete(ctx, model.DBWebsite, conds, v)
 }
 
+func (db Database) RestoreWebsite(ctx context.Context, conds any, v *model.Website) error {
+	if err := db.GenericRestore(ctx, model.DBWebsite, conds, v); err != nil {
+		return websiteSetError(err)
+	}
+	return nil
+}
+
+// AddWebsiteDomainAlias keeps the old domain of the website leading to it, an
+// alias taken by another website before moves over.
+func (db Database) AddWebsiteDomainAlias(ctx context.Context, domain string, websiteID uint) error {
+	cols, vals, args := SetInsert(map[string]any{
+		model.DBWebsiteDomain:           domain,
+		model.DBWebsiteGenericWebsiteID: websiteID,
+	})
+	sql := "INSERT INTO " + model.DBWebsiteDomainAlias + " (" + cols + ") VALUES (" + vals + ")"
+	sql += " ON CONFLICT (" + model.DBWebsiteDomain + ")"
+	sql += " DO UPDATE SET " + model.DBWebsiteGenericWebsiteID + " = EXCLUDED." + model.DBWebsiteGenericWebsiteID
+	sql += ", " + model.DBGenericCreatedAt + " = EXCLUDED." + model.DBGenericCreatedAt
+	return db.Exec(ctx, sql, args...)
+}
+
+func (db Database) DeleteWebsiteDomainAlias(ctx context.Context, domain string) error {
+	args := []any{}
+	cond := SetWhere(model.DBConditionalKV{Key: model.DBWebsiteDomain, Value: domain}, &args)
+	sql := "DELETE FROM " + model.DBWebsiteDomainAlias + " WHERE " + cond
+	return db.Exec(ctx, sql, args...)
+}
+
+// ResolveWebsiteDomainAlias gives the domain the website under the alias has now,
+// an alias shadowed by a website of its own, even one in the trash, resolves to
+// nothing.
+func (db Database) ResolveWebsiteDomainAlias(ctx context.Context, domain string) (string, error) {
+	var dst string
+	args := []any{domain}
+	sql := "SELECT b." + model.DBWebsiteDomain
+	sql += " FROM " + model.DBWebsiteDomainAlias + " a JOIN " + model.DBWebsite + " b"
+	sql += " ON b." + model.DBGenericID + " = a." + model.DBWebsiteGenericWebsiteID
+	sql += " WHERE a." + model.DBWebsiteDomain + " = $1 AND " + notDeletedSQL("b")
+	sql += " AND NOT EXISTS (SELECT 1 FROM " + model.DBWebsite + " WHERE " + model.DBWebsiteDomain + " = $1)"
+	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
+		return "", err
+	}
+	return dst, nil
+}
+
 func (db Database) ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, error) {
 	result := []*model.Website{}
 	if len(params.OrderBys) < 1 {
@@ -2,8 +2,13 @@ package database
 
 import (
 	"context"
+	"reflect"
+	"slices"
+	"strconv"
 	"time"
 
+	"github.com/georgysavva/scany/v2/dbscan"
+
 	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
 )
 
@@ -45,9 +50,42 @@ func (db Database) BatchAdd(ctx context.Context, t string, data []map[string]any
 	return nil
 }
 
+// softDeleteTables keep their deleted rows in the trash until purged, the
+// generic helpers leave the rows in the trash out.
+var softDeleteTables = []string{
+	model.DBLanguage,
+	model.DBWebsite,
+	model.DBLink,
+	model.DBComic,
+	model.DBComicChapter,
+}
+
+// notDeleted narrows the conditions to the rows of the table t that are not
+// in the trash.
+func notDeleted(t string, conds any) any {
+	if !slices.Contains(softDeleteTables, t) {
+		return conds
+	}
+	return []any{model.DBLogicalAND{}, conds, model.DBConditionalKV{
+		Key:   model.DBGenericDeletedAt,
+		Value: model.DBIsNull{},
+	}}
+}
+
+// notDeletedSQL filters out the rows in the trash of every table alias.
+func notDeletedSQL(aliases ...string) (sql string) {
+	for i, alias := range aliases {
+		if i > 0 {
+			sql += " AND "
+		}
+		sql += alias + "." + model.DBGenericDeletedAt + " IS NULL"
+	}
+	return
+}
+
 func (db Database) GenericGet(ctx context.Context, t string, conds any, v any) error {
 	args := []any{}
-	cond := SetWhere(conds, &args)
+	cond := SetWhere(notDeleted(t, conds), &args)
 	sql := "SELECT * FROM " + t + " WHERE " + cond
 	return db.QueryOne(ctx, v, sql, args...)
 }
@@ -55,7 +93,7 @@ func (db Database) GenericGet(ctx context.Context, t string, conds any, v any) e
 func (db Database) GenericUpdate(ctx context.Context, t string, data map[string]any, conds any, v any) error {
 	data[model.DBGenericUpdatedAt] = time.Now().UTC()
 	sets, args := SetUpdate(data)
-	cond := SetWhere([]any{conds, SetUpdateWhere(data)}, &args)
+	cond := SetWhere([]any{notDeleted(t, conds), SetUpdateWhere(data)}, &args)
 	sql := "UPDATE " + t + " SET " + sets + " WHERE " + cond
 	if v != nil {
 		sql += ` RETURNING *`
@@ -70,27 +108,131 @@ func (db Database) GenericUpdate(ctx context.Context, t string, data map[string]
 	return nil
 }
 
+// GenericDelete moves the rows of a soft delete table to the trash along with
+// the rows of the soft delete tables referencing them, rows of other tables
+// are deleted right away.
 func (db Database) GenericDelete(ctx context.Context, t string, conds any, v any) error {
-	args := []any{}
-	cond := SetWhere(conds, &args)
-	sql := "DELETE FROM " + t + " WHERE " + cond
-	if v != nil {
-		sql += ` RETURNING *`
-		if err := db.QueryOne(ctx, v, sql, args...); err != nil {
-			return err
+	if !slices.Contains(softDeleteTables, t) {
+		args := []any{}
+		sql := "DELETE FROM " + t + " WHERE " + SetWhere(conds, &args)
+		return db.genericReturning(ctx, sql, args, v)
+	}
+
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		deletedAt := time.Now().UTC()
+
+		args := []any{}
+		ids := "SELECT " + model.DBGenericID + " FROM " + t + " WHERE " + SetWhere(notDeleted(t, conds), &args)
+		for _, query := range cascadeDeleteSQL(t, ids, args, deletedAt) {
+			if err := db.Exec(ctx, query.SQL, query.Args...); err != nil {
+				return err
+			}
 		}
-	} else {
-		if err := db.Exec(ctx, sql, args...); err != nil {
-			return err
+
+		args = []any{}
+		sql := "UPDATE " + t + " SET " + model.DBGenericDeletedAt + " = " + SetValue(deletedAt, &args)
+		sql += " WHERE " + SetWhere(notDeleted(t, conds), &args)
+		return db.genericReturning(ctx, sql, args, v)
+	})
+}
+
+// cascadeDeleteSQL moves to the trash the rows of the soft delete tables that
+// reference the rows of the table t selected by ids, deepest first so each
+// still finds its parents out of the trash. They share the time of deletion
+// with the row they go along with, which is how a restore finds them.
+func cascadeDeleteSQL(t, ids string, args []any, deletedAt time.Time) (queries []BatchQuery) {
+	for _, key := range cascadeKeys {
+		if key.RefTable != t || !slices.Contains(softDeleteTables, key.Table) {
+			continue
 		}
+
+		where := key.Column + " IN (" + ids + ") AND " + model.DBGenericDeletedAt + " IS NULL"
+		childIDs := "SELECT " + model.DBGenericID + " FROM " + key.Table + " WHERE " + where
+		queries = append(queries, cascadeDeleteSQL(key.Table, childIDs, args, deletedAt)...)
+
+		args := slices.Clone(args)
+		sql := "UPDATE " + key.Table + " SET " + model.DBGenericDeletedAt + " = " + SetValue(deletedAt, &args)
+		sql += " WHERE " + where
+		queries = append(queries, BatchQuery{SQL: sql, Args: args})
 	}
-	return nil
+	return
+}
+
+// GenericRestore takes the rows of the table t back out of the trash, the
+// rows that went to the trash along with it come back too.
+func (db Database) GenericRestore(ctx context.Context, t string, conds any, v any) error {
+	return db.WithTx(ctx, func(ctx context.Context) error {
+		args := []any{}
+		rows := "SELECT " + model.DBGenericID + ", " + model.DBGenericDeletedAt + " FROM " + t
+		rows += " WHERE " + model.DBGenericID + " = (" + restorePickSQL(t, conds, &args) + ")"
+		for _, query := range cascadeRestoreSQL(t, rows, args, time.Now().UTC()) {
+			if err := db.Exec(ctx, query.SQL, query.Args...); err != nil {
+				return err
+			}
+		}
+
+		args = []any{}
+		return db.genericReturning(ctx, restoreSQL(t, conds, &args), args, v)
+	})
+}
+
+// cascadeRestoreSQL takes back out of the trash the rows of the soft delete
+// tables that went to the trash along with the rows of the table t selected
+// by rows, given as id and time of deletion.
+func cascadeRestoreSQL(t, rows string, args []any, updatedAt time.Time) (queries []BatchQuery) {
+	for _, key := range cascadeKeys {
+		if key.RefTable != t || !slices.Contains(softDeleteTables, key.Table) {
+			continue
+		}
+
+		where := "(" + key.Column + ", " + model.DBGenericDeletedAt + ") IN (" + rows + ")"
+		childRows := "SELECT " + model.DBGenericID + ", " + model.DBGenericDeletedAt + " FROM " + key.Table
+		childRows += " WHERE " + where
+		queries = append(queries, cascadeRestoreSQL(key.Table, childRows, args, updatedAt)...)
+
+		args := slices.Clone(args)
+		sql := "UPDATE " + key.Table + " SET " + model.DBGenericDeletedAt + " = NULL"
+		sql += ", " + model.DBGenericUpdatedAt + " = " + SetValue(updatedAt, &args)
+		sql += " WHERE " + where
+		queries = append(queries, BatchQuery{SQL: sql, Args: args})
+	}
+	return
+}
+
+func (db Database) genericReturning(ctx context.Context, sql string, args []any, v any) error {
+	if v != nil {
+		sql += ` RETURNING *`
+		return db.QueryOne(ctx, v, sql, args...)
+	}
+	return db.Exec(ctx, sql, args...)
+}
+
+// restoreSQL takes the row last moved to the trash out of those matching
+// conds, the unique keys leave the trash out so it may hold several.
+func restoreSQL(t string, conds any, args *[]any) (sql string) {
+	sql = "UPDATE " + t + " SET " + model.DBGenericDeletedAt + " = NULL"
+	sql += ", " + model.DBGenericUpdatedAt + " = " + SetValue(time.Now().UTC(), args)
+	sql += " WHERE " + model.DBGenericID + " = (" + restorePickSQL(t, conds, args) + ")"
+	return
+}
+
+func restorePickSQL(t string, conds any, args *[]any) (sql string) {
+	sql = "SELECT " + model.DBGenericID + " FROM " + t
+	sql += " WHERE " + SetWhere([]any{model.DBLogicalAND{}, conds, model.DBConditionalKV{
+		Key:   model.DBGenericDeletedAt,
+		Value: model.DBIsNotNull{},
+	}}, args)
+	sql += " ORDER BY " + model.DBGenericDeletedAt + " DESC, " + model.DBGenericID + " DESC LIMIT 1"
+	return
 }
 
 func (db Database) GenericList(ctx context.Context, t string, params model.ListParams, v any) error {
+	if err := SetKeyset(&params); err != nil {
+		return err
+	}
 	args := []any{}
 	sql := "SELECT * FROM " + t
-	if cond := SetWhere(params.Conditions, &args); cond != "" {
+	if cond := SetWhere(notDeleted(t, params.Conditions), &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	if odbs := SetOrderBys(params.OrderBys, &args); odbs != "" {
@@ -99,14 +241,18 @@ func (db Database) GenericList(ctx context.Context, t string, params model.ListP
 	if params.Pagination != nil {
 		sql += SetPagination(*params.Pagination, &args)
 	}
-	return db.QueryAll(ctx, v, sql, args...)
+	if err := db.QueryAll(ctx, v, sql, args...); err != nil {
+		return err
+	}
+	SetKeysetNext(params, v)
+	return nil
 }
 
 func (db Database) GenericCount(ctx context.Context, t string, conds any) (int, error) {
 	var dst int
 	args := []any{}
 	sql := "SELECT COUNT(*) FROM " + t
-	if cond := SetWhere(conds, &args); cond != "" {
+	if cond := SetWhere(notDeleted(t, conds), &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	if err := db.QueryOne(ctx, &dst, sql, args...); err != nil {
@@ -119,7 +265,7 @@ func (db Database) GenericExists(ctx context.Context, t string, conds any) (bool
 	var dst bool
 	args := []any{}
 	sql := "SELECT EXISTS(SELECT 1 FROM " + t
-	if cond := SetWhere(conds, &args); cond != "" {
+	if cond := SetWhere(notDeleted(t, conds), &args); cond != "" {
 		sql += " WHERE " + cond
 	}
 	sql += ")"
@@ -128,3 +274,112 @@ func (db Database) GenericExists(ctx context.Context, t string, conds any) (bool
 	}
 	return dst, nil
 }
+
+// SetKeyset prepares the list params for keyset pagination if requested, the
+// order by is made total and the rows up to the cursor are filtered out.
+func SetKeyset(params *model.ListParams) error {
+	if params.Pagination == nil || params.Pagination.Keyset == nil {
+		return nil
+	}
+
+	params.OrderBys = SetKeysetOrderBys(params.OrderBys)
+	if after := params.Pagination.Keyset.After; len(after) > 0 {
+		if len(after) != len(params.OrderBys) {
+			return model.GenericError("cursor does not match the order by")
+		}
+		params.Conditions = []any{model.DBLogicalAND{}, params.Conditions, model.DBKeysetAfter{
+			OrderBys: params.OrderBys,
+			Values:   after,
+		}}
+	}
+	return nil
+}
+
+// SetKeysetNext fills the next keyset from the last row of a full page.
+func SetKeysetNext(params model.ListParams, v any) {
+	pagination := params.Pagination
+	if pagination == nil || pagination.Keyset == nil {
+		return
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Slice || rv.Len() < 1 || rv.Len() < pagination.Limit {
+		return
+	}
+
+	cols := map[string]any{}
+	setKeysetColumns(reflect.Indirect(rv.Index(rv.Len()-1)), cols)
+	next := make([]any, 0, len(params.OrderBys))
+	for _, ob := range params.OrderBys {
+		field, _ := ob.Field.(string)
+		next = append(next, cols[field])
+	}
+	pagination.Keyset.Next = next
+}
+
+func setKeysetColumns(rv reflect.Value, cols map[string]any) {
+	if rv.Kind() != reflect.Struct {
+		return
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			setKeysetColumns(rv.Field(i), cols)
+			continue
+		}
+		col := dbscan.SnakeCaseMapper(field.Name)
+		val := rv.Field(i)
+		if val.Kind() == reflect.Pointer {
+			if val.IsNil() {
+				cols[col] = nil
+				continue
+			}
+			val = val.Elem()
+		}
+		cols[col] = val.Interface()
+	}
+}
+
+// SetPartition narrows the conditions to the partition key of the outer row,
+// the select is then wrapped by SetPartitionSelect.
+func SetPartition(params *model.ListParams) {
+	if params.Partition == nil {
+		return
+	}
+
+	key := params.Partition.Key
+	params.Conditions = []any{model.DBLogicalAND{}, params.Conditions, model.DBConditionalKV{
+		Key:   key,
+		Value: model.DBColumnValue("p." + key),
+	}}
+}
+
+func SetPartitionSelect(params model.ListParams, sql string, args *[]any) string {
+	if params.Partition == nil {
+		return sql
+	}
+
+	*args = append(*args, params.Partition.Values)
+	ps := "SELECT b.* FROM unnest($" + strconv.Itoa(len(*args)) + "::bigint[]) AS p(" + params.Partition.Key + ")"
+	return ps + " CROSS JOIN LATERAL (" + sql + ") b"
+}
+
+// embedListParams lists the rows embedded in the parents of ids, a positive
+// limit caps the rows of every parent instead of all of them.
+func embedListParams(key string, ids []uint, limit int) model.ListParams {
+	if limit > 0 {
+		return model.ListParams{
+			Pagination: &model.Pagination{Page: 1, Limit: limit},
+			Partition:  &model.Partition{Key: key, Values: ids},
+		}
+	}
+	return model.ListParams{
+		Conditions: model.DBConditionalKV{Key: key, Value: model.DBIn{Values: ids}},
+		Pagination: &model.Pagination{},
+	}
+}
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+// The Go migrations below are shared by every provider, their versions go in
+// between those of the SQL migrations.
+func init() {
+	goose.AddNamedMigrationContext("00012_chapter-number-backfill.go", upChapterNumberBackfill, downNothing)
+}
+
+// upChapterNumberBackfill sets the number of every comic chapter through
+// model.ComicChapterNumber, the column added by 00010 starts out empty.
+func upChapterNumberBackfill(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, "SELECT "+model.DBGenericID+", "+model.DBComicChapterChapter+" FROM "+model.DBComicChapter)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var (
+		ids     []int64
+		numbers []*float64
+	)
+	for rows.Next() {
+		var (
+			id      int64
+			chapter string
+		)
+		if err := rows.Scan(&id, &chapter); err != nil {
+			return err
+		}
+		ids = append(ids, id)
+		numbers = append(numbers, model.ComicChapterNumber(chapter))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(ids) < 1 {
+		return nil
+	}
+
+	query := "UPDATE " + model.DBComicChapter + " w SET " + model.DBComicChapterNumber + " = d.number"
+	query += " FROM unnest($1::bigint[], $2::double precision[]) AS d(id, number)"
+	query += " WHERE w." + model.DBGenericID + " = d.id"
+	_, err = tx.ExecContext(ctx, query, ids, numbers)
+	return err
+}
+
+func downNothing(ctx context.Context, tx *sql.Tx) error {
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
 	"github.com/mahmudindes/orenocomic-bagicore/internal/utila"
@@ -16,6 +17,8 @@ func SetValue(val any, args *[]any) string {
 		*args = append(*args, v.ZeroValue)
 		subs := "SELECT " + v.Expression + " FROM " + v.Table + " WHERE " + cond
 		return "(SELECT COALESCE((" + subs + "), $" + strconv.Itoa(len(*args)) + "))"
+	case model.DBColumnValue:
+		return string(v)
 	default:
 		*args = append(*args, v)
 		return "$" + strconv.Itoa(len(*args))
@@ -44,16 +47,13 @@ func SetBulkInsert(data []map[string]any) (cols string, valx []string, args []an
 		keys := []string{}
 		for key, val := range data {
 			keys = append(keys, key)
+			for n := len(colvals[key]); n < i; n++ {
+				colvals[key] = append(colvals[key], nil)
+			}
 			if utila.NilData(val) {
 				colvals[key] = append(colvals[key], nil)
 				continue
 			}
-			if n := len(colvals[key]); n != i {
-				for n < i {
-					colvals[key] = append(colvals[key], nil)
-					n++
-				}
-			}
 			colvals[key] = append(colvals[key], val)
 		}
 		for key := range colvals {
@@ -97,6 +97,29 @@ func SetUpdate(data map[string]any) (sets string, args []any) {
 	return
 }
 
+// SetUpsert turns an insert into an upsert on the conflict target, the
+// conflicting row takes cols from the insert and gets a new updated at only
+// when one of them changes. A conflict that changes nothing returns no row, so
+// an upserted row without an updated at is known to be created.
+func SetUpsert(target string, cols []string, args *[]any) string {
+	if len(cols) < 1 {
+		return " ON CONFLICT " + target + " DO NOTHING"
+	}
+	sets, olds, news := "", "", ""
+	for i, col := range cols {
+		if i > 0 {
+			olds += ", "
+			news += ", "
+		}
+		sets += col + " = EXCLUDED." + col + ", "
+		olds += col
+		news += "EXCLUDED." + col
+	}
+	sets += model.DBGenericUpdatedAt + " = " + SetValue(time.Now().UTC(), args)
+	return " ON CONFLICT " + target + " DO UPDATE SET " + sets +
+		" WHERE (" + olds + ") IS DISTINCT FROM (" + news + ")"
+}
+
 func SetUpdateWhere(data map[string]any) (cond map[string]any) {
 	cond = make(map[string]any)
 	for key, val := range data {
@@ -124,6 +147,10 @@ func SetWhere(conds any, args *[]any) (cond string) {
 				if conx == "" {
 					continue
 				}
+				switch conds.(type) {
+				case []any, map[string]any:
+					conx = "(" + conx + ")"
+				}
 				if cond != "" {
 					cond += " " + lop + " "
 				}
@@ -144,6 +171,18 @@ func SetWhere(conds any, args *[]any) (cond string) {
 			}
 			cond += conx
 		}
+	case model.DBLogicalNOT:
+		if conx := SetWhere(conds.Conditions, args); conx != "" {
+			cond += "NOT (" + conx + ")"
+		}
+	case model.DBCrossConditional:
+		cond += "EXISTS (" + setCrossSelect(conds.Table, conds.Conditions, args) + ")"
+	case model.DBNotCrossConditional:
+		cond += "NOT EXISTS (" + setCrossSelect(conds.Table, conds.Conditions, args) + ")"
+	case model.DBKeysetAfter:
+		if conx := SetWhere(setKeysetAfter(conds), args); conx != "" {
+			cond += "(" + conx + ")"
+		}
 	case model.DBConditionalKV:
 		switch val := conds.Value.(type) {
 		case model.DBIsDistinctFrom:
@@ -152,6 +191,22 @@ func SetWhere(conds any, args *[]any) (cond string) {
 		case model.DBIsNotDistinctFrom:
 			*args = append(*args, val.Value)
 			cond += conds.Key + " IS NOT DISTINCT FROM $" + strconv.Itoa(len(*args))
+		case model.DBLessThan:
+			cond += conds.Key + " < " + SetValue(val.Value, args)
+		case model.DBLessThanOrEqual:
+			cond += conds.Key + " <= " + SetValue(val.Value, args)
+		case model.DBGreaterThan:
+			cond += conds.Key + " > " + SetValue(val.Value, args)
+		case model.DBGreaterThanOrEqual:
+			cond += conds.Key + " >= " + SetValue(val.Value, args)
+		case model.DBBetween:
+			cond += conds.Key + " BETWEEN " + SetValue(val.Min, args) + " AND " + SetValue(val.Max, args)
+		case model.DBIn:
+			*args = append(*args, val.Values)
+			cond += conds.Key + " = ANY($" + strconv.Itoa(len(*args)) + ")"
+		case model.DBNotIn:
+			*args = append(*args, val.Values)
+			cond += "NOT (" + conds.Key + " = ANY($" + strconv.Itoa(len(*args)) + "))"
 		case model.DBIsNull:
 			cond += conds.Key + " IS NULL"
 		case model.DBIsNotNull:
@@ -163,6 +218,9 @@ func SetWhere(conds any, args *[]any) (cond string) {
 		case model.DBInsensitiveLike:
 			*args = append(*args, string(val))
 			cond += conds.Key + " ILIKE $" + strconv.Itoa(len(*args))
+		case model.DBTrigramSimilar:
+			*args = append(*args, string(val))
+			cond += conds.Key + " % $" + strconv.Itoa(len(*args))
 		default:
 			cond += conds.Key + " = " + SetValue(val, args)
 		}
@@ -170,6 +228,89 @@ func SetWhere(conds any, args *[]any) (cond string) {
 	return
 }
 
+func setCrossSelect(table string, conds any, args *[]any) (subs string) {
+	subs = "SELECT 1 FROM " + table
+	if cond := SetWhere(conds, args); cond != "" {
+		subs += " WHERE " + cond
+	}
+	return
+}
+
+// setKeysetAfter expands the row comparison so that mixed sort directions and
+// null ordering are honored, (a > x) OR (a = x AND b > y) and so on.
+func setKeysetAfter(m model.DBKeysetAfter) []any {
+	conds := []any{model.DBLogicalOR{}}
+	for i, ob := range m.OrderBys {
+		if i >= len(m.Values) {
+			break
+		}
+		field, ok := ob.Field.(string)
+		if !ok {
+			break
+		}
+
+		group := []any{model.DBLogicalAND{}}
+		for j, ob := range m.OrderBys[:i] {
+			field := ob.Field.(string)
+			if m.Values[j] == nil {
+				group = append(group, model.DBConditionalKV{Key: field, Value: model.DBIsNull{}})
+			} else {
+				group = append(group, model.DBConditionalKV{Key: field, Value: m.Values[j]})
+			}
+		}
+
+		value := m.Values[i]
+		switch {
+		case value == nil && ob.NullsFirst():
+			group = append(group, model.DBConditionalKV{Key: field, Value: model.DBIsNotNull{}})
+		case value == nil:
+			// Nothing sorts after null when nulls are last
+			continue
+		default:
+			var after any = model.DBGreaterThan{Value: value}
+			if ob.Descending() {
+				after = model.DBLessThan{Value: value}
+			}
+			if ob.NullsFirst() {
+				group = append(group, model.DBConditionalKV{Key: field, Value: after})
+			} else {
+				group = append(group, []any{
+					model.DBConditionalKV{Key: field, Value: after},
+					model.DBConditionalKV{Key: field, Value: model.DBIsNull{}},
+				})
+			}
+		}
+		if len(group) == 2 {
+			conds = append(conds, group[1])
+		} else {
+			conds = append(conds, group)
+		}
+	}
+	if len(conds) < 2 {
+		// Cursor is past the last row
+		return []any{model.DBConditionalKV{Key: "false", Value: model.DBBooleanIs(true)}}
+	}
+	return conds
+}
+
+// SetKeysetOrderBys makes the order total by appending the primary key and
+// pins the null ordering so it is the same across database providers.
+func SetKeysetOrderBys(m model.OrderBys) model.OrderBys {
+	obs := make(model.OrderBys, 0, len(m)+1)
+	for _, ob := range m {
+		if ob.NullsFirst() {
+			ob.Null = "first"
+		} else {
+			ob.Null = "last"
+		}
+		obs = append(obs, ob)
+		if ob.Field == model.DBGenericID {
+			return obs
+		}
+	}
+	return append(obs, model.OrderBy{Field: model.DBGenericID, Null: "last"})
+}
+
 func SetOrderBy(m model.OrderBy, args *[]any) (ob string) {
 	if m.Field == "" {
 		return
@@ -222,6 +363,10 @@ func SetPagination(m model.Pagination, args *[]any) (lo string) {
 	*args = append(*args, m.Limit)
 	lo += " LIMIT $" + strconv.Itoa(len(*args))
 
+	if m.Keyset != nil {
+		return
+	}
+
 	offset := m.Limit * (m.Page - 1)
 	if offset > 0 {
 		*args = append(*args, offset)
@@ -0,0 +1,290 @@
+package database
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func TestSetWhere(t *testing.T) {
+	tests := []struct {
+		name  string
+		conds any
+		sql   string
+		args  []any
+	}{
+		{
+			name:  "equal",
+			conds: model.DBConditionalKV{Key: "code", Value: "abc"},
+			sql:   "code = $1",
+			args:  []any{"abc"},
+		},
+		{
+			name:  "less than",
+			conds: model.DBConditionalKV{Key: "id", Value: model.DBLessThan{Value: 10}},
+			sql:   "id < $1",
+			args:  []any{10},
+		},
+		{
+			name:  "less than or equal",
+			conds: model.DBConditionalKV{Key: "id", Value: model.DBLessThanOrEqual{Value: 10}},
+			sql:   "id <= $1",
+			args:  []any{10},
+		},
+		{
+			name:  "greater than",
+			conds: model.DBConditionalKV{Key: "id", Value: model.DBGreaterThan{Value: 10}},
+			sql:   "id > $1",
+			args:  []any{10},
+		},
+		{
+			name:  "greater than or equal",
+			conds: model.DBConditionalKV{Key: "id", Value: model.DBGreaterThanOrEqual{Value: 10}},
+			sql:   "id >= $1",
+			args:  []any{10},
+		},
+		{
+			name:  "between",
+			conds: model.DBConditionalKV{Key: "start_year", Value: model.DBBetween{Min: 2000, Max: 2010}},
+			sql:   "start_year BETWEEN $1 AND $2",
+			args:  []any{2000, 2010},
+		},
+		{
+			name:  "in",
+			conds: model.DBConditionalKV{Key: "id", Value: model.DBIn{Values: []uint{1, 2, 3}}},
+			sql:   "id = ANY($1)",
+			args:  []any{[]uint{1, 2, 3}},
+		},
+		{
+			name:  "not in",
+			conds: model.DBConditionalKV{Key: "id", Value: model.DBNotIn{Values: []uint{1, 2}}},
+			sql:   "NOT (id = ANY($1))",
+			args:  []any{[]uint{1, 2}},
+		},
+		{
+			name:  "trigram similar",
+			conds: model.DBConditionalKV{Key: "title", Value: model.DBTrigramSimilar("abc")},
+			sql:   "title % $1",
+			args:  []any{"abc"},
+		},
+		{
+			name: "not",
+			conds: model.DBLogicalNOT{Conditions: []any{
+				model.DBConditionalKV{Key: "a", Value: 1},
+				model.DBConditionalKV{Key: "b", Value: 2},
+			}},
+			sql:  "NOT (a = $1 OR b = $2)",
+			args: []any{1, 2},
+		},
+		{
+			name: "not empty",
+			conds: []any{
+				model.DBConditionalKV{Key: "a", Value: 1},
+				model.DBLogicalNOT{Conditions: []any{}},
+			},
+			sql:  "a = $1",
+			args: []any{1},
+		},
+		{
+			name: "logical chain",
+			conds: []any{
+				model.DBConditionalKV{Key: "a", Value: 1},
+				model.DBLogicalAND{},
+				model.DBConditionalKV{Key: "b", Value: model.DBIsNull{}},
+				model.DBConditionalKV{Key: "c", Value: model.DBBooleanIs(true)},
+			},
+			sql:  "a = $1 AND b IS NULL AND c IS true",
+			args: []any{1},
+		},
+		{
+			name: "nested group",
+			conds: []any{
+				model.DBLogicalAND{},
+				model.DBConditionalKV{Key: "a", Value: 1},
+				[]any{
+					model.DBConditionalKV{Key: "b", Value: model.DBGreaterThan{Value: 2}},
+					model.DBConditionalKV{Key: "c", Value: model.DBLessThan{Value: 3}},
+				},
+			},
+			sql:  "a = $1 AND (b > $2 OR c < $3)",
+			args: []any{1, 2, 3},
+		},
+		{
+			name: "deep nested group",
+			conds: []any{
+				[]any{
+					model.DBLogicalAND{},
+					model.DBConditionalKV{Key: "a", Value: 1},
+					[]any{
+						model.DBConditionalKV{Key: "b", Value: 2},
+						model.DBConditionalKV{Key: "c", Value: 3},
+					},
+				},
+				model.DBConditionalKV{Key: "d", Value: model.DBIn{Values: []string{"x"}}},
+			},
+			sql:  "(a = $1 AND (b = $2 OR c = $3)) OR d = ANY($4)",
+			args: []any{1, 2, 3, []string{"x"}},
+		},
+		{
+			name: "map group",
+			conds: []any{
+				model.DBConditionalKV{Key: "a", Value: 1},
+				map[string]any{"b": model.DBBetween{Min: 2, Max: 3}},
+			},
+			sql:  "a = $1 OR (b BETWEEN $2 AND $3)",
+			args: []any{1, 2, 3},
+		},
+		{
+			name: "empty group",
+			conds: []any{
+				model.DBConditionalKV{Key: "a", Value: 1},
+				[]any{model.DBLogicalAND{}},
+			},
+			sql:  "a = $1",
+			args: []any{1},
+		},
+		{
+			name: "query value",
+			conds: model.DBConditionalKV{Key: "comic_id", Value: model.DBQueryValue{
+				Table:      "comic",
+				Expression: "id",
+				ZeroValue:  0,
+				Conditions: model.DBConditionalKV{Key: "code", Value: "abc"},
+			}},
+			sql:  "comic_id = (SELECT COALESCE((SELECT id FROM comic WHERE code = $1), $2))",
+			args: []any{"abc", 0},
+		},
+		{
+			name: "cross conditional",
+			conds: []any{
+				model.DBLogicalAND{},
+				model.DBCrossConditional{Table: "comic_tag", Conditions: model.DBConditionalKV{
+					Key:   "comic_id",
+					Value: model.DBColumnValue("comic.id"),
+				}},
+				model.DBNotCrossConditional{Table: "comic_tag", Conditions: model.DBConditionalKV{
+					Key:   "tag_id",
+					Value: model.DBIn{Values: []uint{7}},
+				}},
+			},
+			sql:  "EXISTS (SELECT 1 FROM comic_tag WHERE comic_id = comic.id) AND NOT EXISTS (SELECT 1 FROM comic_tag WHERE tag_id = ANY($1))",
+			args: []any{[]uint{7}},
+		},
+		{
+			name: "keyset after",
+			conds: model.DBKeysetAfter{
+				OrderBys: model.OrderBys{
+					{Field: "released_at", Sort: "desc", Null: "last"},
+					{Field: "id", Null: "last"},
+				},
+				Values: []any{"2024-01-01T00:00:00Z", 5},
+			},
+			sql:  "((released_at < $1 OR released_at IS NULL) OR (released_at = $2 AND (id > $3 OR id IS NULL)))",
+			args: []any{"2024-01-01T00:00:00Z", "2024-01-01T00:00:00Z", 5},
+		},
+		{
+			name: "keyset after null",
+			conds: model.DBKeysetAfter{
+				OrderBys: model.OrderBys{
+					{Field: "released_at", Null: "last"},
+					{Field: "id", Null: "first"},
+				},
+				Values: []any{nil, 5},
+			},
+			sql:  "((released_at IS NULL AND id > $1))",
+			args: []any{5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := []any{}
+			sql := SetWhere(tt.conds, &args)
+			if sql != tt.sql {
+				t.Errorf("SetWhere() sql = %q, want %q", sql, tt.sql)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("SetWhere() args = %#v, want %#v", args, tt.args)
+			}
+		})
+	}
+}
+
+func TestSetWhereArgsOffset(t *testing.T) {
+	args := []any{"existing"}
+	sql := SetWhere(model.DBConditionalKV{Key: "id", Value: model.DBBetween{Min: 1, Max: 2}}, &args)
+	if want := "id BETWEEN $2 AND $3"; sql != want {
+		t.Errorf("SetWhere() sql = %q, want %q", sql, want)
+	}
+	if want := []any{"existing", 1, 2}; !reflect.DeepEqual(args, want) {
+		t.Errorf("SetWhere() args = %#v, want %#v", args, want)
+	}
+}
+
+func TestSetKeysetOrderBys(t *testing.T) {
+	obs := SetKeysetOrderBys(model.OrderBys{{Field: "code"}, {Field: "start_year", Sort: "desc"}})
+	want := model.OrderBys{
+		{Field: "code", Null: "last"},
+		{Field: "start_year", Sort: "desc", Null: "first"},
+		{Field: "id", Null: "last"},
+	}
+	if !reflect.DeepEqual(obs, want) {
+		t.Errorf("SetKeysetOrderBys() = %#v, want %#v", obs, want)
+	}
+}
+
+func TestSetKeysetNext(t *testing.T) {
+	type row struct {
+		ID        uint
+		Code      string
+		StartYear *int
+	}
+	keyset := &model.Keyset{}
+	params := model.ListParams{
+		OrderBys:   model.OrderBys{{Field: "start_year"}, {Field: "code"}, {Field: "id"}},
+		Pagination: &model.Pagination{Limit: 2, Keyset: keyset},
+	}
+	SetKeysetNext(params, &[]*row{{ID: 1, Code: "a"}, {ID: 2, Code: "b"}})
+	if want := []any{nil, "b", uint(2)}; !reflect.DeepEqual(keyset.Next, want) {
+		t.Errorf("SetKeysetNext() = %#v, want %#v", keyset.Next, want)
+	}
+
+	keyset.Next = nil
+	SetKeysetNext(params, &[]*row{{ID: 1, Code: "a"}})
+	if keyset.Next != nil {
+		t.Errorf("SetKeysetNext() = %#v, want nil on last page", keyset.Next)
+	}
+}
+
+func TestSetUpsert(t *testing.T) {
+	tests := []struct {
+		cols []string
+		want string
+		args int
+	}{
+		{
+			[]string{"machine_tl"},
+			" ON CONFLICT (website_id, relative_url) DO UPDATE SET machine_tl = EXCLUDED.machine_tl, updated_at = $2" +
+				" WHERE (machine_tl) IS DISTINCT FROM (EXCLUDED.machine_tl)",
+			2,
+		},
+		{
+			[]string{"machine_tl", "relative_url"},
+			" ON CONFLICT (website_id, relative_url) DO UPDATE SET machine_tl = EXCLUDED.machine_tl" +
+				", relative_url = EXCLUDED.relative_url, updated_at = $2" +
+				" WHERE (machine_tl, relative_url) IS DISTINCT FROM (EXCLUDED.machine_tl, EXCLUDED.relative_url)",
+			2,
+		},
+		{nil, " ON CONFLICT (website_id, relative_url) DO NOTHING", 1},
+	}
+	for _, tt := range tests {
+		args := []any{"existing"}
+		sql := SetUpsert("(website_id, relative_url)", tt.cols, &args)
+		if sql != tt.want {
+			t.Errorf("SetUpsert(%v) sql = %q, want %q", tt.cols, sql, tt.want)
+		}
+		if len(args) != tt.args {
+			t.Errorf("SetUpsert(%v) args = %#v, want %d", tt.cols, args, tt.args)
+		}
+	}
+}
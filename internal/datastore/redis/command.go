@@ -15,6 +15,10 @@ func (rdb Redis) Set(ctx context.Context, key string, val any, exp time.Duration
 	return rdb.client.Set(ctx, key, val, exp).Err()
 }
 
+func (rdb Redis) SetNX(ctx context.Context, key string, val any, exp time.Duration) (bool, error) {
+	return rdb.client.SetNX(ctx, key, val, exp).Result()
+}
+
 func (rdb Redis) get(ctx context.Context, key string) (*redis.StringCmd, error) {
 	cmd := rdb.client.Get(ctx, key)
 	if cmd.Err() != nil {
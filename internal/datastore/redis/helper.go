@@ -12,7 +12,15 @@ func (rdb Redis) GobSet(ctx context.Context, key string, v any, exp time.Duratio
 	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
 		return err
 	}
-	return rdb.Set(ctx, key, buf, exp)
+	return rdb.Set(ctx, key, buf.Bytes(), exp)
+}
+
+func (rdb Redis) GobSetNX(ctx context.Context, key string, v any, exp time.Duration) (bool, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return false, err
+	}
+	return rdb.SetNX(ctx, key, buf.Bytes(), exp)
 }
 
 func (rdb Redis) GobGet(ctx context.Context, key string, v any) error {
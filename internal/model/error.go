@@ -1,10 +1,11 @@
 package model
 
 var (
-	ErrGeneric  GenericError
-	ErrNotFound notFoundError
-	ErrDatabase DatabaseError
-	ErrCache    cacheError
+	ErrGeneric      GenericError
+	ErrNotFound     notFoundError
+	ErrPrecondition preconditionError
+	ErrDatabase     DatabaseError
+	ErrCache        cacheError
 )
 
 type GenericError string
@@ -34,6 +35,16 @@ func NotFoundError(err error) error {
 	return notFoundError{err}
 }
 
+type preconditionError struct {
+	msg string
+}
+
+func (e preconditionError) Error() string { return "precondition failed: " + e.msg }
+
+func PreconditionError(msg string) error {
+	return preconditionError{msg}
+}
+
 type DatabaseError struct {
 	Name string
 	Code string
@@ -0,0 +1,205 @@
+package model
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	bagicore "github.com/mahmudindes/orenocomic-bagicore"
+)
+
+const (
+	AuditPaginationDef           = 10
+	AuditPaginationMax           = 50
+	AuditOperationAdd            = "add"
+	AuditOperationUpdate         = "update"
+	AuditOperationDelete         = "delete"
+	AuditOperationRestore        = "restore"
+	AuditEntityLanguage          = "language"
+	AuditEntityWebsite           = "website"
+	AuditEntityWebsiteTLLanguage = "websiteTLLanguage"
+	AuditEntityLink              = "link"
+	AuditEntityLinkTLLanguage    = "linkTLLanguage"
+	AuditEntityCreator           = "creator"
+	AuditEntityCreatorLink       = "creatorLink"
+	AuditEntityTag               = "tag"
+	AuditEntityComic             = "comic"
+	AuditEntityComicTitle        = "comicTitle"
+	AuditEntityComicLink         = "comicLink"
+	AuditEntityComicCreator      = "comicCreator"
+	AuditEntityComicTag          = "comicTag"
+	AuditEntityComicChapter      = "comicChapter"
+	AuditEntityComicChapterLink  = "comicChapterLink"
+	DBAuditEvent                 = bagicore.ID + "." + "audit_event"
+	DBAuditEventActor            = "actor"
+	DBAuditEventOperation        = "operation"
+	DBAuditEventEntity           = "entity"
+	DBAuditEventEntitySID        = "entity_sid"
+	DBAuditEventBeforeData       = "before_data"
+	DBAuditEventAfterData        = "after_data"
+)
+
+var (
+	AuditOperationAllow = []string{
+		AuditOperationAdd,
+		AuditOperationUpdate,
+		AuditOperationDelete,
+		AuditOperationRestore,
+	}
+
+	AuditEntityAllow = []string{
+		AuditEntityLanguage,
+		AuditEntityWebsite,
+		AuditEntityWebsiteTLLanguage,
+		AuditEntityLink,
+		AuditEntityLinkTLLanguage,
+		AuditEntityCreator,
+		AuditEntityCreatorLink,
+		AuditEntityTag,
+		AuditEntityComic,
+		AuditEntityComicTitle,
+		AuditEntityComicLink,
+		AuditEntityComicCreator,
+		AuditEntityComicTag,
+		AuditEntityComicChapter,
+		AuditEntityComicChapterLink,
+	}
+
+	// DBAuditComicCodeToID resolves the comic in the trash as well, its
+	// history outlives the deletion. The code may have been taken again since,
+	// the comic added last is the one.
+	DBAuditComicCodeToID = func(code string) DBQueryValue {
+		return DBQueryValue{
+			Table:      DBComic,
+			Expression: "MAX(" + DBGenericID + ")",
+			ZeroValue:  0,
+			Conditions: map[string]any{DBComicCode: code},
+		}
+	}
+)
+
+type (
+	// Audited is a row of the catalog the audit log keeps the history of, it
+	// names the row and the comic the row belongs to if any.
+	Audited interface {
+		AuditSID() string
+		AuditComicID() any
+	}
+
+	AuditEvent struct {
+		ID         uint           `json:"id"`
+		Actor      *string        `json:"actor"`
+		Operation  string         `json:"operation"`
+		Entity     string         `json:"entity"`
+		EntitySID  string         `json:"entitySID"`
+		ComicID    *uint          `json:"comicID"`
+		BeforeData map[string]any `json:"before"`
+		AfterData  map[string]any `json:"after"`
+		CreatedAt  time.Time      `json:"createdAt"`
+	}
+	AddAuditEvent struct {
+		Actor     *string
+		Operation string
+		Entity    string
+		EntitySID string
+		ComicID   any
+		Before    any
+		After     any
+	}
+
+	AuditParams struct {
+		Entity     *string
+		Operation  *string
+		Actor      *string
+		ComicCode  *string
+		Pagination *Pagination
+	}
+)
+
+func (m AuditParams) Validate() error {
+	if m.Entity != nil && !slices.Contains(AuditEntityAllow, *m.Entity) {
+		return GenericError("entity " + *m.Entity + " is not recognized")
+	}
+
+	if m.Operation != nil && !slices.Contains(AuditOperationAllow, *m.Operation) {
+		return GenericError("operation " + *m.Operation + " is not recognized")
+	}
+
+	if m.Pagination != nil {
+		if err := m.Pagination.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func auditID(id uint) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+func (m Language) AuditSID() string  { return m.IETF }
+func (m Language) AuditComicID() any { return nil }
+
+func (m Website) AuditSID() string  { return m.Domain }
+func (m Website) AuditComicID() any { return nil }
+
+func (m WebsiteTLLanguage) AuditSID() string  { return auditID(m.WebsiteID) + "/" + m.LanguageIETF }
+func (m WebsiteTLLanguage) AuditComicID() any { return nil }
+
+func (m Link) AuditSID() string  { return m.WebsiteDomain + m.RelativeURL }
+func (m Link) AuditComicID() any { return nil }
+
+func (m LinkTLLanguage) AuditSID() string  { return auditID(m.LinkID) + "/" + m.LanguageIETF }
+func (m LinkTLLanguage) AuditComicID() any { return nil }
+
+func (m Creator) AuditSID() string  { return m.Slug }
+func (m Creator) AuditComicID() any { return nil }
+
+func (m CreatorLink) AuditSID() string {
+	return auditID(m.CreatorID) + "/" + m.LinkWebsiteDomain + m.LinkRelativeURL
+}
+func (m CreatorLink) AuditComicID() any { return nil }
+
+func (m Tag) AuditSID() string  { return m.Namespace + "/" + m.Slug }
+func (m Tag) AuditComicID() any { return nil }
+
+func (m Comic) AuditSID() string  { return m.Code }
+func (m Comic) AuditComicID() any { return m.ID }
+
+func (m ComicTitle) AuditSID() string  { return auditID(m.ComicID) + "/" + m.RID }
+func (m ComicTitle) AuditComicID() any { return m.ComicID }
+
+func (m ComicLink) AuditSID() string {
+	return auditID(m.ComicID) + "/" + m.LinkWebsiteDomain + m.LinkRelativeURL
+}
+func (m ComicLink) AuditComicID() any { return m.ComicID }
+
+func (m ComicCreator) AuditSID() string  { return m.ComicCode + "/" + m.CreatorSlug + "/" + m.Role }
+func (m ComicCreator) AuditComicID() any { return m.ComicID }
+
+func (m ComicTag) AuditSID() string  { return m.ComicCode + "/" + m.TagNamespace + "/" + m.TagSlug }
+func (m ComicTag) AuditComicID() any { return m.ComicID }
+
+func (m ComicChapter) AuditSID() string {
+	sid := m.ComicCode + "/" + m.Chapter
+	if m.Version != nil {
+		sid += "/" + *m.Version
+	}
+	return sid
+}
+func (m ComicChapter) AuditComicID() any { return m.ComicID }
+
+func (m ComicChapterLink) AuditSID() string {
+	return auditID(m.ChapterID) + "/" + m.LinkWebsiteDomain + m.LinkRelativeURL
+}
+
+// AuditComicID of a comic chapter link looks the comic up from its chapter,
+// the chapter may be in the trash already.
+func (m ComicChapterLink) AuditComicID() any {
+	return DBQueryValue{
+		Table:      DBComicChapter,
+		Expression: DBComicGenericComicID,
+		Conditions: map[string]any{DBGenericID: m.ChapterID},
+	}
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"slices"
+	"strconv"
+)
+
+const (
+	BatchOperationsMax            = 500
+	BatchModeAtomic               = "atomic"
+	BatchModeBestEffort           = "bestEffort"
+	BatchActionAddLink            = "addLink"
+	BatchActionDeleteLink         = "deleteLink"
+	BatchActionAddComicChapter    = "addComicChapter"
+	BatchActionDeleteComicChapter = "deleteComicChapter"
+	BatchStatusDone               = "done"
+	BatchStatusFailed             = "failed"
+	BatchStatusAborted            = "aborted"
+)
+
+var (
+	BatchModeAllow = []string{
+		BatchModeAtomic,
+		BatchModeBestEffort,
+	}
+
+	BatchActionAllow = []string{
+		BatchActionAddLink,
+		BatchActionDeleteLink,
+		BatchActionAddComicChapter,
+		BatchActionDeleteComicChapter,
+	}
+)
+
+type (
+	Batch struct {
+		Mode       string
+		Operations []BatchOperation
+	}
+
+	// BatchOperation is an action along with the data it needs, AddLink and
+	// LinkSID for link actions, AddComicChapter and ComicChapterSID for comic
+	// chapter actions.
+	BatchOperation struct {
+		Action          string
+		AddLink         *AddLink
+		LinkSID         *LinkSID
+		AddComicChapter *AddComicChapter
+		ComicChapterSID *ComicChapterSID
+	}
+
+	BatchResult struct {
+		Status string
+		Err    error
+	}
+)
+
+func (m Batch) Validate() error {
+	if !slices.Contains(BatchModeAllow, m.Mode) {
+		return GenericError("mode " + m.Mode + " is not recognized")
+	}
+
+	if len(m.Operations) < 1 {
+		return GenericError("operations cannot be empty")
+	}
+
+	if len(m.Operations) > BatchOperationsMax {
+		max := strconv.Itoa(BatchOperationsMax)
+		return GenericError("operations must be at most " + max + " items")
+	}
+
+	for i, operation := range m.Operations {
+		if err := operation.Validate(); err != nil {
+			return GenericError("operation " + strconv.Itoa(i) + " " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (m BatchOperation) Validate() error {
+	switch m.Action {
+	case BatchActionAddLink:
+		if m.AddLink == nil {
+			return GenericError("link must exist")
+		}
+	case BatchActionDeleteLink:
+		if m.LinkSID == nil {
+			return GenericError("link sid must exist")
+		}
+	case BatchActionAddComicChapter:
+		if m.AddComicChapter == nil {
+			return GenericError("comic chapter must exist")
+		}
+	case BatchActionDeleteComicChapter:
+		if m.ComicChapterSID == nil {
+			return GenericError("comic chapter sid must exist")
+		}
+	default:
+		return GenericError("action " + m.Action + " is not recognized")
+	}
+
+	return nil
+}
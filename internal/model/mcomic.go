@@ -1,8 +1,11 @@
 package model
 
 import (
+	"net/url"
+	"regexp"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	bagicore "github.com/mahmudindes/orenocomic-bagicore"
@@ -13,52 +16,253 @@ func init() {
 }
 
 const (
-	ComicCodeLength    = 8
-	ComicOrderBysMax   = 3
-	ComicPaginationDef = 10
-	ComicPaginationMax = 50
-	DBComic            = bagicore.ID + "." + "comic"
-	DBComicCode        = "code"
+	ComicCodeLength      = 8
+	ComicCodeTitleMax    = 255
+	ComicCodeTriesMax    = 5
+	ComicCodeAlphabetDef = "23456789abcdefghjkmnpqrstuvwxyz"
+	ComicCodeModeRandom  = "random"
+	ComicCodeModeHash    = "hash"
+	ComicStartYearMin    = 1800
+	ComicSynopsisMax     = 4096
+	ComicCoverURLMax     = 255
+	ComicLinksMax        = 50
+	ComicChaptersMax     = 500
+	ComicOrderBysMax     = 3
+	ComicPaginationDef   = 10
+	ComicPaginationMax   = 50
+	DBComic              = bagicore.ID + "." + "comic"
+	DBComicCode          = "code"
+	DBComicStatus        = "status"
+	DBComicType          = "type"
+	DBComicStartYear     = "start_year"
+	DBComicSynopsis      = "synopsis"
+	DBComicCoverURL      = "cover_url"
+	DBComicCodeAlias     = bagicore.ID + "." + "comic_code_alias"
+	ComicStatusOngoing   = "ongoing"
+	ComicStatusCompleted = "completed"
+	ComicStatusHiatus    = "hiatus"
+	ComicStatusCancelled = "cancelled"
+	ComicTypeManga       = "manga"
+	ComicTypeManhwa      = "manhwa"
+	ComicTypeManhua      = "manhua"
+	ComicTypeWebtoon     = "webtoon"
+	ComicTypeOriginal    = "original"
+	ComicIncludeLimitMax = 50
+	ComicIncludeTitles   = "titles"
+	ComicIncludeCreators = "creators"
+	ComicIncludeTags     = "tags"
+	ComicIncludeLinks    = "links"
+	ComicIncludeLinkTLs  = "links.tlLanguages"
+	ComicIncludeChapters = "chapters"
+	ComicIncludeChLinks  = "chapters.links"
 )
 
 var (
+	ErrComicCodeExists = GenericError("same code already exists")
+
+	ComicCodeAlphabetAllow = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ-._~"
+
+	ComicCodeModeAllow = []string{
+		ComicCodeModeRandom,
+		ComicCodeModeHash,
+	}
+
 	ComicOrderByAllow = []string{
 		DBComicCode,
+		DBComicStartYear,
+	}
+
+	ComicSetNullAllow = []string{
+		DBComicStatus,
+		DBComicType,
+		DBComicStartYear,
+		DBComicSynopsis,
+		DBComicCoverURL,
+	}
+
+	ComicStatusAllow = []string{
+		ComicStatusOngoing,
+		ComicStatusCompleted,
+		ComicStatusHiatus,
+		ComicStatusCancelled,
 	}
 
+	ComicTypeAllow = []string{
+		ComicTypeManga,
+		ComicTypeManhwa,
+		ComicTypeManhua,
+		ComicTypeWebtoon,
+		ComicTypeOriginal,
+	}
+
+	ComicIncludeAllow = []string{
+		ComicIncludeTitles,
+		ComicIncludeCreators,
+		ComicIncludeTags,
+		ComicIncludeLinks,
+		ComicIncludeLinkTLs,
+		ComicIncludeChapters,
+		ComicIncludeChLinks,
+	}
+
+	// ComicIncludeDef is what gets embedded when no include is requested.
+	ComicIncludeDef = ComicInclude{Relations: []string{
+		ComicIncludeTitles,
+		ComicIncludeCreators,
+		ComicIncludeTags,
+		ComicIncludeLinks,
+		ComicIncludeChapters,
+		ComicIncludeChLinks,
+	}}
+
+	// ComicIncludeAll embeds every row of the default relations, as a comic
+	// just added is returned with all that came along with it.
+	ComicIncludeAll = ComicInclude{Relations: ComicIncludeDef.Relations, Limit: -1}
+
 	DBComicCodeToID = func(code string) DBQueryValue {
 		return DBQueryValue{
 			Table:      DBComic,
 			Expression: DBGenericID,
 			ZeroValue:  0,
-			Conditions: DBConditionalKV{Key: DBComicCode, Value: code},
+			Conditions: map[string]any{
+				DBComicCode:        code,
+				DBGenericDeletedAt: DBIsNull{},
+			},
 		}
 	}
 )
 
 type (
 	Comic struct {
-		ID        uint            `json:"id"`
-		Code      string          `json:"code"`
-		Links     []*Link         `db:"-" json:"links"`
-		Chapters  []*ComicChapter `db:"-" json:"chapters"`
-		CreatedAt time.Time       `json:"createdAt"`
-		UpdatedAt *time.Time      `json:"updatedAt"`
+		ID        uint              `json:"id"`
+		Code      string            `json:"code"`
+		Status    *string           `json:"status"`
+		Type      *string           `json:"type"`
+		StartYear *int              `json:"startYear"`
+		Synopsis  map[string]string `json:"synopsis"`
+		CoverURL  *string           `json:"coverURL"`
+		Titles    []*ComicTitle     `db:"-" json:"titles"`
+		Creators  []*ComicCreator   `db:"-" json:"creators"`
+		Tags      []*ComicTag       `db:"-" json:"tags"`
+		Links     []*Link           `db:"-" json:"links"`
+		Chapters  []*ComicChapter   `db:"-" json:"chapters"`
+		CreatedAt time.Time         `json:"createdAt"`
+		UpdatedAt *time.Time        `json:"updatedAt"`
+		DeletedAt *time.Time        `json:"deletedAt"`
 	}
 
+	// AddComic leaves the code to be generated when Code is empty, Title is
+	// what the code is derived from in the hash mode.
 	AddComic struct {
-		Code string
+		Code      string
+		Title     *string
+		Status    *string
+		Type      *string
+		StartYear *int
+		Synopsis  map[string]string
+		CoverURL  *string
+		Links     []AddLink
+		Chapters  []AddComicChapter
 	}
 
 	SetComic struct {
-		Code *string
+		Code      *string
+		Status    *string
+		Type      *string
+		StartYear *int
+		Synopsis  map[string]string
+		CoverURL  *string
+		SetNull   []string
+	}
+
+	// ComicInclude selects the relations embedded in comic, Limit caps every
+	// embedded collection per comic where zero means the default of each. A
+	// negative Limit embeds every row, it fails Validate so only the service
+	// sets it.
+	ComicInclude struct {
+		Relations []string
+		Limit     int
 	}
 )
 
+func (m ComicInclude) Has(relation string) bool {
+	return slices.Contains(m.Relations, relation)
+}
+
+// LimitOf gives the cap of a relation whose list defaults to def and allows at
+// most max, zero when every row is embedded.
+func (m ComicInclude) LimitOf(def, max int) int {
+	switch {
+	case m.Limit < 0:
+		return 0
+	case m.Limit < 1:
+		return def
+	case m.Limit > max:
+		return max
+	}
+	return m.Limit
+}
+
+func (m ComicInclude) Validate() error {
+	for _, relation := range m.Relations {
+		if !slices.Contains(ComicIncludeAllow, relation) {
+			return GenericError("include " + relation + " is not recognized")
+		}
+	}
+
+	if m.Limit < 0 {
+		return GenericError("include limit cannot be negative")
+	}
+
+	if m.Limit > ComicIncludeLimitMax {
+		max := strconv.FormatInt(ComicIncludeLimitMax, 10)
+		return GenericError("include limit must be at most " + max)
+	}
+
+	return nil
+}
+
 func (m AddComic) Validate() error {
-	return (SetComic{
-		Code: &m.Code,
-	}).Validate()
+	if m.Title != nil && len(*m.Title) > ComicCodeTitleMax {
+		max := strconv.Itoa(ComicCodeTitleMax)
+		return GenericError("title must be at most " + max + " characters long")
+	}
+
+	if err := (SetComic{
+		Code:      &m.Code,
+		Status:    m.Status,
+		Type:      m.Type,
+		StartYear: m.StartYear,
+		Synopsis:  m.Synopsis,
+		CoverURL:  m.CoverURL,
+	}).Validate(); err != nil {
+		return err
+	}
+
+	if len(m.Links) > ComicLinksMax {
+		max := strconv.Itoa(ComicLinksMax)
+		return GenericError("links must be at most " + max + " items")
+	}
+
+	for _, link := range m.Links {
+		if err := link.Validate(); err != nil {
+			return GenericError("link " + err.Error())
+		}
+	}
+
+	if len(m.Chapters) > ComicChaptersMax {
+		max := strconv.Itoa(ComicChaptersMax)
+		return GenericError("chapters must be at most " + max + " items")
+	}
+
+	for _, chapter := range m.Chapters {
+		chapter.ComicCode = &m.Code
+		if err := chapter.Validate(); err != nil {
+			return GenericError("chapter " + err.Error())
+		}
+	}
+
+	return nil
 }
 
 func (m SetComic) Validate() error {
@@ -73,6 +277,187 @@ func (m SetComic) Validate() error {
 		}
 	}
 
+	if m.Status != nil {
+		if !slices.Contains(ComicStatusAllow, *m.Status) {
+			return GenericError("status " + *m.Status + " is not recognized")
+		}
+	}
+
+	if m.Type != nil {
+		if !slices.Contains(ComicTypeAllow, *m.Type) {
+			return GenericError("type " + *m.Type + " is not recognized")
+		}
+	}
+
+	if m.StartYear != nil {
+		if *m.StartYear < ComicStartYearMin {
+			min := strconv.Itoa(ComicStartYearMin)
+			return GenericError("start year must be at least " + min)
+		}
+
+		if max := time.Now().UTC().Year() + 1; *m.StartYear > max {
+			return GenericError("start year must be at most " + strconv.Itoa(max))
+		}
+	}
+
+	for ietf, synopsis := range m.Synopsis {
+		if err := (SetLanguage{IETF: &ietf}).Validate(); err != nil {
+			return GenericError("synopsis language " + err.Error())
+		}
+
+		if synopsis == "" {
+			return GenericError("synopsis " + ietf + " cannot be empty")
+		}
+
+		if len(synopsis) > ComicSynopsisMax {
+			max := strconv.FormatInt(ComicSynopsisMax, 10)
+			return GenericError("synopsis " + ietf + " must be at most " + max + " characters long")
+		}
+	}
+
+	if m.CoverURL != nil {
+		if *m.CoverURL == "" {
+			return GenericError("cover url cannot be empty")
+		}
+
+		if len(*m.CoverURL) > ComicCoverURLMax {
+			max := strconv.FormatInt(ComicCoverURLMax, 10)
+			return GenericError("cover url must be at most " + max + " characters long")
+		}
+
+		if u, err := url.ParseRequestURI(*m.CoverURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return GenericError("cover url must be an absolute http or https url")
+		}
+	}
+
+	for _, key := range m.SetNull {
+		if !slices.Contains(ComicSetNullAllow, key) {
+			return GenericError("set null " + key + " is not recognized")
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	ComicTitleOrderByAllow = append(ComicTitleOrderByAllow, GenericOrderByAllow...)
+}
+
+const (
+	ComicTitleRIDLength      = 5
+	ComicTitleTitleMax       = 255
+	ComicTitleOrderBysMax    = 3
+	ComicTitlePaginationDef  = 10
+	ComicTitlePaginationMax  = 50
+	DBComicTitle             = bagicore.ID + "." + "comic_title"
+	DBComicTitleRID          = "rid"
+	DBComicTitleTitle        = "title"
+	DBComicTitleIsPrimary    = "is_primary"
+	DBComicTitleRomanized    = "romanized"
+	DBComicTitleLanguageIETF = "language_ietf"
+)
+
+var (
+	ComicTitleOrderByAllow = []string{
+		DBComicGenericComicID,
+		DBLanguageGenericLanguageID,
+		DBComicTitleTitle,
+		DBComicTitleIsPrimary,
+		DBComicTitleRomanized,
+	}
+)
+
+type (
+	ComicTitle struct {
+		ID           uint       `json:"id"`
+		ComicID      uint       `json:"comicID"`
+		RID          string     `json:"rid"`
+		LanguageID   uint       `json:"languageID"`
+		LanguageIETF string     `json:"languageIETF"`
+		Title        string     `json:"title"`
+		IsPrimary    bool       `json:"isPrimary"`
+		Romanized    bool       `json:"romanized"`
+		CreatedAt    time.Time  `json:"createdAt"`
+		UpdatedAt    *time.Time `json:"updatedAt"`
+	}
+
+	AddComicTitle struct {
+		ComicID      *uint
+		ComicCode    *string
+		RID          string
+		LanguageID   *uint
+		LanguageIETF *string
+		Title        string
+		IsPrimary    *bool
+		Romanized    *bool
+	}
+
+	SetComicTitle struct {
+		ComicID      *uint
+		ComicCode    *string
+		RID          *string
+		LanguageID   *uint
+		LanguageIETF *string
+		Title        *string
+		IsPrimary    *bool
+		Romanized    *bool
+	}
+
+	ComicTitleSID struct {
+		ComicID   *uint
+		ComicCode *string
+		RID       string
+	}
+)
+
+func (m AddComicTitle) Validate() error {
+	if m.ComicID == nil && m.ComicCode == nil {
+		return GenericError("either comic id or comic code must exist")
+	}
+
+	if m.LanguageID == nil && m.LanguageIETF == nil {
+		return GenericError("either language id or language ietf must exist")
+	}
+
+	return (SetComicTitle{
+		ComicID:      m.ComicID,
+		ComicCode:    m.ComicCode,
+		RID:          &m.RID,
+		LanguageID:   m.LanguageID,
+		LanguageIETF: m.LanguageIETF,
+		Title:        &m.Title,
+		IsPrimary:    m.IsPrimary,
+		Romanized:    m.Romanized,
+	}).Validate()
+}
+
+func (m SetComicTitle) Validate() error {
+	if err := (SetComic{Code: m.ComicCode}).Validate(); err != nil {
+		return GenericError("comic " + err.Error())
+	}
+
+	if m.RID != nil {
+		if len(*m.RID) != ComicTitleRIDLength {
+			length := strconv.Itoa(ComicTitleRIDLength)
+			return GenericError("rid must be " + length + " characters long")
+		}
+	}
+
+	if err := (SetLanguage{IETF: m.LanguageIETF}).Validate(); err != nil {
+		return GenericError("language " + err.Error())
+	}
+
+	if m.Title != nil {
+		if *m.Title == "" {
+			return GenericError("title cannot be empty")
+		}
+
+		if len(*m.Title) > ComicTitleTitleMax {
+			max := strconv.FormatInt(ComicTitleTitleMax, 10)
+			return GenericError("title must be at most " + max + " characters long")
+		}
+	}
+
 	return nil
 }
 
@@ -92,6 +477,13 @@ var (
 	ComicLinkOrderByAllow = []string{
 		DBLinkGenericLinkID,
 	}
+
+	DBComicLinked = func(sid LinkSID) DBCrossConditional {
+		return DBCrossConditional{Table: DBComicLink, Conditions: map[string]any{
+			DBComicGenericComicID: DBColumnValue(DBComic + "." + DBGenericID),
+			DBLinkGenericLinkID:   DBLinkSIDToID(sid),
+		}}
+	}
 )
 
 type (
@@ -156,20 +548,230 @@ func (m SetComicLink) Validate() error {
 	return nil
 }
 
+func init() {
+	ComicCreatorOrderByAllow = append(ComicCreatorOrderByAllow, GenericOrderByAllow...)
+}
+
+const (
+	ComicCreatorOrderBysMax    = 3
+	ComicCreatorPaginationDef  = 10
+	ComicCreatorPaginationMax  = 50
+	DBComicCreator             = bagicore.ID + "." + "comic_creator"
+	DBComicCreatorRole         = "role"
+	ComicCreatorRoleStory      = "story"
+	ComicCreatorRoleArt        = "art"
+	ComicCreatorRoleOriginal   = "original-work"
+	ComicCreatorRoleTranslator = "translator"
+)
+
+var (
+	ComicCreatorOrderByAllow = []string{
+		DBComicGenericComicID,
+		DBCreatorGenericCreatorID,
+		DBComicCreatorRole,
+	}
+
+	ComicCreatorRoleAllow = []string{
+		ComicCreatorRoleStory,
+		ComicCreatorRoleArt,
+		ComicCreatorRoleOriginal,
+		ComicCreatorRoleTranslator,
+	}
+)
+
+type (
+	ComicCreator struct {
+		ComicID     uint       `json:"comicID"`
+		ComicCode   string     `json:"comicCode"`
+		CreatorID   uint       `json:"creatorID"`
+		CreatorSlug string     `json:"creatorSlug"`
+		CreatorName string     `json:"creatorName"`
+		Role        string     `json:"role"`
+		CreatedAt   time.Time  `json:"createdAt"`
+		UpdatedAt   *time.Time `json:"updatedAt"`
+	}
+	AddComicCreator struct {
+		ComicID     *uint
+		ComicCode   *string
+		CreatorID   *uint
+		CreatorSlug *string
+		Role        string
+	}
+	SetComicCreator struct {
+		ComicID     *uint
+		ComicCode   *string
+		CreatorID   *uint
+		CreatorSlug *string
+		Role        *string
+	}
+	ComicCreatorSID struct {
+		ComicID     *uint
+		ComicCode   *string
+		CreatorID   *uint
+		CreatorSlug *string
+		Role        string
+	}
+)
+
+func (m AddComicCreator) Validate() error {
+	if m.ComicID == nil && m.ComicCode == nil {
+		return GenericError("either comic id or comic code must exist")
+	}
+
+	if m.CreatorID == nil && m.CreatorSlug == nil {
+		return GenericError("either creator id or creator slug must exist")
+	}
+
+	return (SetComicCreator{
+		ComicID:     m.ComicID,
+		ComicCode:   m.ComicCode,
+		CreatorID:   m.CreatorID,
+		CreatorSlug: m.CreatorSlug,
+		Role:        &m.Role,
+	}).Validate()
+}
+func (m SetComicCreator) Validate() error {
+	if err := (SetComic{Code: m.ComicCode}).Validate(); err != nil {
+		return GenericError("comic " + err.Error())
+	}
+
+	if err := (SetCreator{Slug: m.CreatorSlug}).Validate(); err != nil {
+		return GenericError("creator " + err.Error())
+	}
+
+	if m.Role != nil {
+		if !slices.Contains(ComicCreatorRoleAllow, *m.Role) {
+			return GenericError("role " + *m.Role + " is not recognized")
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	ComicTagOrderByAllow = append(ComicTagOrderByAllow, GenericOrderByAllow...)
+}
+
+const (
+	DBTagGenericTagID     = "tag_id"
+	ComicTagOrderBysMax   = 3
+	ComicTagPaginationDef = 10
+	ComicTagPaginationMax = 50
+	DBComicTag            = bagicore.ID + "." + "comic_tag"
+	DBComicTagNamespace   = "tag_namespace"
+	DBComicTagSlug        = "tag_slug"
+)
+
+var (
+	ComicTagOrderByAllow = []string{
+		DBComicGenericComicID,
+		DBTagGenericTagID,
+		DBComicTagNamespace,
+		DBComicTagSlug,
+	}
+
+	DBComicTagged = func(sids ...TagSID) DBCrossConditional {
+		conditions := []any{DBLogicalOR{}}
+		for _, sid := range sids {
+			conditions = append(conditions, map[string]any{
+				DBComicGenericComicID: DBColumnValue(DBComic + "." + DBGenericID),
+				DBTagGenericTagID:     DBTagSIDToID(sid),
+			})
+		}
+		return DBCrossConditional{Table: DBComicTag, Conditions: conditions}
+	}
+
+	DBComicNotTagged = func(sids ...TagSID) DBNotCrossConditional {
+		return DBNotCrossConditional(DBComicTagged(sids...))
+	}
+)
+
+type (
+	ComicTag struct {
+		ComicID      uint              `json:"comicID"`
+		ComicCode    string            `json:"comicCode"`
+		TagID        uint              `json:"tagID"`
+		TagNamespace string            `json:"tagNamespace"`
+		TagSlug      string            `json:"tagSlug"`
+		TagNames     map[string]string `json:"tagNames"`
+		CreatedAt    time.Time         `json:"createdAt"`
+		UpdatedAt    *time.Time        `json:"updatedAt"`
+	}
+	AddComicTag struct {
+		ComicID   *uint
+		ComicCode *string
+		TagID     *uint
+		TagSID    *TagSID
+	}
+	SetComicTag struct {
+		ComicID   *uint
+		ComicCode *string
+		TagID     *uint
+		TagSID    *TagSID
+	}
+	ComicTagSID struct {
+		ComicID   *uint
+		ComicCode *string
+		TagID     *uint
+		TagSID    *TagSID
+	}
+)
+
+func (m AddComicTag) Validate() error {
+	if m.ComicID == nil && m.ComicCode == nil {
+		return GenericError("either comic id or comic code must exist")
+	}
+
+	if m.TagID == nil && m.TagSID == nil {
+		return GenericError("either tag id or tag sid must exist")
+	}
+
+	return (SetComicTag{
+		ComicID:   m.ComicID,
+		ComicCode: m.ComicCode,
+		TagID:     m.TagID,
+		TagSID:    m.TagSID,
+	}).Validate()
+}
+
+func (m SetComicTag) Validate() error {
+	if err := (SetComic{Code: m.ComicCode}).Validate(); err != nil {
+		return GenericError("comic " + err.Error())
+	}
+
+	if m.TagSID != nil {
+		if err := (SetTag{
+			Namespace: &m.TagSID.Namespace,
+			Slug:      &m.TagSID.Slug,
+		}).Validate(); err != nil {
+			return GenericError("tag " + err.Error())
+		}
+	}
+
+	return nil
+}
+
 func init() {
 	ComicChapterOrderByAllow = append(ComicChapterOrderByAllow, GenericOrderByAllow...)
 }
 
 const (
-	ComicChapterChapterMax    = 64
-	ComicChapterVersionMax    = 32
-	ComicChapterOrderBysMax   = 5
-	ComicChapterPaginationDef = 10
-	ComicChapterPaginationMax = 50
-	DBComicChapter            = bagicore.ID + "." + "comic_chapter"
-	DBComicChapterChapter     = "chapter"
-	DBComicChapterVersion     = "version"
-	DBComicChapterReleasedAt  = "released_at"
+	ComicChapterChapterMax        = 64
+	ComicChapterVersionMax        = 32
+	ComicChapterLinksMax          = 50
+	ComicChapterOrderBysMax       = 5
+	ComicChapterPaginationDef     = 10
+	ComicChapterPaginationMax     = 50
+	DBComicChapter                = bagicore.ID + "." + "comic_chapter"
+	DBComicChapterChapter         = "chapter"
+	DBComicChapterVersion         = "version"
+	DBComicChapterReleasedAt      = "released_at"
+	DBComicChapterNumber          = "number"
+	ComicChapterNumberSubMax      = 999
+	ComicChapterNumberSubZerosMax = 2
+	ComicChapterNumberXtraMax     = 98
+	ComicChapterNumberPartMax     = 99
+	ComicChapterNumberExtras      = 1000000
 )
 
 var (
@@ -178,6 +780,7 @@ var (
 		DBComicChapterChapter,
 		DBComicChapterVersion,
 		DBComicChapterReleasedAt,
+		DBComicChapterNumber,
 	}
 
 	ComicChapterSetNullAllow = []string{
@@ -208,6 +811,7 @@ var (
 				DBComicGenericComicID: comicID,
 				DBComicChapterChapter: sid.Chapter,
 				DBComicChapterVersion: version,
+				DBGenericDeletedAt:    DBIsNull{},
 			},
 		}
 	}
@@ -221,9 +825,11 @@ type (
 		Chapter    string     `json:"chapter"`
 		Version    *string    `json:"version"`
 		ReleasedAt time.Time  `json:"releasedAt"`
+		Number     *float64   `json:"number"`
 		Links      []*Link    `db:"-" json:"links"`
 		CreatedAt  time.Time  `json:"createdAt"`
 		UpdatedAt  *time.Time `json:"updatedAt"`
+		DeletedAt  *time.Time `json:"deletedAt"`
 	}
 
 	AddComicChapter struct {
@@ -232,6 +838,7 @@ type (
 		Chapter    string
 		Version    *string
 		ReleasedAt time.Time
+		Links      []AddLink
 	}
 
 	SetComicChapter struct {
@@ -256,13 +863,28 @@ func (m AddComicChapter) Validate() error {
 		return GenericError("either comic id or comic code must exist")
 	}
 
-	return (SetComicChapter{
+	if err := (SetComicChapter{
 		ComicID:    m.ComicID,
 		ComicCode:  m.ComicCode,
 		Chapter:    &m.Chapter,
 		Version:    m.Version,
 		ReleasedAt: &m.ReleasedAt,
-	}).Validate()
+	}).Validate(); err != nil {
+		return err
+	}
+
+	if len(m.Links) > ComicChapterLinksMax {
+		max := strconv.Itoa(ComicChapterLinksMax)
+		return GenericError("links must be at most " + max + " items")
+	}
+
+	for _, link := range m.Links {
+		if err := link.Validate(); err != nil {
+			return GenericError("link " + err.Error())
+		}
+	}
+
+	return nil
 }
 
 func (m SetComicChapter) Validate() error {
@@ -301,6 +923,84 @@ func (m SetComicChapter) Validate() error {
 	return nil
 }
 
+var (
+	comicChapterVolumeRegexp  = regexp.MustCompile(`(?i)\b(?:vol(?:ume)?|v)\.?\s*\d+(?:\.\d+)?`)
+	comicChapterChapterRegexp = regexp.MustCompile(`(?i)\b(?:ch(?:apter)?|ep(?:isode)?|c)\.?\s*(\d+(?:\.\d+)?)`)
+	comicChapterPartRegexp    = regexp.MustCompile(`(?i)\b(?:part|pt)\.?\s*(\d+)`)
+	comicChapterExtraRegexp   = regexp.MustCompile(`(?i)\b(?:extra|special|bonus|omake|side ?story)\b\.?\s*(\d+(?:\.\d+)?)?`)
+	comicChapterNumberRegexp  = regexp.MustCompile(`\d+(?:\.\d+)?`)
+)
+
+// ComicChapterNumber gives the sort key of the free text chapter, nil when it
+// has no number. The chapter number counts whether labelled or bare and the
+// volume is left out since chapters run on across volumes, "Vol.2 Ch.10" is
+// 10. The decimals of the key hold in turn the sub chapter, so "12.10" comes
+// after "12.9" and "12.05" before "12.5", then the extra of the chapter one
+// past its index, so "12 Extra" comes before "12 Extra 2", then the part. An
+// extra on its own such as "Extra 3" is put after every chapter at
+// ComicChapterNumberExtras plus its index.
+func ComicChapterNumber(chapter string) *float64 {
+	rest := comicChapterVolumeRegexp.ReplaceAllString(chapter, " ")
+
+	var part int
+	if match := comicChapterPartRegexp.FindStringSubmatch(rest); match != nil {
+		part, _ = strconv.Atoi(match[1])
+		rest = strings.Replace(rest, match[0], " ", 1)
+	}
+
+	var extra bool
+	var extraIndex string
+	if match := comicChapterExtraRegexp.FindStringSubmatch(rest); match != nil {
+		extra, extraIndex = true, match[1]
+		rest = strings.Replace(rest, match[0], " ", 1)
+	}
+
+	var number string
+	if match := comicChapterChapterRegexp.FindStringSubmatch(rest); match != nil {
+		number = match[1]
+	} else {
+		number = comicChapterNumberRegexp.FindString(rest)
+	}
+
+	var base, xtra int
+	switch {
+	case number != "" && extra:
+		index, _, _ := strings.Cut(extraIndex, ".")
+		xtra, _ = strconv.Atoi(index)
+		xtra = min(xtra, ComicChapterNumberXtraMax) + 1
+	case number != "":
+	case extra:
+		number, base = extraIndex, ComicChapterNumberExtras
+	default:
+		return nil
+	}
+
+	whole, decimals, _ := strings.Cut(number, ".")
+	chapter0, _ := strconv.Atoi(whole)
+	part = min(part, ComicChapterNumberPartMax)
+
+	result := float64(base+chapter0) + float64(comicChapterSub(decimals)*10000+xtra*100+part)/1e8
+	return &result
+}
+
+// comicChapterSub gives the sort key of the sub chapter decimals, their value
+// and then the fewer leading zeros the later so "5", "05" and "005" keep
+// apart. No decimals at all come first.
+func comicChapterSub(decimals string) int {
+	if decimals == "" {
+		return 0
+	}
+
+	sub, _ := strconv.Atoi(decimals)
+	sub = min(sub, ComicChapterNumberSubMax)
+	zeros := len(decimals) - len(strings.TrimLeft(decimals, "0"))
+	if sub == 0 {
+		zeros--
+	}
+	zeros = min(zeros, ComicChapterNumberSubZerosMax)
+	return sub*(ComicChapterNumberSubZerosMax+1) + ComicChapterNumberSubZerosMax - zeros + 1
+}
+
 func init() {
 	ComicChapterLinkOrderByAllow = append(ComicChapterLinkOrderByAllow, GenericOrderByAllow...)
 }
@@ -317,6 +1017,15 @@ var (
 	ComicChapterLinkOrderByAllow = []string{
 		DBWebsiteGenericWebsiteID,
 	}
+
+	// DBComicChapterLinked expects the outer comic chapter row id unqualified,
+	// the join table has no id column so it resolves to the outer row.
+	DBComicChapterLinked = func(sid LinkSID) DBCrossConditional {
+		return DBCrossConditional{Table: DBComicChapterLink, Conditions: map[string]any{
+			DBComicChapterLink + "." + DBComicChapterGenericChapterID: DBColumnValue(DBGenericID),
+			DBComicChapterLink + "." + DBLinkGenericLinkID:            DBLinkSIDToID(sid),
+		}}
+	}
 )
 
 type (
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComicChapterNumber(t *testing.T) {
+	tests := []struct {
+		chapter string
+		want    *float64
+	}{
+		{"12", ptr(12)},
+		{"Ch. 12", ptr(12)},
+		{"Vol.2 Ch.10", ptr(10)},
+		{"Episode 7", ptr(7)},
+		{"12.5", ptr(12.0018)},
+		{"12.05", ptr(12.0017)},
+		{"12.10", ptr(12.0033)},
+		{"12 Part 2", ptr(12.00000002)},
+		{"12 Extra", ptr(12.000001)},
+		{"Chapter 12 Extra 2", ptr(12.000003)},
+		{"12.5 Part 2", ptr(12.00180002)},
+		{"12.5 Extra", ptr(12.001801)},
+		{"Extra 3", ptr(ComicChapterNumberExtras + 3)},
+		{"Side Story", ptr(ComicChapterNumberExtras)},
+		{"Prologue", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		got := ComicChapterNumber(tt.chapter)
+		switch {
+		case got == nil && tt.want == nil:
+		case got == nil || tt.want == nil:
+			t.Errorf("ComicChapterNumber(%q) = %v, want %v", tt.chapter, deref(got), deref(tt.want))
+		case math.Abs(*got-*tt.want) > 1e-9:
+			t.Errorf("ComicChapterNumber(%q) = %v, want %v", tt.chapter, *got, *tt.want)
+		}
+	}
+}
+
+// TestComicChapterNumberOrder keeps chapters given in reading order sorted the
+// same way by their numbers, with no two of them tied.
+func TestComicChapterNumberOrder(t *testing.T) {
+	chapters := []string{
+		"12", "12 Part 2", "12 Extra", "12 Extra 2", "12.0", "12.05", "12.5", "12.5 Part 2", "12.5 Extra",
+		"12.9", "12.10", "13", "Extra", "Extra 2", "Extra 3",
+	}
+	numbers := make([]float64, 0, len(chapters))
+	for _, chapter := range chapters {
+		number := ComicChapterNumber(chapter)
+		if number == nil {
+			t.Fatalf("ComicChapterNumber(%q) = nil", chapter)
+		}
+		numbers = append(numbers, *number)
+	}
+	for i := 1; i < len(numbers); i++ {
+		if numbers[i-1] >= numbers[i] {
+			t.Errorf("numbers of %q = %v, want them strictly sorted", chapters, numbers)
+			break
+		}
+	}
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
+
+func deref(v *float64) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
@@ -0,0 +1,213 @@
+package model
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	bagicore "github.com/mahmudindes/orenocomic-bagicore"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/utila"
+)
+
+func init() {
+	CreatorOrderByAllow = append(CreatorOrderByAllow, GenericOrderByAllow...)
+}
+
+const (
+	CreatorSlugMax           = 64
+	CreatorNameMax           = 128
+	CreatorAlternateNamesMax = 16
+	CreatorOrderBysMax       = 3
+	CreatorPaginationDef     = 10
+	CreatorPaginationMax     = 50
+	DBCreator                = bagicore.ID + "." + "creator"
+	DBCreatorSlug            = "slug"
+	DBCreatorName            = "name"
+	DBCreatorAlternateNames  = "alternate_names"
+)
+
+var (
+	CreatorOrderByAllow = []string{
+		DBCreatorSlug,
+		DBCreatorName,
+	}
+
+	CreatorSetNullAllow = []string{
+		DBCreatorAlternateNames,
+	}
+
+	DBCreatorSlugToID = func(slug string) DBQueryValue {
+		return DBQueryValue{
+			Table:      DBCreator,
+			Expression: DBGenericID,
+			ZeroValue:  0,
+			Conditions: DBConditionalKV{Key: DBCreatorSlug, Value: slug},
+		}
+	}
+)
+
+type (
+	Creator struct {
+		ID             uint       `json:"id"`
+		Slug           string     `json:"slug"`
+		Name           string     `json:"name"`
+		AlternateNames []string   `json:"alternateNames"`
+		Links          []*Link    `db:"-" json:"links"`
+		CreatedAt      time.Time  `json:"createdAt"`
+		UpdatedAt      *time.Time `json:"updatedAt"`
+	}
+
+	AddCreator struct {
+		Slug           string
+		Name           string
+		AlternateNames []string
+	}
+
+	SetCreator struct {
+		Slug           *string
+		Name           *string
+		AlternateNames []string
+		SetNull        []string
+	}
+)
+
+func (m AddCreator) Validate() error {
+	return (SetCreator{
+		Slug:           &m.Slug,
+		Name:           &m.Name,
+		AlternateNames: m.AlternateNames,
+	}).Validate()
+}
+
+func (m SetCreator) Validate() error {
+	if m.Slug != nil {
+		if *m.Slug == "" {
+			return GenericError("slug cannot be empty")
+		}
+
+		if len(*m.Slug) > CreatorSlugMax {
+			max := strconv.FormatInt(CreatorSlugMax, 10)
+			return GenericError("slug must be at most " + max + " characters long")
+		}
+
+		if !utila.ValidSlug(*m.Slug) {
+			return GenericError("slug is not valid")
+		}
+	}
+
+	if m.Name != nil {
+		if *m.Name == "" {
+			return GenericError("name cannot be empty")
+		}
+
+		if len(*m.Name) > CreatorNameMax {
+			max := strconv.FormatInt(CreatorNameMax, 10)
+			return GenericError("name must be at most " + max + " characters long")
+		}
+	}
+
+	if len(m.AlternateNames) > CreatorAlternateNamesMax {
+		max := strconv.FormatInt(CreatorAlternateNamesMax, 10)
+		return GenericError("alternate names must be at most " + max + " items")
+	}
+
+	for _, name := range m.AlternateNames {
+		if name == "" {
+			return GenericError("alternate name cannot be empty")
+		}
+
+		if len(name) > CreatorNameMax {
+			max := strconv.FormatInt(CreatorNameMax, 10)
+			return GenericError("alternate name must be at most " + max + " characters long")
+		}
+	}
+
+	for _, key := range m.SetNull {
+		if !slices.Contains(CreatorSetNullAllow, key) {
+			return GenericError("set null " + key + " is not recognized")
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	CreatorLinkOrderByAllow = append(CreatorLinkOrderByAllow, GenericOrderByAllow...)
+}
+
+const (
+	DBCreatorGenericCreatorID = "creator_id"
+	CreatorLinkOrderBysMax    = 3
+	CreatorLinkPaginationDef  = 10
+	CreatorLinkPaginationMax  = 50
+	DBCreatorLink             = bagicore.ID + "." + "creator_link"
+)
+
+var (
+	CreatorLinkOrderByAllow = []string{
+		DBLinkGenericLinkID,
+	}
+)
+
+type (
+	CreatorLink struct {
+		CreatorID         uint       `json:"-"`
+		LinkID            uint       `json:"linkID"`
+		LinkWebsiteDomain string     `json:"linkWebsiteDomain"`
+		LinkRelativeURL   string     `json:"linkRelativeURL"`
+		CreatedAt         time.Time  `json:"createdAt"`
+		UpdatedAt         *time.Time `json:"updatedAt"`
+	}
+	AddCreatorLink struct {
+		CreatorID   *uint
+		CreatorSlug *string
+		LinkID      *uint
+		LinkSID     *LinkSID
+	}
+	SetCreatorLink struct {
+		CreatorID   *uint
+		CreatorSlug *string
+		LinkID      *uint
+		LinkSID     *LinkSID
+	}
+	CreatorLinkSID struct {
+		CreatorID   *uint
+		CreatorSlug *string
+		LinkID      *uint
+		LinkSID     *LinkSID
+	}
+)
+
+func (m AddCreatorLink) Validate() error {
+	if m.CreatorID == nil && m.CreatorSlug == nil {
+		return GenericError("either creator id or creator slug must exist")
+	}
+
+	if m.LinkID == nil && m.LinkSID == nil {
+		return GenericError("either link id or link sid must exist")
+	}
+
+	return (SetCreatorLink{
+		CreatorID:   m.CreatorID,
+		CreatorSlug: m.CreatorSlug,
+		LinkID:      m.LinkID,
+		LinkSID:     m.LinkSID,
+	}).Validate()
+}
+
+func (m SetCreatorLink) Validate() error {
+	if err := (SetCreator{Slug: m.CreatorSlug}).Validate(); err != nil {
+		return GenericError("creator " + err.Error())
+	}
+
+	if m.LinkSID != nil {
+		if err := (SetLink{
+			WebsiteDomain: m.LinkSID.WebsiteDomain,
+			RelativeURL:   &m.LinkSID.RelativeURL,
+		}).Validate(); err != nil {
+			return GenericError("link " + err.Error())
+		}
+	}
+
+	return nil
+}
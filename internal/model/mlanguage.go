@@ -33,7 +33,10 @@ var (
 			Table:      DBLanguage,
 			Expression: DBGenericID,
 			ZeroValue:  0,
-			Conditions: DBConditionalKV{Key: DBLanguageIETF, Value: ietf},
+			Conditions: map[string]any{
+				DBLanguageIETF:     ietf,
+				DBGenericDeletedAt: DBIsNull{},
+			},
 		}
 	}
 )
@@ -45,6 +48,7 @@ type (
 		Name      string     `json:"name"`
 		CreatedAt time.Time  `json:"createdAt"`
 		UpdatedAt *time.Time `json:"updatedAt"`
+		DeletedAt *time.Time `json:"deletedAt"`
 	}
 
 	AddLanguage struct {
@@ -49,6 +49,7 @@ var (
 			Conditions: map[string]any{
 				DBWebsiteGenericWebsiteID: websiteID,
 				DBLinkRelativeURL:         sid.RelativeURL,
+				DBGenericDeletedAt:        DBIsNull{},
 			},
 		}
 	}
@@ -64,12 +65,16 @@ type (
 		MachineTL     *bool       `json:"machineTL"`
 		CreatedAt     time.Time   `json:"createdAt"`
 		UpdatedAt     *time.Time  `json:"updatedAt"`
+		DeletedAt     *time.Time  `json:"deletedAt"`
 	}
 
+	// AddLink takes either the website and relative url or the full URL, the
+	// service splits URL into the other two.
 	AddLink struct {
 		WebsiteID     *uint
 		WebsiteDomain *string
 		RelativeURL   string
+		URL           *string
 		MachineTL     *bool
 	}
 
@@ -89,6 +94,10 @@ type (
 )
 
 func (m AddLink) Validate() error {
+	if m.WebsiteID == nil && m.WebsiteDomain == nil {
+		return GenericError("either website id or website domain must exist")
+	}
+
 	return (SetLink{
 		WebsiteID:     m.WebsiteID,
 		WebsiteDomain: m.WebsiteDomain,
@@ -138,6 +147,15 @@ var (
 	LinkTLLanguageOrderByAllow = []string{
 		DBLanguageGenericLanguageID,
 	}
+
+	// DBLinkTranslated expects the outer link row id unqualified, the join
+	// table has no id column so it resolves to the outer row.
+	DBLinkTranslated = func(ietf string) DBCrossConditional {
+		return DBCrossConditional{Table: DBLinkTLLanguage, Conditions: map[string]any{
+			DBLinkTLLanguage + "." + DBLinkGenericLinkID:         DBColumnValue(DBGenericID),
+			DBLinkTLLanguage + "." + DBLanguageGenericLanguageID: DBLanguageIETFToID(ietf),
+		}}
+	}
 )
 
 type (
@@ -7,15 +7,25 @@ import (
 )
 
 type (
-	DBLogicalAND        struct{}
-	DBLogicalOR         struct{}
-	DBIsDistinctFrom    struct{ Value any }
-	DBIsNotDistinctFrom struct{ Value any }
-	DBIsNull            struct{}
-	DBIsNotNull         struct{}
-	DBBooleanIs         bool
-	DBBooleanIsNot      bool
-	DBInsensitiveLike   string
+	DBLogicalAND         struct{}
+	DBLogicalOR          struct{}
+	DBLogicalNOT         struct{ Conditions any }
+	DBIsDistinctFrom     struct{ Value any }
+	DBIsNotDistinctFrom  struct{ Value any }
+	DBLessThan           struct{ Value any }
+	DBLessThanOrEqual    struct{ Value any }
+	DBGreaterThan        struct{ Value any }
+	DBGreaterThanOrEqual struct{ Value any }
+	DBBetween            struct{ Min, Max any }
+	DBIn                 struct{ Values any }
+	DBNotIn              struct{ Values any }
+	DBIsNull             struct{}
+	DBIsNotNull          struct{}
+	DBBooleanIs          bool
+	DBBooleanIsNot       bool
+	DBInsensitiveLike    string
+	DBTrigramSimilar     string
+	DBColumnValue        string
 
 	DBConditionalKV struct {
 		Key   string
@@ -29,6 +39,14 @@ type (
 		Table      string
 		Conditions any
 	}
+	DBNotCrossConditional struct {
+		Table      string
+		Conditions any
+	}
+	DBKeysetAfter struct {
+		OrderBys OrderBys
+		Values   []any
+	}
 )
 
 const (
@@ -36,6 +54,7 @@ const (
 	DBGenericID        = "id"
 	DBGenericCreatedAt = "created_at"
 	DBGenericUpdatedAt = "updated_at"
+	DBGenericDeletedAt = "deleted_at"
 )
 
 var (
@@ -47,6 +66,7 @@ type ListParams struct {
 	Conditions any
 	OrderBys   OrderBys
 	Pagination *Pagination
+	Partition  *Partition
 }
 
 func (m ListParams) Validate() error {
@@ -99,6 +119,24 @@ func (ob OrderBy) Validate() error {
 	return nil
 }
 
+func (ob OrderBy) Descending() bool {
+	switch strings.ToLower(ob.Sort) {
+	case "d", "desc", "descend", "descending":
+		return true
+	}
+	return false
+}
+
+func (ob OrderBy) NullsFirst() bool {
+	switch strings.ToLower(ob.Null) {
+	case "f", "first":
+		return true
+	case "l", "last":
+		return false
+	}
+	return ob.Descending()
+}
+
 type OrderBys []OrderBy
 
 func (obs OrderBys) Validate() error {
@@ -112,8 +150,23 @@ func (obs OrderBys) Validate() error {
 }
 
 type Pagination struct {
-	Page  int
-	Limit int
+	Page   int
+	Limit  int
+	Keyset *Keyset
+}
+
+// Partition applies the order by and pagination to each value of the key
+// separately, such as limiting the embedded rows of every parent.
+type Partition struct {
+	Key    string
+	Values []uint
+}
+
+// Keyset replaces the page offset with the order by values of the last row
+// seen, Next is filled with the values of the last row returned if any.
+type Keyset struct {
+	After []any
+	Next  []any
 }
 
 func (p Pagination) Validate() error {
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"slices"
+	"strconv"
+)
+
+const (
+	SearchQueryMax         = 128
+	SearchPaginationDef    = 10
+	SearchPaginationMax    = 50
+	SearchKindComic        = "comic"
+	SearchKindWebsite      = "website"
+	SearchKindLink         = "link"
+	SearchFieldCode        = "code"
+	SearchFieldTitle       = "title"
+	SearchFieldAltTitle    = "alternate_title"
+	SearchFieldName        = "name"
+	SearchFieldDomain      = "domain"
+	SearchFieldRelativeURL = "relative_url"
+	DBSearchKind           = "kind"
+	DBSearchScore          = "score"
+)
+
+var (
+	SearchKindAllow = []string{
+		SearchKindComic,
+		SearchKindWebsite,
+		SearchKindLink,
+	}
+)
+
+type (
+	SearchResult struct {
+		Kind          string  `json:"kind"`
+		ID            uint    `json:"id"`
+		ComicCode     *string `json:"comicCode"`
+		WebsiteDomain *string `json:"websiteDomain"`
+		RelativeURL   *string `json:"relativeURL"`
+		Field         string  `json:"field"`
+		Text          string  `json:"text"`
+		Score         float64 `json:"score"`
+	}
+
+	SearchParams struct {
+		Query      string
+		Kinds      []string
+		Pagination *Pagination
+	}
+)
+
+func (m SearchParams) Validate() error {
+	if m.Query == "" {
+		return GenericError("query cannot be empty")
+	}
+
+	if len(m.Query) > SearchQueryMax {
+		max := strconv.FormatInt(SearchQueryMax, 10)
+		return GenericError("query must be at most " + max + " characters long")
+	}
+
+	for _, kind := range m.Kinds {
+		if !slices.Contains(SearchKindAllow, kind) {
+			return GenericError("kind " + kind + " is not recognized")
+		}
+	}
+
+	if m.Pagination != nil {
+		if err := m.Pagination.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package model
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	bagicore "github.com/mahmudindes/orenocomic-bagicore"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/utila"
+)
+
+func init() {
+	TagOrderByAllow = append(TagOrderByAllow, GenericOrderByAllow...)
+}
+
+const (
+	TagSlugMax                 = 64
+	TagNameMax                 = 64
+	TagOrderBysMax             = 3
+	TagPaginationDef           = 10
+	TagPaginationMax           = 50
+	TagFacetPaginationDef      = 50
+	TagFacetPaginationMax      = 200
+	DBTag                      = bagicore.ID + "." + "tag"
+	DBTagNamespace             = "namespace"
+	DBTagSlug                  = "slug"
+	DBTagNames                 = "names"
+	DBTagFacetComicCount       = "comic_count"
+	TagNamespaceGenre          = "genre"
+	TagNamespaceTheme          = "theme"
+	TagNamespaceDemographic    = "demographic"
+	TagNamespaceContentWarning = "content-warning"
+)
+
+var (
+	TagOrderByAllow = []string{
+		DBTagNamespace,
+		DBTagSlug,
+	}
+
+	TagFacetOrderByAllow = []string{
+		DBTagNamespace,
+		DBTagSlug,
+		DBTagFacetComicCount,
+	}
+
+	TagSetNullAllow = []string{
+		DBTagNames,
+	}
+
+	TagNamespaceAllow = []string{
+		TagNamespaceGenre,
+		TagNamespaceTheme,
+		TagNamespaceDemographic,
+		TagNamespaceContentWarning,
+	}
+
+	DBTagSIDToID = func(sid TagSID) DBQueryValue {
+		return DBQueryValue{
+			Table:      DBTag,
+			Expression: DBGenericID,
+			ZeroValue:  0,
+			Conditions: map[string]any{
+				DBTagNamespace: sid.Namespace,
+				DBTagSlug:      sid.Slug,
+			},
+		}
+	}
+)
+
+type (
+	Tag struct {
+		ID        uint              `json:"id"`
+		Namespace string            `json:"namespace"`
+		Slug      string            `json:"slug"`
+		Names     map[string]string `json:"names"`
+		CreatedAt time.Time         `json:"createdAt"`
+		UpdatedAt *time.Time        `json:"updatedAt"`
+	}
+
+	AddTag struct {
+		Namespace string
+		Slug      string
+		Names     map[string]string
+	}
+
+	SetTag struct {
+		Namespace *string
+		Slug      *string
+		Names     map[string]string
+		SetNull   []string
+	}
+
+	TagSID struct {
+		Namespace string
+		Slug      string
+	}
+
+	TagFacet struct {
+		Tag
+		ComicCount int `json:"comicCount"`
+	}
+)
+
+func (m AddTag) Validate() error {
+	return (SetTag{
+		Namespace: &m.Namespace,
+		Slug:      &m.Slug,
+		Names:     m.Names,
+	}).Validate()
+}
+
+func (m SetTag) Validate() error {
+	if m.Namespace != nil {
+		if !slices.Contains(TagNamespaceAllow, *m.Namespace) {
+			return GenericError("namespace " + *m.Namespace + " is not recognized")
+		}
+	}
+
+	if m.Slug != nil {
+		if *m.Slug == "" {
+			return GenericError("slug cannot be empty")
+		}
+
+		if len(*m.Slug) > TagSlugMax {
+			max := strconv.FormatInt(TagSlugMax, 10)
+			return GenericError("slug must be at most " + max + " characters long")
+		}
+
+		if !utila.ValidSlug(*m.Slug) {
+			return GenericError("slug is not valid")
+		}
+	}
+
+	for ietf, name := range m.Names {
+		if err := (SetLanguage{IETF: &ietf}).Validate(); err != nil {
+			return GenericError("name language " + err.Error())
+		}
+
+		if name == "" {
+			return GenericError("name " + ietf + " cannot be empty")
+		}
+
+		if len(name) > TagNameMax {
+			max := strconv.FormatInt(TagNameMax, 10)
+			return GenericError("name " + ietf + " must be at most " + max + " characters long")
+		}
+	}
+
+	for _, key := range m.SetNull {
+		if !slices.Contains(TagSetNullAllow, key) {
+			return GenericError("set null " + key + " is not recognized")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	TrashPaginationDef    = 10
+	TrashPaginationMax    = 50
+	TrashKindLanguage     = "language"
+	TrashKindWebsite      = "website"
+	TrashKindLink         = "link"
+	TrashKindComic        = "comic"
+	TrashKindComicChapter = "comicChapter"
+	DBTrashKind           = "kind"
+)
+
+var (
+	TrashKindAllow = []string{
+		TrashKindLanguage,
+		TrashKindWebsite,
+		TrashKindLink,
+		TrashKindComic,
+		TrashKindComicChapter,
+	}
+)
+
+type (
+	// TrashItem is a row moved to the trash, along with the keys of its path
+	// that apply to the kind.
+	TrashItem struct {
+		Kind          string    `json:"kind"`
+		ID            uint      `json:"id"`
+		LanguageIETF  *string   `json:"languageIETF"`
+		WebsiteDomain *string   `json:"websiteDomain"`
+		RelativeURL   *string   `json:"relativeURL"`
+		ComicCode     *string   `json:"comicCode"`
+		Chapter       *string   `json:"chapter"`
+		Version       *string   `json:"version"`
+		DeletedAt     time.Time `json:"deletedAt"`
+	}
+
+	// DeleteImpact counts the rows by table name without schema that a
+	// delete moves to the trash along with the row itself, and those that go
+	// for good once it is purged.
+	DeleteImpact struct {
+		Trashed map[string]int
+		Purged  map[string]int
+	}
+
+	TrashParams struct {
+		Kinds      []string
+		Pagination *Pagination
+	}
+)
+
+func (m TrashParams) Validate() error {
+	for _, kind := range m.Kinds {
+		if !slices.Contains(TrashKindAllow, kind) {
+			return GenericError("kind " + kind + " is not recognized")
+		}
+	}
+
+	if m.Pagination != nil {
+		if err := m.Pagination.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+// DBGenericVersion is the version of a row in SQL.
+const DBGenericVersion = "COALESCE(" + DBGenericUpdatedAt + ", " + DBGenericCreatedAt + ")"
+
+type ctxIfMatch struct{}
+
+// Version is the time a row was last updated or else created, every update
+// gives the row a new one.
+func Version(createdAt time.Time, updatedAt *time.Time) time.Time {
+	if updatedAt != nil {
+		return *updatedAt
+	}
+	return createdAt
+}
+
+// ContextWithIfMatch makes the updates and deletes run with ctx only apply to
+// a row at one of versions, no versions match no row.
+func ContextWithIfMatch(ctx context.Context, versions []time.Time) context.Context {
+	return context.WithValue(ctx, ctxIfMatch{}, versions)
+}
+
+func IfMatchFromContext(ctx context.Context) ([]time.Time, bool) {
+	versions, ok := ctx.Value(ctxIfMatch{}).([]time.Time)
+	return versions, ok
+}
@@ -23,6 +23,7 @@ const (
 	DBWebsiteDomain      = "domain"
 	DBWebsiteName        = "name"
 	DBWebsiteMachineTL   = "machine_tl"
+	DBWebsiteDomainAlias = bagicore.ID + "." + "website_domain_alias"
 )
 
 var (
@@ -41,7 +42,10 @@ var (
 			Table:      DBWebsite,
 			Expression: DBGenericID,
 			ZeroValue:  0,
-			Conditions: DBConditionalKV{Key: DBWebsiteDomain, Value: domain},
+			Conditions: map[string]any{
+				DBWebsiteDomain:    domain,
+				DBGenericDeletedAt: DBIsNull{},
+			},
 		}
 	}
 )
@@ -55,6 +59,7 @@ type (
 		MachineTL   *bool       `json:"machineTL"`
 		CreatedAt   time.Time   `json:"createdAt"`
 		UpdatedAt   *time.Time  `json:"updatedAt"`
+		DeletedAt   *time.Time  `json:"deletedAt"`
 	}
 
 	AddWebsite struct {
@@ -131,6 +136,13 @@ var (
 	WebsiteTLLanguageOrderByAllow = []string{
 		DBLanguageGenericLanguageID,
 	}
+
+	DBWebsiteTranslated = func(ietf string) DBCrossConditional {
+		return DBCrossConditional{Table: DBWebsiteTLLanguage, Conditions: map[string]any{
+			DBWebsiteGenericWebsiteID:   DBColumnValue(DBWebsite + "." + DBGenericID),
+			DBLanguageGenericLanguageID: DBLanguageIETFToID(ietf),
+		}}
+	}
 )
 
 type (
@@ -2,28 +2,51 @@ package service
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/utila"
 )
 
 type (
 	Service struct {
 		database database
 		oauth    oauth
+		config   Config
+	}
+
+	Config struct {
+		ComicCode struct {
+			Alphabet string `conf:"alphabet"`
+			Mode     string `conf:"mode"`
+		} `conf:"comic_code"`
 	}
 
 	database interface {
+		WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+		WithSavepoint(ctx context.Context, fn func(ctx context.Context) error) error
+		DeleteImpact(ctx context.Context, t string, conds any) (*model.DeleteImpact, error)
+
 		AddLanguage(ctx context.Context, data model.AddLanguage, v *model.Language) error
 		GetLanguage(ctx context.Context, conds any) (*model.Language, error)
 		UpdateLanguage(ctx context.Context, data model.SetLanguage, conds any, v *model.Language) error
 		DeleteLanguage(ctx context.Context, conds any, v *model.Language) error
+		RestoreLanguage(ctx context.Context, conds any, v *model.Language) error
 		ListLanguage(ctx context.Context, params model.ListParams) ([]*model.Language, error)
 		CountLanguage(ctx context.Context, conds any) (int, error)
+		ExistsLanguage(ctx context.Context, conds any) (bool, error)
 
 		AddWebsite(ctx context.Context, data model.AddWebsite, v *model.Website) error
 		GetWebsite(ctx context.Context, conds any) (*model.Website, error)
 		UpdateWebsite(ctx context.Context, data model.SetWebsite, conds any, v *model.Website) error
 		DeleteWebsite(ctx context.Context, conds any, v *model.Website) error
+		RestoreWebsite(ctx context.Context, conds any, v *model.Website) error
+		AddWebsiteDomainAlias(ctx context.Context, domain string, websiteID uint) error
+		DeleteWebsiteDomainAlias(ctx context.Context, domain string) error
+		ResolveWebsiteDomainAlias(ctx context.Context, domain string) (string, error)
 		ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, error)
 		CountWebsite(ctx context.Context, conds any) (int, error)
 		ExistsWebsite(ctx context.Context, conds any) (bool, error)
@@ -35,42 +58,109 @@ type (
 		CountWebsiteTLLanguage(ctx context.Context, conds any) (int, error)
 
 		AddLink(ctx context.Context, data model.AddLink, v *model.Link) error
+		UpsertLink(ctx context.Context, data model.AddLink, v *model.Link) error
 		GetLink(ctx context.Context, conds any) (*model.Link, error)
 		UpdateLink(ctx context.Context, data model.SetLink, conds any, v *model.Link) error
 		DeleteLink(ctx context.Context, conds any, v *model.Link) error
+		RestoreLink(ctx context.Context, conds any, v *model.Link) error
 		ListLink(ctx context.Context, params model.ListParams) ([]*model.Link, error)
+		EmbedLinkTLLanguages(ctx context.Context, result []*model.Link, limit int) error
 		CountLink(ctx context.Context, conds any) (int, error)
 		ExistsLink(ctx context.Context, conds any) (bool, error)
 		AddLinkTLLanguage(ctx context.Context, data model.AddLinkTLLanguage, v *model.LinkTLLanguage) error
+		UpsertLinkTLLanguage(ctx context.Context, data model.AddLinkTLLanguage, v *model.LinkTLLanguage) error
 		GetLinkTLLanguage(ctx context.Context, conds any) (*model.LinkTLLanguage, error)
 		UpdateLinkTLLanguage(ctx context.Context, data model.SetLinkTLLanguage, conds any, v *model.LinkTLLanguage) error
 		DeleteLinkTLLanguage(ctx context.Context, conds any, v *model.LinkTLLanguage) error
 		ListLinkTLLanguage(ctx context.Context, params model.ListParams) ([]*model.LinkTLLanguage, error)
 		CountLinkTLLanguage(ctx context.Context, conds any) (int, error)
 
+		AddCreator(ctx context.Context, data model.AddCreator, v *model.Creator) error
+		GetCreator(ctx context.Context, conds any) (*model.Creator, error)
+		UpdateCreator(ctx context.Context, data model.SetCreator, conds any, v *model.Creator) error
+		DeleteCreator(ctx context.Context, conds any, v *model.Creator) error
+		ListCreator(ctx context.Context, params model.ListParams) ([]*model.Creator, error)
+		CountCreator(ctx context.Context, conds any) (int, error)
+		ExistsCreator(ctx context.Context, conds any) (bool, error)
+		AddCreatorLink(ctx context.Context, data model.AddCreatorLink, v *model.CreatorLink) error
+		GetCreatorLink(ctx context.Context, conds any) (*model.CreatorLink, error)
+		UpdateCreatorLink(ctx context.Context, data model.SetCreatorLink, conds any, v *model.CreatorLink) error
+		DeleteCreatorLink(ctx context.Context, conds any, v *model.CreatorLink) error
+		ListCreatorLink(ctx context.Context, params model.ListParams) ([]*model.CreatorLink, error)
+		CountCreatorLink(ctx context.Context, conds any) (int, error)
+
+		AddTag(ctx context.Context, data model.AddTag, v *model.Tag) error
+		GetTag(ctx context.Context, conds any) (*model.Tag, error)
+		UpdateTag(ctx context.Context, data model.SetTag, conds any, v *model.Tag) error
+		DeleteTag(ctx context.Context, conds any, v *model.Tag) error
+		ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, error)
+		CountTag(ctx context.Context, conds any) (int, error)
+		ListTagFacet(ctx context.Context, comicConds any, params model.ListParams) ([]*model.TagFacet, error)
+		CountTagFacet(ctx context.Context, comicConds any, conds any) (int, error)
+
+		Search(ctx context.Context, query string, params model.ListParams) ([]*model.SearchResult, error)
+		CountSearch(ctx context.Context, query string, conds any) (int, error)
+
+		ListTrash(ctx context.Context, params model.ListParams) ([]*model.TrashItem, error)
+		CountTrash(ctx context.Context, conds any) (int, error)
+
+		AddAuditEvent(ctx context.Context, data model.AddAuditEvent) error
+		AddAuditEvents(ctx context.Context, data []model.AddAuditEvent) error
+		ListAuditEvent(ctx context.Context, params model.ListParams) ([]*model.AuditEvent, error)
+		CountAuditEvent(ctx context.Context, conds any) (int, error)
+
 		// Comic
 		AddComic(ctx context.Context, data model.AddComic, v *model.Comic) error
 		GetComic(ctx context.Context, conds any) (*model.Comic, error)
 		UpdateComic(ctx context.Context, data model.SetComic, conds any, v *model.Comic) error
 		DeleteComic(ctx context.Context, conds any, v *model.Comic) error
+		RestoreComic(ctx context.Context, conds any, v *model.Comic) error
+		AddComicCodeAlias(ctx context.Context, code string, comicID uint) error
+		DeleteComicCodeAlias(ctx context.Context, code string) error
+		ResolveComicCodeAlias(ctx context.Context, code string) (string, error)
 		ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, error)
+		EmbedComic(ctx context.Context, result []*model.Comic, include model.ComicInclude) error
 		CountComic(ctx context.Context, conds any) (int, error)
 		ExistsComic(ctx context.Context, conds any) (bool, error)
+		AddComicTitle(ctx context.Context, data model.AddComicTitle, v *model.ComicTitle) error
+		GetComicTitle(ctx context.Context, conds any) (*model.ComicTitle, error)
+		UpdateComicTitle(ctx context.Context, data model.SetComicTitle, conds any, v *model.ComicTitle) error
+		DeleteComicTitle(ctx context.Context, conds any, v *model.ComicTitle) error
+		ListComicTitle(ctx context.Context, params model.ListParams) ([]*model.ComicTitle, error)
+		CountComicTitle(ctx context.Context, conds any) (int, error)
 		AddComicLink(ctx context.Context, data model.AddComicLink, v *model.ComicLink) error
+		UpsertComicLink(ctx context.Context, data model.AddComicLink, v *model.ComicLink) error
 		GetComicLink(ctx context.Context, conds any) (*model.ComicLink, error)
 		UpdateComicLink(ctx context.Context, data model.SetComicLink, conds any, v *model.ComicLink) error
 		DeleteComicLink(ctx context.Context, params any, v *model.ComicLink) error
 		ListComicLink(ctx context.Context, params model.ListParams) ([]*model.ComicLink, error)
 		CountComicLink(ctx context.Context, conds any) (int, error)
+		AddComicCreator(ctx context.Context, data model.AddComicCreator, v *model.ComicCreator) error
+		GetComicCreator(ctx context.Context, conds any) (*model.ComicCreator, error)
+		UpdateComicCreator(ctx context.Context, data model.SetComicCreator, conds any, v *model.ComicCreator) error
+		DeleteComicCreator(ctx context.Context, conds any, v *model.ComicCreator) error
+		ListComicCreator(ctx context.Context, params model.ListParams) ([]*model.ComicCreator, error)
+		CountComicCreator(ctx context.Context, conds any) (int, error)
+		AddComicTag(ctx context.Context, data model.AddComicTag, v *model.ComicTag) error
+		GetComicTag(ctx context.Context, conds any) (*model.ComicTag, error)
+		UpdateComicTag(ctx context.Context, data model.SetComicTag, conds any, v *model.ComicTag) error
+		DeleteComicTag(ctx context.Context, conds any, v *model.ComicTag) error
+		ListComicTag(ctx context.Context, params model.ListParams) ([]*model.ComicTag, error)
+		CountComicTag(ctx context.Context, conds any) (int, error)
 		// Comic Chapter
 		AddComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) error
+		AddComicChapters(ctx context.Context, data []model.AddComicChapter, v *[]*model.ComicChapter) error
+		UpsertComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) error
 		GetComicChapter(ctx context.Context, conds any) (*model.ComicChapter, error)
 		UpdateComicChapter(ctx context.Context, data model.SetComicChapter, conds any, v *model.ComicChapter) error
 		DeleteComicChapter(ctx context.Context, conds any, v *model.ComicChapter) error
+		RestoreComicChapter(ctx context.Context, conds any, v *model.ComicChapter) error
 		ListComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, error)
+		EmbedComicChapterLinks(ctx context.Context, result []*model.ComicChapter, limit int) error
 		CountComicChapter(ctx context.Context, conds any) (int, error)
 		ExistsComicChapter(ctx context.Context, conds any) (bool, error)
 		AddComicChapterLink(ctx context.Context, data model.AddComicChapterLink, v *model.ComicChapterLink) error
+		UpsertComicChapterLink(ctx context.Context, data model.AddComicChapterLink, v *model.ComicChapterLink) error
 		GetComicChapterLink(ctx context.Context, conds any) (*model.ComicChapterLink, error)
 		UpdateComicChapterLink(ctx context.Context, data model.SetComicChapterLink, conds any, v *model.ComicChapterLink) error
 		DeleteComicChapterLink(ctx context.Context, conds any, v *model.ComicChapterLink) error
@@ -81,9 +171,134 @@ type (
 	oauth interface {
 		HasPermissionContext(ctx context.Context, permission string) bool
 		TokenPermissionKey(s ...string) string
+		TokenSubjectContext(ctx context.Context) string
 	}
 )
 
-func New(db database, oa oauth) Service {
-	return Service{database: db, oauth: oa}
+func New(db database, oa oauth, cfg Config) (Service, error) {
+	if cfg.ComicCode.Alphabet == "" {
+		cfg.ComicCode.Alphabet = model.ComicCodeAlphabetDef
+	}
+	if len(cfg.ComicCode.Alphabet) < 2 {
+		return Service{}, errors.New("comic code alphabet must be at least 2 characters")
+	}
+	if len(cfg.ComicCode.Alphabet) > utila.HashStringCharsetMax {
+		max := strconv.Itoa(utila.HashStringCharsetMax)
+		return Service{}, errors.New("comic code alphabet must be at most " + max + " characters")
+	}
+	for _, c := range cfg.ComicCode.Alphabet {
+		if !strings.ContainsRune(model.ComicCodeAlphabetAllow, c) {
+			return Service{}, errors.New("comic code alphabet character " + string(c) + " is not url safe")
+		}
+	}
+
+	if cfg.ComicCode.Mode == "" {
+		cfg.ComicCode.Mode = model.ComicCodeModeRandom
+	}
+	if !slices.Contains(model.ComicCodeModeAllow, cfg.ComicCode.Mode) {
+		return Service{}, errors.New("comic code mode " + cfg.ComicCode.Mode + " is not recognized")
+	}
+
+	return Service{database: db, oauth: oa, config: cfg}, nil
+}
+
+// ifMatch runs fn with conds narrowed to the versions of If-Match in ctx if
+// any. The versions are only checked by fn, so a write committed in between
+// cannot slip past them. When fn finds no row the precondition fails if the
+// row is there at another version, else fn stays not found.
+func (svc Service) ifMatch(
+	ctx context.Context,
+	exists func(ctx context.Context, conds any) (bool, error),
+	conds any,
+	fn func(ctx context.Context, conds any) error,
+) error {
+	versions, ok := model.IfMatchFromContext(ctx)
+	if !ok {
+		return fn(ctx, conds)
+	}
+
+	matched := []any{
+		model.DBLogicalAND{},
+		conds,
+		model.DBConditionalKV{Key: model.DBGenericVersion, Value: model.DBIn{Values: versions}},
+	}
+	return svc.database.WithTx(ctx, func(ctx context.Context) error {
+		err := fn(ctx, matched)
+		if !errors.As(err, &model.ErrNotFound) {
+			return err
+		}
+
+		// An update that changes nothing finds no row either, the row is
+		// still at one of the versions then.
+		match, err0 := exists(ctx, matched)
+		if err0 != nil {
+			return err0
+		}
+		if match {
+			return err
+		}
+		found, err0 := exists(ctx, conds)
+		if err0 != nil {
+			return err0
+		}
+		if found {
+			return model.PreconditionError("version does not match")
+		}
+		return err
+	})
+}
+
+// audit records the mutation fn makes to a row of the catalog as an event in
+// the same transaction. fn always gets a row to fill, the row an add, update
+// or restore leaves and the row a delete takes away. get reads the row ahead
+// of an update, an update that finds none is an add done by an upsert. A
+// mutation that finds no row to work on is not found.
+func audit[T any, PT interface {
+	*T
+	model.Audited
+}](
+	ctx context.Context,
+	svc Service,
+	entity, operation string,
+	get func(ctx context.Context) (PT, error),
+	fn func(ctx context.Context, v PT) error,
+	v PT,
+) error {
+	return svc.database.WithTx(ctx, func(ctx context.Context) error {
+		event := model.AddAuditEvent{Operation: operation, Entity: entity}
+		if subject := svc.oauth.TokenSubjectContext(ctx); subject != "" {
+			event.Actor = &subject
+		}
+
+		if get != nil {
+			before, err := get(ctx)
+			switch {
+			case err == nil:
+				event.Before = before
+			case errors.As(err, &model.ErrNotFound):
+				if event.Operation == model.AuditOperationUpdate {
+					event.Operation = model.AuditOperationAdd
+				}
+			default:
+				return err
+			}
+		}
+
+		result := v
+		if result == nil {
+			result = new(T)
+		}
+		if err := fn(ctx, result); err != nil {
+			return err
+		}
+
+		switch event.Operation {
+		case model.AuditOperationDelete:
+			event.Before = result
+		default:
+			event.After = result
+		}
+		event.EntitySID, event.ComicID = result.AuditSID(), result.AuditComicID()
+		return svc.database.AddAuditEvent(ctx, event)
+	})
 }
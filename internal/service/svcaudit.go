@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func (svc Service) ListAuditEvent(ctx context.Context, params model.AuditParams) ([]*model.AuditEvent, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return nil, model.GenericError("missing admin permission to list audit event")
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.AuditPaginationMax {
+			pagination.Limit = model.AuditPaginationMax
+		}
+	}
+
+	return svc.database.ListAuditEvent(ctx, model.ListParams{
+		Conditions: auditConditions(params),
+		Pagination: params.Pagination,
+	})
+}
+
+func (svc Service) CountAuditEvent(ctx context.Context, params model.AuditParams) (int, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return -1, model.GenericError("missing admin permission to count audit event")
+	}
+
+	if err := params.Validate(); err != nil {
+		return -1, err
+	}
+
+	return svc.database.CountAuditEvent(ctx, auditConditions(params))
+}
+
+func auditConditions(params model.AuditParams) any {
+	conds := map[string]any{}
+	if params.Entity != nil {
+		conds[model.DBAuditEventEntity] = params.Entity
+	}
+	if params.Operation != nil {
+		conds[model.DBAuditEventOperation] = params.Operation
+	}
+	if params.Actor != nil {
+		conds[model.DBAuditEventActor] = params.Actor
+	}
+	if params.ComicCode != nil {
+		conds[model.DBComicGenericComicID] = model.DBAuditComicCodeToID(*params.ComicCode)
+	}
+	if len(conds) < 1 {
+		return nil
+	}
+	return conds
+}
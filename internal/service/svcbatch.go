@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func (svc Service) RunBatch(ctx context.Context, data model.Batch) ([]*model.BatchResult, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return nil, model.GenericError("missing admin permission to run batch")
+	}
+
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.BatchResult, 0, len(data.Operations))
+	for range data.Operations {
+		result = append(result, &model.BatchResult{Status: model.BatchStatusAborted})
+	}
+
+	if data.Mode == model.BatchModeBestEffort {
+		for i := 0; i < len(data.Operations); {
+			// A run of comic chapter adds goes in at once, one by one only
+			// when that fails so each gets its own status.
+			n := batchComicChapterRun(data.Operations[i:])
+			if n > 1 {
+				if err := svc.AddComicChapters(ctx, batchComicChapterData(data.Operations[i:i+n])); err == nil {
+					for _, r := range result[i : i+n] {
+						r.Status = model.BatchStatusDone
+					}
+					i += n
+					continue
+				}
+			}
+			for end := i + max(n, 1); i < end; i++ {
+				if err := svc.database.WithTx(ctx, func(ctx context.Context) error {
+					return svc.runBatchOperation(ctx, data.Operations[i])
+				}); err != nil {
+					result[i].Status, result[i].Err = model.BatchStatusFailed, err
+					continue
+				}
+				result[i].Status = model.BatchStatusDone
+			}
+		}
+		return result, nil
+	}
+
+	failed := false
+	if err := svc.database.WithTx(ctx, func(ctx context.Context) error {
+		failed = false
+		for _, r := range result {
+			r.Status, r.Err = model.BatchStatusAborted, nil
+		}
+		for i := 0; i < len(data.Operations); {
+			// A run of comic chapter adds goes in at once and fails as a
+			// whole.
+			if n := batchComicChapterRun(data.Operations[i:]); n > 1 {
+				if err := svc.AddComicChapters(ctx, batchComicChapterData(data.Operations[i:i+n])); err != nil {
+					for _, r := range result[i : i+n] {
+						r.Status, r.Err = model.BatchStatusFailed, err
+					}
+					failed = true
+					return err
+				}
+				for _, r := range result[i : i+n] {
+					r.Status = model.BatchStatusDone
+				}
+				i += n
+				continue
+			}
+			if err := svc.runBatchOperation(ctx, data.Operations[i]); err != nil {
+				result[i].Status, result[i].Err = model.BatchStatusFailed, err
+				failed = true
+				return err
+			}
+			result[i].Status = model.BatchStatusDone
+			i++
+		}
+		return nil
+	}); err != nil {
+		if !failed {
+			return nil, err
+		}
+		for _, r := range result {
+			if r.Status == model.BatchStatusDone {
+				r.Status = model.BatchStatusAborted
+			}
+		}
+	}
+	return result, nil
+}
+
+// runBatchOperation runs the operation, a delete of what does not exist fails
+// with the not found error so it is never told as done.
+func (svc Service) runBatchOperation(ctx context.Context, operation model.BatchOperation) error {
+	switch operation.Action {
+	case model.BatchActionAddLink:
+		return svc.AddLink(ctx, *operation.AddLink, nil)
+	case model.BatchActionDeleteLink:
+		return svc.DeleteLinkBySID(ctx, *operation.LinkSID)
+	case model.BatchActionAddComicChapter:
+		return svc.AddComicChapter(ctx, *operation.AddComicChapter, nil)
+	case model.BatchActionDeleteComicChapter:
+		return svc.DeleteComicChapterBySID(ctx, *operation.ComicChapterSID)
+	}
+	return model.GenericError("action " + operation.Action + " is not recognized")
+}
+
+// batchComicChapterRun counts the comic chapter adds the operations start with.
+func batchComicChapterRun(operations []model.BatchOperation) int {
+	for i, operation := range operations {
+		if operation.Action != model.BatchActionAddComicChapter {
+			return i
+		}
+	}
+	return len(operations)
+}
+
+func batchComicChapterData(operations []model.BatchOperation) []model.AddComicChapter {
+	data := make([]model.AddComicChapter, 0, len(operations))
+	for _, operation := range operations {
+		data = append(data, *operation.AddComicChapter)
+	}
+	return data
+}
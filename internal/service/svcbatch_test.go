@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+// testBatchDatabase has no links, deleting one is not found. Comic chapters
+// added in bulk are kept apart from those added one by one, bulk adds fail
+// when failBulk is set.
+type testBatchDatabase struct {
+	database
+	failBulk bool
+	bulk     [][]model.AddComicChapter
+	single   []model.AddComicChapter
+}
+
+func (db *testBatchDatabase) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (db *testBatchDatabase) AddAuditEvent(ctx context.Context, data model.AddAuditEvent) error {
+	return nil
+}
+
+func (db *testBatchDatabase) AddAuditEvents(ctx context.Context, data []model.AddAuditEvent) error {
+	return nil
+}
+
+func (db *testBatchDatabase) AddComicChapters(ctx context.Context, data []model.AddComicChapter, v *[]*model.ComicChapter) error {
+	if db.failBulk {
+		return model.GenericError("same comic id + chapter + version already exists")
+	}
+	db.bulk = append(db.bulk, data)
+	for _, data := range data {
+		*v = append(*v, &model.ComicChapter{ComicCode: *data.ComicCode, Chapter: data.Chapter})
+	}
+	return nil
+}
+
+func (db *testBatchDatabase) AddComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) error {
+	db.single = append(db.single, data)
+	return nil
+}
+
+func (db *testBatchDatabase) EmbedComicChapterLinks(ctx context.Context, result []*model.ComicChapter, limit int) error {
+	return nil
+}
+
+func (db *testBatchDatabase) DeleteLink(ctx context.Context, conds any, v *model.Link) error {
+	return model.NotFoundError(errors.New("no link"))
+}
+
+func TestRunBatchDeleteNotFound(t *testing.T) {
+	svc, err := New(&testBatchDatabase{}, testOAuth{}, Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := "example.com"
+	operations := []model.BatchOperation{{
+		Action:  model.BatchActionDeleteLink,
+		LinkSID: &model.LinkSID{WebsiteDomain: &domain, RelativeURL: "/missing"},
+	}}
+
+	for _, mode := range model.BatchModeAllow {
+		result, err := svc.RunBatch(context.Background(), model.Batch{Mode: mode, Operations: operations})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result[0].Status != model.BatchStatusFailed {
+			t.Errorf("%s delete of a missing link status = %s, want %s", mode, result[0].Status, model.BatchStatusFailed)
+		}
+		if !errors.As(result[0].Err, &model.ErrNotFound) {
+			t.Errorf("%s delete of a missing link error = %v, want not found", mode, result[0].Err)
+		}
+	}
+}
+
+func TestRunBatchAddComicChapters(t *testing.T) {
+	code := "op000001"
+	operations := []model.BatchOperation{}
+	for _, chapter := range []string{"1", "2", "3"} {
+		operations = append(operations, model.BatchOperation{
+			Action:          model.BatchActionAddComicChapter,
+			AddComicChapter: &model.AddComicChapter{ComicCode: &code, Chapter: chapter},
+		})
+	}
+
+	tests := []struct {
+		mode     string
+		failBulk bool
+		bulk     int
+		single   int
+	}{
+		{model.BatchModeAtomic, false, 1, 0},
+		{model.BatchModeBestEffort, false, 1, 0},
+		{model.BatchModeBestEffort, true, 0, 3},
+	}
+	for _, tt := range tests {
+		db := &testBatchDatabase{failBulk: tt.failBulk}
+		svc, err := New(db, testOAuth{}, Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := svc.RunBatch(context.Background(), model.Batch{Mode: tt.mode, Operations: operations})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(db.bulk) != tt.bulk || len(db.single) != tt.single {
+			t.Errorf("%s (failBulk %t) added %d in bulk and %d one by one, want %d and %d",
+				tt.mode, tt.failBulk, len(db.bulk), len(db.single), tt.bulk, tt.single)
+		}
+		for i, r := range result {
+			if r.Status != model.BatchStatusDone {
+				t.Errorf("%s (failBulk %t) operation %d status = %s (%v), want %s",
+					tt.mode, tt.failBulk, i, r.Status, r.Err, model.BatchStatusDone)
+			}
+		}
+	}
+}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"slices"
+	"strconv"
 
 	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
-	"golang.org/x/sync/errgroup"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/utila"
 )
 
 //
@@ -17,165 +19,664 @@ func (svc Service) AddComic(ctx context.Context, data model.AddComic, v *model.C
 		return model.GenericError("missing admin permission to add comic")
 	}
 
-	if err := data.Validate(); err != nil {
+	if data.Code != "" {
+		if err := data.Validate(); err != nil {
+			return err
+		}
+
+		return svc.addComic(ctx, data, v)
+	}
+
+	for try := 0; ; try++ {
+		data.Code = svc.comicCode(data, try)
+		if err := data.Validate(); err != nil {
+			return err
+		}
+
+		taken, err := svc.comicCodeTaken(ctx, data.Code)
+		if err != nil {
+			return err
+		}
+		if taken && try < model.ComicCodeTriesMax {
+			continue
+		}
+
+		// The code may be taken in between, inside an outer transaction the
+		// failed try must not abort it for the next one.
+		err = svc.database.WithSavepoint(ctx, func(ctx context.Context) error {
+			return svc.addComic(ctx, data, v)
+		})
+		if errors.Is(err, model.ErrComicCodeExists) && try < model.ComicCodeTriesMax {
+			continue
+		}
 		return err
 	}
+}
 
-	if v != nil {
-		v.Links = []*model.Link{}
-		v.Chapters = []*model.ComicChapter{}
+// comicCode generates the code of the comic data is to be added as, try is
+// the number of codes generated already that were taken.
+func (svc Service) comicCode(data model.AddComic, try int) string {
+	cfg := svc.config.ComicCode
+	if cfg.Mode != model.ComicCodeModeHash || data.Title == nil {
+		return utila.RandomString(cfg.Alphabet, model.ComicCodeLength)
 	}
 
-	return svc.database.AddComic(ctx, data, v)
+	seed := *data.Title
+	if try > 0 {
+		seed += "#" + strconv.Itoa(try)
+	}
+	return utila.HashString(cfg.Alphabet, model.ComicCodeLength, seed)
 }
 
-func (svc Service) GetComicByCode(ctx context.Context, code string) (*model.Comic, error) {
-	result, err := svc.database.GetComic(ctx, model.DBConditionalKV{
+// comicCodeTaken tells whether code is the code of a comic or a former one.
+func (svc Service) comicCodeTaken(ctx context.Context, code string) (bool, error) {
+	exists, err := svc.database.ExistsComic(ctx, model.DBConditionalKV{
 		Key:   model.DBComicCode,
 		Value: code,
 	})
+	if err != nil || exists {
+		return exists, err
+	}
+
+	current, err := svc.ResolveComicCodeAlias(ctx, code)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
+	return current != "", nil
+}
 
-	g, gctx := errgroup.WithContext(ctx)
-	g.Go(func() error {
-		links0, err := svc.listComicLink(ctx, model.ListParams{
-			Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: result.ID},
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
+func (svc Service) addComic(ctx context.Context, data model.AddComic, v *model.Comic) error {
+	return audit(ctx, svc, model.AuditEntityComic, model.AuditOperationAdd, nil, func(ctx context.Context, result *model.Comic) error {
+		if err := svc.database.AddComic(ctx, data, result); err != nil {
 			return err
 		}
 
-		if len(links0) == 0 {
-			result.Links = []*model.Link{}
-			return nil
+		// Links given twice, in whatever form, come out as the same link and
+		// are only added once.
+		linkIDs := map[uint]bool{}
+		for _, link := range data.Links {
+			link0 := new(model.Link)
+			if err := svc.database.UpsertLink(ctx, link, link0); err != nil {
+				return err
+			}
+			if linkIDs[link0.ID] {
+				continue
+			}
+			linkIDs[link0.ID] = true
+			if err := svc.database.AddComicLink(ctx, model.AddComicLink{
+				ComicID: &result.ID,
+				LinkID:  &link0.ID,
+			}, nil); err != nil {
+				return err
+			}
 		}
 
-		conditions := make([]any, len(links0)+2)
-		conditions = append(conditions, model.DBLogicalOR{})
-		for _, link := range links0 {
-			conditions = append(conditions, model.DBConditionalKV{
-				Key:   model.DBGenericID,
-				Value: link.LinkID,
-			})
+		for _, chapter := range data.Chapters {
+			chapter.ComicID, chapter.ComicCode = &result.ID, nil
+			if err := svc.addComicChapter(ctx, chapter, nil); err != nil {
+				return err
+			}
 		}
 
-		links1, err := svc.database.ListLink(ctx, model.ListParams{
-			Conditions: conditions,
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return err
+		if v != nil {
+			if err := svc.database.EmbedComic(ctx, []*model.Comic{v}, model.ComicIncludeAll); err != nil {
+				return err
+			}
 		}
 
-		result.Links = links1
 		return nil
+	}, v)
+}
+
+func (svc Service) GetComicByCode(ctx context.Context, code string, include model.ComicInclude) (*model.Comic, error) {
+	if err := include.Validate(); err != nil {
+		return nil, err
+	}
+
+	result, err := svc.database.GetComic(ctx, model.DBConditionalKV{
+		Key:   model.DBComicCode,
+		Value: code,
 	})
-	g.Go(func() error {
-		chapters, err := svc.listComicChapter(gctx, model.ListParams{
-			Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: result.ID},
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.database.EmbedComic(ctx, []*model.Comic{result}, include); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (svc Service) UpdateComicByCode(ctx context.Context, code string, data model.SetComic, include model.ComicInclude, v *model.Comic) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to update comic")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	if err := include.Validate(); err != nil {
+		return err
+	}
+
+	return svc.database.WithTx(ctx, func(ctx context.Context) error {
+		if err := svc.ifMatch(ctx, svc.database.ExistsComic, model.DBConditionalKV{
+			Key:   model.DBComicCode,
+			Value: code,
+		}, func(ctx context.Context, conds any) error {
+			return audit(ctx, svc, model.AuditEntityComic, model.AuditOperationUpdate, func(ctx context.Context) (*model.Comic, error) {
+				return svc.database.GetComic(ctx, conds)
+			}, func(ctx context.Context, v *model.Comic) error {
+				if err := svc.database.UpdateComic(ctx, data, conds, v); err != nil {
+					return err
+				}
+
+				if v.Code == code {
+					return nil
+				}
+				if err := svc.database.AddComicCodeAlias(ctx, code, v.ID); err != nil {
+					return err
+				}
+				return svc.database.DeleteComicCodeAlias(ctx, v.Code)
+			}, v)
+		}); err != nil {
 			return err
 		}
 
-		result.Chapters = chapters
+		if v != nil {
+			if err := svc.database.EmbedComic(ctx, []*model.Comic{v}, include); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
-	if err := g.Wait(); err != nil {
+}
+
+func (svc Service) DeleteComicByCode(ctx context.Context, code string) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to delete comic")
+	}
+
+	return svc.ifMatch(ctx, svc.database.ExistsComic, model.DBConditionalKV{
+		Key:   model.DBComicCode,
+		Value: code,
+	}, func(ctx context.Context, conds any) error {
+		return audit(ctx, svc, model.AuditEntityComic, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.Comic) error {
+			return svc.database.DeleteComic(ctx, conds, v)
+		}, nil)
+	})
+}
+
+func (svc Service) RestoreComicByCode(ctx context.Context, code string) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to restore comic")
+	}
+
+	return audit(ctx, svc, model.AuditEntityComic, model.AuditOperationRestore, nil, func(ctx context.Context, v *model.Comic) error {
+		return svc.database.RestoreComic(ctx, model.DBConditionalKV{
+			Key:   model.DBComicCode,
+			Value: code,
+		}, v)
+	}, nil)
+}
+
+// ResolveComicCodeAlias gives the code of the comic that used to go by code, it is empty
+// when code is not a former code of any comic.
+func (svc Service) ResolveComicCodeAlias(ctx context.Context, code string) (string, error) {
+	result, err := svc.database.ResolveComicCodeAlias(ctx, code)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return result, nil
+}
+
+func (svc Service) ListComic(ctx context.Context, params model.ListParams, include model.ComicInclude) ([]*model.Comic, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := include.Validate(); err != nil {
+		return nil, err
+	}
+
+	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
+		switch field := ob.Field.(type) {
+		case string:
+			return !slices.Contains(model.ComicOrderByAllow, field)
+		}
+		return true
+	})
+	if len(params.OrderBys) > model.ComicOrderBysMax {
+		params.OrderBys = params.OrderBys[:model.ComicOrderBysMax]
+	}
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.ComicPaginationMax {
+			pagination.Limit = model.ComicPaginationMax
+		}
+	}
+
+	result, err := svc.database.ListComic(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.database.EmbedComic(ctx, result, include); err != nil {
 		return nil, err
 	}
 
 	return result, nil
 }
 
-func (svc Service) UpdateComicByCode(ctx context.Context, code string, data model.SetComic, v *model.Comic) error {
+func (svc Service) CountComic(ctx context.Context, conds any) (int, error) {
+	return svc.database.CountComic(ctx, conds)
+}
+
+func (svc Service) ExistsComicByCode(ctx context.Context, code string) (bool, error) {
+	return svc.database.ExistsComic(ctx, model.DBConditionalKV{
+		Key:   model.DBComicCode,
+		Value: code,
+	})
+}
+
+// Comic Title
+
+func (svc Service) AddComicTitle(ctx context.Context, data model.AddComicTitle, v *model.ComicTitle) error {
 	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic")
+		return model.GenericError("missing admin permission to add comic title")
+	}
+
+	if data.RID == "" {
+		data.RID = utila.RandomString(utila.RandomStringGeneral, model.ComicTitleRIDLength)
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	if err := svc.database.UpdateComic(ctx, data, model.DBConditionalKV{
-		Key:   model.DBComicCode,
-		Value: code,
+	return audit(ctx, svc, model.AuditEntityComicTitle, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.ComicTitle) error {
+		return svc.database.AddComicTitle(ctx, data, v)
+	}, v)
+}
+
+func (svc Service) GetComicTitleBySID(ctx context.Context, sid model.ComicTitleSID) (*model.ComicTitle, error) {
+	var comicID any
+	switch {
+	case sid.ComicID != nil:
+		comicID = sid.ComicID
+	case sid.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*sid.ComicCode)
+	}
+	result, err := svc.database.GetComicTitle(ctx, map[string]any{
+		model.DBComicGenericComicID: comicID,
+		model.DBComicTitleRID:       sid.RID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (svc Service) UpdateComicTitleBySID(ctx context.Context, sid model.ComicTitleSID, data model.SetComicTitle, v *model.ComicTitle) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to update comic title")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	var comicID any
+	switch {
+	case sid.ComicID != nil:
+		comicID = sid.ComicID
+	case sid.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*sid.ComicCode)
+	}
+	conds := map[string]any{
+		model.DBComicGenericComicID: comicID,
+		model.DBComicTitleRID:       sid.RID,
+	}
+	if err := audit(ctx, svc, model.AuditEntityComicTitle, model.AuditOperationUpdate, func(ctx context.Context) (*model.ComicTitle, error) {
+		return svc.database.GetComicTitle(ctx, conds)
+	}, func(ctx context.Context, v *model.ComicTitle) error {
+		return svc.database.UpdateComicTitle(ctx, data, conds, v)
 	}, v); err != nil {
 		return err
 	}
 
-	if v != nil {
-		g, gctx := errgroup.WithContext(ctx)
-		g.Go(func() error {
-			links0, err := svc.listComicLink(ctx, model.ListParams{
-				Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: v.ID},
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
+	return nil
+}
 
-			if len(links0) == 0 {
-				v.Links = []*model.Link{}
-				return nil
-			}
+func (svc Service) DeleteComicTitleBySID(ctx context.Context, sid model.ComicTitleSID) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to delete comic title")
+	}
 
-			conditions := make([]any, len(links0)+2)
-			conditions = append(conditions, model.DBLogicalOR{})
-			for _, link := range links0 {
-				conditions = append(conditions, model.DBConditionalKV{
-					Key:   model.DBGenericID,
-					Value: link.LinkID,
-				})
-			}
+	var comicID any
+	switch {
+	case sid.ComicID != nil:
+		comicID = sid.ComicID
+	case sid.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*sid.ComicCode)
+	}
+	return audit(ctx, svc, model.AuditEntityComicTitle, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.ComicTitle) error {
+		return svc.database.DeleteComicTitle(ctx, map[string]any{
+			model.DBComicGenericComicID: comicID,
+			model.DBComicTitleRID:       sid.RID,
+		}, v)
+	}, nil)
+}
 
-			links1, err := svc.database.ListLink(ctx, model.ListParams{
-				Conditions: conditions,
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
+func (svc Service) ListComicTitle(ctx context.Context, params model.ListParams) ([]*model.ComicTitle, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
 
-			v.Links = links1
-			return nil
-		})
-		g.Go(func() error {
-			chapters, err := svc.listComicChapter(gctx, model.ListParams{
-				Conditions: model.DBConditionalKV{Key: model.DBComicGenericComicID, Value: v.ID},
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
+	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
+		switch field := ob.Field.(type) {
+		case string:
+			return !slices.Contains(model.ComicTitleOrderByAllow, field)
+		}
+		return true
+	})
+	if len(params.OrderBys) > model.ComicTitleOrderBysMax {
+		params.OrderBys = params.OrderBys[:model.ComicTitleOrderBysMax]
+	}
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.ComicTitlePaginationMax {
+			pagination.Limit = model.ComicTitlePaginationMax
+		}
+	}
 
-			v.Chapters = chapters
-			return nil
-		})
-		if err := g.Wait(); err != nil {
-			return err
+	return svc.database.ListComicTitle(ctx, params)
+}
+
+func (svc Service) CountComicTitle(ctx context.Context, conds any) (int, error) {
+	return svc.database.CountComicTitle(ctx, conds)
+}
+
+// Comic Link
+
+func (svc Service) AddComicLink(ctx context.Context, data model.AddComicLink, v *model.ComicLink) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to add comic link")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return audit(ctx, svc, model.AuditEntityComicLink, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.ComicLink) error {
+		return svc.database.AddComicLink(ctx, data, v)
+	}, v)
+}
+
+// UpsertComicLink adds the comic link or gets the existing one as it is, it
+// reports whether the comic link was created.
+func (svc Service) UpsertComicLink(ctx context.Context, data model.AddComicLink, v *model.ComicLink) (bool, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return false, model.GenericError("missing admin permission to upsert comic link")
+	}
+
+	if err := data.Validate(); err != nil {
+		return false, err
+	}
+
+	result := v
+	if result == nil {
+		result = new(model.ComicLink)
+	}
+	created := false
+	if err := audit(ctx, svc, model.AuditEntityComicLink, model.AuditOperationUpdate, func(ctx context.Context) (*model.ComicLink, error) {
+		before, err := svc.GetComicLinkBySID(ctx, model.ComicLinkSID{ComicID: data.ComicID, ComicCode: data.ComicCode, LinkID: data.LinkID, LinkSID: data.LinkSID})
+		created = errors.As(err, &model.ErrNotFound)
+		return before, err
+	}, func(ctx context.Context, v *model.ComicLink) error {
+		return svc.database.UpsertComicLink(ctx, data, v)
+	}, result); err != nil {
+		return false, err
+	}
+
+	return created, nil
+}
+
+func (svc Service) GetComicLinkBySID(ctx context.Context, sid model.ComicLinkSID) (*model.ComicLink, error) {
+	var comicID any
+	switch {
+	case sid.ComicID != nil:
+		comicID = sid.ComicID
+	case sid.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*sid.ComicCode)
+	}
+	var linkID any
+	switch {
+	case sid.LinkID != nil:
+		linkID = sid.LinkID
+	case sid.LinkSID != nil:
+		linkID = model.DBLinkSIDToID(*sid.LinkSID)
+	}
+	result, err := svc.database.GetComicLink(ctx, map[string]any{
+		model.DBComicGenericComicID: comicID,
+		model.DBLinkGenericLinkID:   linkID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (svc Service) UpdateComicLinkBySID(ctx context.Context, sid model.ComicLinkSID, data model.SetComicLink, v *model.ComicLink) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to update comic link")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	var comicID any
+	switch {
+	case sid.ComicID != nil:
+		comicID = sid.ComicID
+	case sid.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*sid.ComicCode)
+	}
+	var linkID any
+	switch {
+	case sid.LinkID != nil:
+		linkID = sid.LinkID
+	case sid.LinkSID != nil:
+		linkID = model.DBLinkSIDToID(*sid.LinkSID)
+	}
+	conds := map[string]any{
+		model.DBComicGenericComicID: comicID,
+		model.DBLinkGenericLinkID:   linkID,
+	}
+	if err := audit(ctx, svc, model.AuditEntityComicLink, model.AuditOperationUpdate, func(ctx context.Context) (*model.ComicLink, error) {
+		return svc.database.GetComicLink(ctx, conds)
+	}, func(ctx context.Context, v *model.ComicLink) error {
+		return svc.database.UpdateComicLink(ctx, data, conds, v)
+	}, v); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc Service) DeleteComicLinkBySID(ctx context.Context, sid model.ComicLinkSID) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to delete comic link")
+	}
+
+	var comicID any
+	switch {
+	case sid.ComicID != nil:
+		comicID = sid.ComicID
+	case sid.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*sid.ComicCode)
+	}
+	var linkID any
+	switch {
+	case sid.LinkID != nil:
+		linkID = sid.LinkID
+	case sid.LinkSID != nil:
+		linkID = model.DBLinkSIDToID(*sid.LinkSID)
+	}
+	return audit(ctx, svc, model.AuditEntityComicLink, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.ComicLink) error {
+		return svc.database.DeleteComicLink(ctx, map[string]any{
+			model.DBComicGenericComicID: comicID,
+			model.DBLinkGenericLinkID:   linkID,
+		}, v)
+	}, nil)
+}
+
+func (svc Service) ListComicLink(ctx context.Context, params model.ListParams) ([]*model.ComicLink, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
+		switch field := ob.Field.(type) {
+		case string:
+			return !slices.Contains(model.ComicLinkOrderByAllow, field)
+		}
+		return true
+	})
+	if len(params.OrderBys) > model.ComicLinkOrderBysMax {
+		params.OrderBys = params.OrderBys[:model.ComicLinkOrderBysMax]
+	}
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.ComicLinkPaginationMax {
+			pagination.Limit = model.ComicLinkPaginationMax
 		}
 	}
 
+	return svc.database.ListComicLink(ctx, params)
+}
+
+func (svc Service) CountComicLink(ctx context.Context, conds any) (int, error) {
+	return svc.database.CountComicLink(ctx, conds)
+}
+
+// Comic Creator
+
+func (svc Service) AddComicCreator(ctx context.Context, data model.AddComicCreator, v *model.ComicCreator) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to add comic creator")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return audit(ctx, svc, model.AuditEntityComicCreator, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.ComicCreator) error {
+		return svc.database.AddComicCreator(ctx, data, v)
+	}, v)
+}
+
+func (svc Service) GetComicCreatorBySID(ctx context.Context, sid model.ComicCreatorSID) (*model.ComicCreator, error) {
+	var comicID any
+	switch {
+	case sid.ComicID != nil:
+		comicID = sid.ComicID
+	case sid.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*sid.ComicCode)
+	}
+	var creatorID any
+	switch {
+	case sid.CreatorID != nil:
+		creatorID = sid.CreatorID
+	case sid.CreatorSlug != nil:
+		creatorID = model.DBCreatorSlugToID(*sid.CreatorSlug)
+	}
+	result, err := svc.database.GetComicCreator(ctx, map[string]any{
+		model.DBComicGenericComicID:     comicID,
+		model.DBCreatorGenericCreatorID: creatorID,
+		model.DBComicCreatorRole:        sid.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (svc Service) UpdateComicCreatorBySID(ctx context.Context, sid model.ComicCreatorSID, data model.SetComicCreator, v *model.ComicCreator) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to update comic creator")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	var comicID any
+	switch {
+	case sid.ComicID != nil:
+		comicID = sid.ComicID
+	case sid.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*sid.ComicCode)
+	}
+	var creatorID any
+	switch {
+	case sid.CreatorID != nil:
+		creatorID = sid.CreatorID
+	case sid.CreatorSlug != nil:
+		creatorID = model.DBCreatorSlugToID(*sid.CreatorSlug)
+	}
+	conds := map[string]any{
+		model.DBComicGenericComicID:     comicID,
+		model.DBCreatorGenericCreatorID: creatorID,
+		model.DBComicCreatorRole:        sid.Role,
+	}
+	if err := audit(ctx, svc, model.AuditEntityComicCreator, model.AuditOperationUpdate, func(ctx context.Context) (*model.ComicCreator, error) {
+		return svc.database.GetComicCreator(ctx, conds)
+	}, func(ctx context.Context, v *model.ComicCreator) error {
+		return svc.database.UpdateComicCreator(ctx, data, conds, v)
+	}, v); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (svc Service) DeleteComicByCode(ctx context.Context, code string) error {
+func (svc Service) DeleteComicCreatorBySID(ctx context.Context, sid model.ComicCreatorSID) error {
 	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic")
+		return model.GenericError("missing admin permission to delete comic creator")
 	}
 
-	return svc.database.DeleteComic(ctx, model.DBConditionalKV{
-		Key:   model.DBComicCode,
-		Value: code,
+	var comicID any
+	switch {
+	case sid.ComicID != nil:
+		comicID = sid.ComicID
+	case sid.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*sid.ComicCode)
+	}
+	var creatorID any
+	switch {
+	case sid.CreatorID != nil:
+		creatorID = sid.CreatorID
+	case sid.CreatorSlug != nil:
+		creatorID = model.DBCreatorSlugToID(*sid.CreatorSlug)
+	}
+	return audit(ctx, svc, model.AuditEntityComicCreator, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.ComicCreator) error {
+		return svc.database.DeleteComicCreator(ctx, map[string]any{
+			model.DBComicGenericComicID:     comicID,
+			model.DBCreatorGenericCreatorID: creatorID,
+			model.DBComicCreatorRole:        sid.Role,
+		}, v)
 	}, nil)
 }
 
-func (svc Service) ListComic(ctx context.Context, params model.ListParams) ([]*model.Comic, error) {
+func (svc Service) ListComicCreator(ctx context.Context, params model.ListParams) ([]*model.ComicCreator, error) {
 	if err := params.Validate(); err != nil {
 		return nil, err
 	}
@@ -183,130 +684,43 @@ func (svc Service) ListComic(ctx context.Context, params model.ListParams) ([]*m
 	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
 		switch field := ob.Field.(type) {
 		case string:
-			return !slices.Contains(model.ComicOrderByAllow, field)
+			return !slices.Contains(model.ComicCreatorOrderByAllow, field)
 		}
 		return true
 	})
-	if len(params.OrderBys) > model.ComicOrderBysMax {
-		params.OrderBys = params.OrderBys[:model.ComicOrderBysMax]
+	if len(params.OrderBys) > model.ComicCreatorOrderBysMax {
+		params.OrderBys = params.OrderBys[:model.ComicCreatorOrderBysMax]
 	}
 	if pagination := params.Pagination; pagination != nil {
-		if pagination.Limit > model.ComicPaginationMax {
-			pagination.Limit = model.ComicPaginationMax
-		}
-	}
-
-	result, err := svc.database.ListComic(ctx, params)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(result) > 0 {
-		conds := make([]any, len(result)+1)
-		conds = append(conds, model.DBLogicalOR{})
-		for _, r := range result {
-			conds = append(conds, model.DBConditionalKV{
-				Key:   model.DBComicGenericComicID,
-				Value: r.ID,
-			})
-		}
-		g, gctx := errgroup.WithContext(ctx)
-		g.Go(func() error {
-			links0, err := svc.listComicLink(ctx, model.ListParams{
-				Conditions: conds,
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
-			links := map[uint]*model.Link{}
-			for _, link := range links0 {
-				links[link.LinkID] = nil
-			}
-			conditions := make([]any, len(links0)+2)
-			conditions = append(conditions, model.DBLogicalOR{})
-			for id := range links {
-				conditions = append(conditions, model.DBConditionalKV{
-					Key:   model.DBGenericID,
-					Value: id,
-				})
-			}
-			links1, err := svc.database.ListLink(ctx, model.ListParams{
-				Conditions: conditions,
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
-			for _, link := range links1 {
-				links[link.ID] = link
-			}
-			for _, r := range result {
-				r.Links = make([]*model.Link, 0)
-			}
-			for _, link := range links0 {
-				for _, r := range result {
-					if r.ID == link.LinkID {
-						r.Links = append(r.Links, links[link.LinkID])
-					}
-				}
-			}
-			return nil
-		})
-		g.Go(func() error {
-			chapters, err := svc.listComicChapter(gctx, model.ListParams{
-				Conditions: conds,
-				Pagination: &model.Pagination{},
-			})
-			if err != nil {
-				return err
-			}
-			for _, r := range result {
-				r.Chapters = []*model.ComicChapter{}
-			}
-			for _, chapter := range chapters {
-				for _, r := range result {
-					if r.ID == chapter.ComicID {
-						r.Chapters = append(r.Chapters, chapter)
-					}
-				}
-			}
-			return nil
-		})
-		if err := g.Wait(); err != nil {
-			return nil, err
+		if pagination.Limit > model.ComicCreatorPaginationMax {
+			pagination.Limit = model.ComicCreatorPaginationMax
 		}
 	}
 
-	return result, nil
-}
-
-func (svc Service) CountComic(ctx context.Context, conds any) (int, error) {
-	return svc.database.CountComic(ctx, conds)
+	return svc.database.ListComicCreator(ctx, params)
 }
 
-func (svc Service) ExistsComicByCode(ctx context.Context, code string) (bool, error) {
-	return svc.database.ExistsComic(ctx, model.DBConditionalKV{
-		Key:   model.DBComicCode,
-		Value: code,
-	})
+func (svc Service) CountComicCreator(ctx context.Context, conds any) (int, error) {
+	return svc.database.CountComicCreator(ctx, conds)
 }
 
-// Comic Link
+// Comic Tag
 
-func (svc Service) AddComicLink(ctx context.Context, data model.AddComicLink, v *model.ComicLink) error {
+func (svc Service) AddComicTag(ctx context.Context, data model.AddComicTag, v *model.ComicTag) error {
 	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to add comic link")
+		return model.GenericError("missing admin permission to add comic tag")
 	}
 
 	if err := data.Validate(); err != nil {
 		return err
 	}
 
-	return svc.database.AddComicLink(ctx, data, v)
+	return audit(ctx, svc, model.AuditEntityComicTag, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.ComicTag) error {
+		return svc.database.AddComicTag(ctx, data, v)
+	}, v)
 }
 
-func (svc Service) GetComicLinkBySID(ctx context.Context, sid model.ComicLinkSID) (*model.ComicLink, error) {
+func (svc Service) GetComicTagBySID(ctx context.Context, sid model.ComicTagSID) (*model.ComicTag, error) {
 	var comicID any
 	switch {
 	case sid.ComicID != nil:
@@ -314,16 +728,16 @@ func (svc Service) GetComicLinkBySID(ctx context.Context, sid model.ComicLinkSID
 	case sid.ComicCode != nil:
 		comicID = model.DBComicCodeToID(*sid.ComicCode)
 	}
-	var linkID any
+	var tagID any
 	switch {
-	case sid.LinkID != nil:
-		linkID = sid.LinkID
-	case sid.LinkSID != nil:
-		linkID = model.DBLinkSIDToID(*sid.LinkSID)
+	case sid.TagID != nil:
+		tagID = sid.TagID
+	case sid.TagSID != nil:
+		tagID = model.DBTagSIDToID(*sid.TagSID)
 	}
-	result, err := svc.database.GetComicLink(ctx, map[string]any{
+	result, err := svc.database.GetComicTag(ctx, map[string]any{
 		model.DBComicGenericComicID: comicID,
-		model.DBLinkGenericLinkID:   linkID,
+		model.DBTagGenericTagID:     tagID,
 	})
 	if err != nil {
 		return nil, err
@@ -332,9 +746,9 @@ func (svc Service) GetComicLinkBySID(ctx context.Context, sid model.ComicLinkSID
 	return result, nil
 }
 
-func (svc Service) UpdateComicLinkBySID(ctx context.Context, sid model.ComicLinkSID, data model.SetComicLink, v *model.ComicLink) error {
+func (svc Service) UpdateComicTagBySID(ctx context.Context, sid model.ComicTagSID, data model.SetComicTag, v *model.ComicTag) error {
 	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to update comic link")
+		return model.GenericError("missing admin permission to update comic tag")
 	}
 
 	if err := data.Validate(); err != nil {
@@ -348,16 +762,21 @@ func (svc Service) UpdateComicLinkBySID(ctx context.Context, sid model.ComicLink
 	case sid.ComicCode != nil:
 		comicID = model.DBComicCodeToID(*sid.ComicCode)
 	}
-	var linkID any
+	var tagID any
 	switch {
-	case sid.LinkID != nil:
-		linkID = sid.LinkID
-	case sid.LinkSID != nil:
-		linkID = model.DBLinkSIDToID(*sid.LinkSID)
+	case sid.TagID != nil:
+		tagID = sid.TagID
+	case sid.TagSID != nil:
+		tagID = model.DBTagSIDToID(*sid.TagSID)
 	}
-	if err := svc.database.UpdateComicLink(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBComicGenericComicID: comicID,
-		model.DBLinkGenericLinkID:   linkID,
+		model.DBTagGenericTagID:     tagID,
+	}
+	if err := audit(ctx, svc, model.AuditEntityComicTag, model.AuditOperationUpdate, func(ctx context.Context) (*model.ComicTag, error) {
+		return svc.database.GetComicTag(ctx, conds)
+	}, func(ctx context.Context, v *model.ComicTag) error {
+		return svc.database.UpdateComicTag(ctx, data, conds, v)
 	}, v); err != nil {
 		return err
 	}
@@ -365,9 +784,9 @@ func (svc Service) UpdateComicLinkBySID(ctx context.Context, sid model.ComicLink
 	return nil
 }
 
-func (svc Service) DeleteComicLinkBySID(ctx context.Context, sid model.ComicLinkSID) error {
+func (svc Service) DeleteComicTagBySID(ctx context.Context, sid model.ComicTagSID) error {
 	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
-		return model.GenericError("missing admin permission to delete comic link")
+		return model.GenericError("missing admin permission to delete comic tag")
 	}
 
 	var comicID any
@@ -377,29 +796,22 @@ func (svc Service) DeleteComicLinkBySID(ctx context.Context, sid model.ComicLink
 	case sid.ComicCode != nil:
 		comicID = model.DBComicCodeToID(*sid.ComicCode)
 	}
-	var linkID any
+	var tagID any
 	switch {
-	case sid.LinkID != nil:
-		linkID = sid.LinkID
-	case sid.LinkSID != nil:
-		linkID = model.DBLinkSIDToID(*sid.LinkSID)
-	}
-	return svc.database.DeleteComicLink(ctx, map[string]any{
-		model.DBComicGenericComicID: comicID,
-		model.DBLinkGenericLinkID:   linkID,
+	case sid.TagID != nil:
+		tagID = sid.TagID
+	case sid.TagSID != nil:
+		tagID = model.DBTagSIDToID(*sid.TagSID)
+	}
+	return audit(ctx, svc, model.AuditEntityComicTag, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.ComicTag) error {
+		return svc.database.DeleteComicTag(ctx, map[string]any{
+			model.DBComicGenericComicID: comicID,
+			model.DBTagGenericTagID:     tagID,
+		}, v)
 	}, nil)
 }
 
-func (svc Service) listComicLink(ctx context.Context, params model.ListParams) ([]*model.ComicLink, error) {
-	result, err := svc.database.ListComicLink(ctx, params)
-	if err != nil {
-		return nil, err
-	}
-
-	return result, nil
-}
-
-func (svc Service) ListComicLink(ctx context.Context, params model.ListParams) ([]*model.ComicLink, error) {
+func (svc Service) ListComicTag(ctx context.Context, params model.ListParams) ([]*model.ComicTag, error) {
 	if err := params.Validate(); err != nil {
 		return nil, err
 	}
@@ -407,24 +819,24 @@ func (svc Service) ListComicLink(ctx context.Context, params model.ListParams) (
 	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
 		switch field := ob.Field.(type) {
 		case string:
-			return !slices.Contains(model.ComicLinkOrderByAllow, field)
+			return !slices.Contains(model.ComicTagOrderByAllow, field)
 		}
 		return true
 	})
-	if len(params.OrderBys) > model.ComicLinkOrderBysMax {
-		params.OrderBys = params.OrderBys[:model.ComicLinkOrderBysMax]
+	if len(params.OrderBys) > model.ComicTagOrderBysMax {
+		params.OrderBys = params.OrderBys[:model.ComicTagOrderBysMax]
 	}
 	if pagination := params.Pagination; pagination != nil {
-		if pagination.Limit > model.ComicLinkPaginationMax {
-			pagination.Limit = model.ComicLinkPaginationMax
+		if pagination.Limit > model.ComicTagPaginationMax {
+			pagination.Limit = model.ComicTagPaginationMax
 		}
 	}
 
-	return svc.database.ListComicLink(ctx, params)
+	return svc.database.ListComicTag(ctx, params)
 }
 
-func (svc Service) CountComicLink(ctx context.Context, conds any) (int, error) {
-	return svc.database.CountComicLink(ctx, conds)
+func (svc Service) CountComicTag(ctx context.Context, conds any) (int, error) {
+	return svc.database.CountComicTag(ctx, conds)
 }
 
 //
@@ -440,11 +852,173 @@ func (svc Service) AddComicChapter(ctx context.Context, data model.AddComicChapt
 		return err
 	}
 
+	return audit(ctx, svc, model.AuditEntityComicChapter, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.ComicChapter) error {
+		return svc.addComicChapter(ctx, data, v)
+	}, v)
+}
+
+func (svc Service) addComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) error {
+	result := v
+	if result == nil {
+		result = new(model.ComicChapter)
+	}
+	if err := svc.database.AddComicChapter(ctx, data, result); err != nil {
+		return err
+	}
+
+	if err := svc.addComicChapterLinks(ctx, result.ID, data.Links); err != nil {
+		return err
+	}
+
 	if v != nil {
-		v.Links = []*model.Link{}
+		if err := svc.database.EmbedComicChapterLinks(ctx, []*model.ComicChapter{v}, 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addComicChapterLinks adds the links to the comic chapter, links given twice
+// in whatever form are only added once.
+func (svc Service) addComicChapterLinks(ctx context.Context, chapterID uint, links []model.AddLink) error {
+	linkIDs := map[uint]bool{}
+	for _, link := range links {
+		link0 := new(model.Link)
+		if err := svc.database.UpsertLink(ctx, link, link0); err != nil {
+			return err
+		}
+		if linkIDs[link0.ID] {
+			continue
+		}
+		linkIDs[link0.ID] = true
+		if err := svc.database.AddComicChapterLink(ctx, model.AddComicChapterLink{
+			ChapterID: &chapterID,
+			LinkID:    &link0.ID,
+		}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddComicChapters adds the comic chapters in a single insert and records their
+// audit events in another, the links of each are added one by one after.
+func (svc Service) AddComicChapters(ctx context.Context, data []model.AddComicChapter) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to add comic chapter")
+	}
+
+	for _, data := range data {
+		if err := data.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return svc.database.WithTx(ctx, func(ctx context.Context) error {
+		result := []*model.ComicChapter{}
+		if err := svc.database.AddComicChapters(ctx, data, &result); err != nil {
+			return err
+		}
+
+		var actor *string
+		if subject := svc.oauth.TokenSubjectContext(ctx); subject != "" {
+			actor = &subject
+		}
+		events := make([]model.AddAuditEvent, 0, len(result))
+		for _, r := range result {
+			for _, data := range data {
+				if len(data.Links) < 1 || !addedComicChapter(data, r) {
+					continue
+				}
+				if err := svc.addComicChapterLinks(ctx, r.ID, data.Links); err != nil {
+					return err
+				}
+			}
+			events = append(events, model.AddAuditEvent{
+				Actor:     actor,
+				Operation: model.AuditOperationAdd,
+				Entity:    model.AuditEntityComicChapter,
+				EntitySID: r.AuditSID(),
+				ComicID:   r.AuditComicID(),
+				After:     r,
+			})
+		}
+		return svc.database.AddAuditEvents(ctx, events)
+	})
+}
+
+// addedComicChapter tells whether v is the comic chapter added from data, the
+// rows of a bulk insert are not bound to come back in order.
+func addedComicChapter(data model.AddComicChapter, v *model.ComicChapter) bool {
+	switch {
+	case data.ComicID != nil && *data.ComicID != v.ComicID:
+		return false
+	case data.ComicID == nil && data.ComicCode != nil && *data.ComicCode != v.ComicCode:
+		return false
+	case data.Chapter != v.Chapter:
+		return false
+	case (data.Version == nil) != (v.Version == nil):
+		return false
+	}
+	return data.Version == nil || *data.Version == *v.Version
+}
+
+// UpsertComicChapter adds the comic chapter or updates the existing one along
+// with its links, it reports whether the comic chapter was created.
+func (svc Service) UpsertComicChapter(ctx context.Context, data model.AddComicChapter, v *model.ComicChapter) (bool, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return false, model.GenericError("missing admin permission to upsert comic chapter")
+	}
+
+	if err := data.Validate(); err != nil {
+		return false, err
+	}
+
+	result := v
+	if result == nil {
+		result = new(model.ComicChapter)
+	}
+	created := false
+	if err := audit(ctx, svc, model.AuditEntityComicChapter, model.AuditOperationUpdate, func(ctx context.Context) (*model.ComicChapter, error) {
+		before, err := svc.database.GetComicChapter(ctx, model.DBConditionalKV{
+			Key: model.DBGenericID,
+			Value: model.DBComicChapterSIDToID(model.ComicChapterSID{
+				ComicID:   data.ComicID,
+				ComicCode: data.ComicCode,
+				Chapter:   data.Chapter,
+				Version:   data.Version,
+			}),
+		})
+		created = errors.As(err, &model.ErrNotFound)
+		return before, err
+	}, func(ctx context.Context, result *model.ComicChapter) error {
+		if err := svc.database.UpsertComicChapter(ctx, data, result); err != nil {
+			return err
+		}
+
+		for _, link := range data.Links {
+			link0 := new(model.Link)
+			if err := svc.database.UpsertLink(ctx, link, link0); err != nil {
+				return err
+			}
+			if err := svc.database.UpsertComicChapterLink(ctx, model.AddComicChapterLink{
+				ChapterID: &result.ID,
+				LinkID:    &link0.ID,
+			}, nil); err != nil {
+				return err
+			}
+		}
+
+		if v != nil {
+			return svc.database.EmbedComicChapterLinks(ctx, []*model.ComicChapter{v}, 0)
+		}
+		return nil
+	}, result); err != nil {
+		return false, err
 	}
 
-	return svc.database.AddComicChapter(ctx, data, v)
+	return created, nil
 }
 
 func (svc Service) getComicChapter(ctx context.Context, conds any) (*model.ComicChapter, error) {
@@ -462,14 +1036,11 @@ func (svc Service) getComicChapter(ctx context.Context, conds any) (*model.Comic
 	}
 	result.Links = []*model.Link{}
 	if len(links0) > 0 {
-		conditions := make([]any, len(links0)+2)
-		conditions = append(conditions, model.DBLogicalOR{})
+		linkIDs := make([]uint, 0, len(links0))
 		for _, link := range links0 {
-			conditions = append(conditions, model.DBConditionalKV{
-				Key:   model.DBGenericID,
-				Value: link.LinkID,
-			})
+			linkIDs = append(linkIDs, link.LinkID)
 		}
+		conditions := model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: linkIDs}}
 		links1, err := svc.database.ListLink(ctx, model.ListParams{
 			Conditions: conditions,
 			Pagination: &model.Pagination{},
@@ -514,7 +1085,13 @@ func (svc Service) updateComicChapter(ctx context.Context, data model.SetComicCh
 		return err
 	}
 
-	if err := svc.database.UpdateComicChapter(ctx, data, conds, v); err != nil {
+	if err := svc.ifMatch(ctx, svc.database.ExistsComicChapter, conds, func(ctx context.Context, conds any) error {
+		return audit(ctx, svc, model.AuditEntityComicChapter, model.AuditOperationUpdate, func(ctx context.Context) (*model.ComicChapter, error) {
+			return svc.database.GetComicChapter(ctx, conds)
+		}, func(ctx context.Context, v *model.ComicChapter) error {
+			return svc.database.UpdateComicChapter(ctx, data, conds, v)
+		}, v)
+	}); err != nil {
 		return err
 	}
 
@@ -528,14 +1105,11 @@ func (svc Service) updateComicChapter(ctx context.Context, data model.SetComicCh
 		}
 		v.Links = []*model.Link{}
 		if len(links0) > 0 {
-			conditions := make([]any, len(links0)+2)
-			conditions = append(conditions, model.DBLogicalOR{})
+			linkIDs := make([]uint, 0, len(links0))
 			for _, link := range links0 {
-				conditions = append(conditions, model.DBConditionalKV{
-					Key:   model.DBGenericID,
-					Value: link.LinkID,
-				})
+				linkIDs = append(linkIDs, link.LinkID)
 			}
+			conditions := model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: linkIDs}}
 			links1, err := svc.database.ListLink(ctx, model.ListParams{
 				Conditions: conditions,
 				Pagination: &model.Pagination{},
@@ -577,10 +1151,18 @@ func (svc Service) deleteComicChapter(ctx context.Context, conds any) error {
 		return model.GenericError("missing admin permission to delete comic chapter")
 	}
 
-	return svc.database.DeleteComicChapter(ctx, conds, nil)
+	return svc.ifMatch(ctx, svc.database.ExistsComicChapter, conds, func(ctx context.Context, conds any) error {
+		return audit(ctx, svc, model.AuditEntityComicChapter, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.ComicChapter) error {
+			return svc.database.DeleteComicChapter(ctx, conds, v)
+		}, nil)
+	})
 }
 
 func (svc Service) DeleteComicChapterBySID(ctx context.Context, sid model.ComicChapterSID) error {
+	return svc.deleteComicChapter(ctx, comicChapterSIDConditions(sid))
+}
+
+func comicChapterSIDConditions(sid model.ComicChapterSID) map[string]any {
 	var comicID any
 	switch {
 	case sid.ComicID != nil:
@@ -595,11 +1177,39 @@ func (svc Service) DeleteComicChapterBySID(ctx context.Context, sid model.ComicC
 	default:
 		version = model.DBIsNull{}
 	}
-	return svc.deleteComicChapter(ctx, map[string]any{
+	return map[string]any{
 		model.DBComicGenericComicID: comicID,
 		model.DBComicChapterChapter: sid.Chapter,
 		model.DBComicChapterVersion: version,
-	})
+	}
+}
+
+func (svc Service) RestoreComicChapterBySID(ctx context.Context, sid model.ComicChapterSID) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to restore comic chapter")
+	}
+
+	var comicID any
+	switch {
+	case sid.ComicID != nil:
+		comicID = sid.ComicID
+	case sid.ComicCode != nil:
+		comicID = model.DBComicCodeToID(*sid.ComicCode)
+	}
+	var version any
+	switch {
+	case sid.Version != nil:
+		version = sid.Version
+	default:
+		version = model.DBIsNull{}
+	}
+	return audit(ctx, svc, model.AuditEntityComicChapter, model.AuditOperationRestore, nil, func(ctx context.Context, v *model.ComicChapter) error {
+		return svc.database.RestoreComicChapter(ctx, map[string]any{
+			model.DBComicGenericComicID: comicID,
+			model.DBComicChapterChapter: sid.Chapter,
+			model.DBComicChapterVersion: version,
+		}, v)
+	}, nil)
 }
 
 func (svc Service) listComicChapter(ctx context.Context, params model.ListParams) ([]*model.ComicChapter, error) {
@@ -608,54 +1218,8 @@ func (svc Service) listComicChapter(ctx context.Context, params model.ListParams
 		return nil, err
 	}
 
-	if len(result) > 0 {
-		conds := make([]any, len(result)+1)
-		conds = append(conds, model.DBLogicalOR{})
-		for _, r := range result {
-			conds = append(conds, model.DBConditionalKV{
-				Key:   model.DBComicChapterGenericChapterID,
-				Value: r.ID,
-			})
-		}
-		links0, err := svc.listComicChapterLink(ctx, model.ListParams{
-			Conditions: conds,
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return nil, err
-		}
-		links := map[uint]*model.Link{}
-		for _, link := range links0 {
-			links[link.LinkID] = nil
-		}
-		conditions := make([]any, len(links0)+2)
-		conditions = append(conditions, model.DBLogicalOR{})
-		for id := range links {
-			conditions = append(conditions, model.DBConditionalKV{
-				Key:   model.DBGenericID,
-				Value: id,
-			})
-		}
-		links1, err := svc.database.ListLink(ctx, model.ListParams{
-			Conditions: conditions,
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return nil, err
-		}
-		for _, link := range links1 {
-			links[link.ID] = link
-		}
-		for _, r := range result {
-			r.Links = make([]*model.Link, 0)
-		}
-		for _, link := range links0 {
-			for _, r := range result {
-				if r.ID == link.ChapterID {
-					r.Links = append(r.Links, links[link.LinkID])
-				}
-			}
-		}
+	if err := svc.database.EmbedComicChapterLinks(ctx, result, 0); err != nil {
+		return nil, err
 	}
 
 	return result, nil
@@ -722,7 +1286,38 @@ func (svc Service) AddComicChapterLink(ctx context.Context, data model.AddComicC
 		return err
 	}
 
-	return svc.database.AddComicChapterLink(ctx, data, v)
+	return audit(ctx, svc, model.AuditEntityComicChapterLink, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.ComicChapterLink) error {
+		return svc.database.AddComicChapterLink(ctx, data, v)
+	}, v)
+}
+
+// UpsertComicChapterLink adds the comic chapter link or gets the existing one
+// as it is, it reports whether the comic chapter link was created.
+func (svc Service) UpsertComicChapterLink(ctx context.Context, data model.AddComicChapterLink, v *model.ComicChapterLink) (bool, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return false, model.GenericError("missing admin permission to upsert comic chapter link")
+	}
+
+	if err := data.Validate(); err != nil {
+		return false, err
+	}
+
+	result := v
+	if result == nil {
+		result = new(model.ComicChapterLink)
+	}
+	created := false
+	if err := audit(ctx, svc, model.AuditEntityComicChapterLink, model.AuditOperationUpdate, func(ctx context.Context) (*model.ComicChapterLink, error) {
+		before, err := svc.GetComicChapterLinkBySID(ctx, model.ComicChapterLinkSID{ChapterID: data.ChapterID, ChapterSID: data.ChapterSID, LinkID: data.LinkID, LinkSID: data.LinkSID})
+		created = errors.As(err, &model.ErrNotFound)
+		return before, err
+	}, func(ctx context.Context, v *model.ComicChapterLink) error {
+		return svc.database.UpsertComicChapterLink(ctx, data, v)
+	}, result); err != nil {
+		return false, err
+	}
+
+	return created, nil
 }
 
 func (svc Service) GetComicChapterLinkBySID(ctx context.Context, sid model.ComicChapterLinkSID) (*model.ComicChapterLink, error) {
@@ -774,9 +1369,14 @@ func (svc Service) UpdateComicChapterLinkBySID(ctx context.Context, sid model.Co
 	case sid.LinkSID != nil:
 		linkID = model.DBLinkSIDToID(*sid.LinkSID)
 	}
-	if err := svc.database.UpdateComicChapterLink(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBComicChapterGenericChapterID: chapterID,
 		model.DBLinkGenericLinkID:            linkID,
+	}
+	if err := audit(ctx, svc, model.AuditEntityComicChapterLink, model.AuditOperationUpdate, func(ctx context.Context) (*model.ComicChapterLink, error) {
+		return svc.database.GetComicChapterLink(ctx, conds)
+	}, func(ctx context.Context, v *model.ComicChapterLink) error {
+		return svc.database.UpdateComicChapterLink(ctx, data, conds, v)
 	}, v); err != nil {
 		return err
 	}
@@ -803,9 +1403,11 @@ func (svc Service) DeleteComicChapterLinkBySID(ctx context.Context, sid model.Co
 	case sid.LinkSID != nil:
 		linkID = model.DBLinkSIDToID(*sid.LinkSID)
 	}
-	return svc.database.DeleteComicChapterLink(ctx, map[string]any{
-		model.DBComicChapterGenericChapterID: chapterID,
-		model.DBLinkGenericLinkID:            linkID,
+	return audit(ctx, svc, model.AuditEntityComicChapterLink, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.ComicChapterLink) error {
+		return svc.database.DeleteComicChapterLink(ctx, map[string]any{
+			model.DBComicChapterGenericChapterID: chapterID,
+			model.DBLinkGenericLinkID:            linkID,
+		}, v)
 	}, nil)
 }
 
@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/utila"
+)
+
+// testComicDatabase keeps the codes of comics added, taken codes are seen by
+// the check before adding and raced codes only once added.
+type testComicDatabase struct {
+	database
+	taken  map[string]bool
+	raced  map[string]bool
+	tried  []string
+	result []string
+}
+
+func (db *testComicDatabase) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (db *testComicDatabase) WithSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (db *testComicDatabase) AddAuditEvent(ctx context.Context, data model.AddAuditEvent) error {
+	return nil
+}
+
+func (db *testComicDatabase) ExistsComic(ctx context.Context, conds any) (bool, error) {
+	code := conds.(model.DBConditionalKV).Value.(string)
+	db.tried = append(db.tried, code)
+	return db.taken[code], nil
+}
+
+func (db *testComicDatabase) ResolveComicCodeAlias(ctx context.Context, code string) (string, error) {
+	return "", model.NotFoundError(errors.New("no alias"))
+}
+
+func (db *testComicDatabase) AddComic(ctx context.Context, data model.AddComic, v *model.Comic) error {
+	if db.raced[data.Code] {
+		return model.ErrComicCodeExists
+	}
+	db.result = append(db.result, data.Code)
+	return nil
+}
+
+type testOAuth struct{}
+
+func (testOAuth) HasPermissionContext(ctx context.Context, permission string) bool { return true }
+func (testOAuth) TokenPermissionKey(s ...string) string                            { return "" }
+func (testOAuth) TokenSubjectContext(ctx context.Context) string                   { return "" }
+
+func testComicService(t *testing.T, mode string) (Service, *testComicDatabase) {
+	db := &testComicDatabase{taken: map[string]bool{}, raced: map[string]bool{}}
+	cfg := Config{}
+	cfg.ComicCode.Mode = mode
+	svc, err := New(db, testOAuth{}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return svc, db
+}
+
+func TestAddComicCode(t *testing.T) {
+	title := "One Piece"
+	for _, mode := range model.ComicCodeModeAllow {
+		t.Run(mode, func(t *testing.T) {
+			svc, db := testComicService(t, mode)
+			for i := 0; i < 2; i++ {
+				if err := svc.AddComic(context.Background(), model.AddComic{Title: &title}, nil); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, code := range db.result {
+				if len(code) != model.ComicCodeLength {
+					t.Errorf("code %q is %d characters, want %d", code, len(code), model.ComicCodeLength)
+				}
+				if strings.Trim(code, model.ComicCodeAlphabetDef) != "" {
+					t.Errorf("code %q is out of the alphabet %q", code, model.ComicCodeAlphabetDef)
+				}
+			}
+			if mode == model.ComicCodeModeHash && db.result[0] != db.result[1] {
+				t.Errorf("codes %q of the same title differ", db.result)
+			}
+		})
+	}
+}
+
+// TestComicCodeLength keeps the code short enough for utila.HashString, which
+// the hash mode makes it with.
+func TestComicCodeLength(t *testing.T) {
+	if model.ComicCodeLength > utila.HashStringLengthMax {
+		t.Errorf("comic code length %d is over %d, the most utila.HashString gives", model.ComicCodeLength, utila.HashStringLengthMax)
+	}
+}
+
+func TestAddComicCodeRetry(t *testing.T) {
+	title := "One Piece"
+	data := model.AddComic{Title: &title}
+
+	svc, db := testComicService(t, model.ComicCodeModeHash)
+	db.taken[svc.comicCode(data, 0)] = true
+	db.raced[svc.comicCode(data, 1)] = true
+	if err := svc.AddComic(context.Background(), data, nil); err != nil {
+		t.Fatal(err)
+	}
+	if want := svc.comicCode(data, 2); len(db.result) != 1 || db.result[0] != want {
+		t.Errorf("added %q, want [%s] once the taken and raced codes are skipped", db.result, want)
+	}
+
+	svc, db = testComicService(t, model.ComicCodeModeHash)
+	for try := 0; try <= model.ComicCodeTriesMax; try++ {
+		db.taken[svc.comicCode(data, try)] = true
+	}
+	db.raced[svc.comicCode(data, model.ComicCodeTriesMax)] = true
+	err := svc.AddComic(context.Background(), data, nil)
+	if !errors.Is(err, model.ErrComicCodeExists) {
+		t.Errorf("got %v once every try is taken, want %v", err, model.ErrComicCodeExists)
+	}
+	if len(db.tried) != model.ComicCodeTriesMax+1 {
+		t.Errorf("tried %d codes, want %d", len(db.tried), model.ComicCodeTriesMax+1)
+	}
+}
+
+// testComicLinkDatabase gives every relative url a link of its own and keeps
+// the comic links added along with the include the comic was embedded with.
+type testComicLinkDatabase struct {
+	testComicDatabase
+	links      map[string]uint
+	comicLinks []uint
+	include    model.ComicInclude
+}
+
+func (db *testComicLinkDatabase) UpsertLink(ctx context.Context, data model.AddLink, v *model.Link) error {
+	if _, ok := db.links[data.RelativeURL]; !ok {
+		db.links[data.RelativeURL] = uint(len(db.links) + 1)
+	}
+	v.ID = db.links[data.RelativeURL]
+	return nil
+}
+
+func (db *testComicLinkDatabase) AddComicLink(ctx context.Context, data model.AddComicLink, v *model.ComicLink) error {
+	if slices.Contains(db.comicLinks, *data.LinkID) {
+		return model.GenericError("same link id already exists")
+	}
+	db.comicLinks = append(db.comicLinks, *data.LinkID)
+	return nil
+}
+
+func (db *testComicLinkDatabase) EmbedComic(ctx context.Context, result []*model.Comic, include model.ComicInclude) error {
+	db.include = include
+	return nil
+}
+
+func TestAddComicLinks(t *testing.T) {
+	db := &testComicLinkDatabase{links: map[string]uint{}}
+	svc, err := New(db, testOAuth{}, Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := "example.com"
+	data := model.AddComic{Code: "op000001", Links: []model.AddLink{
+		{WebsiteDomain: &domain, RelativeURL: "/one-piece"},
+		{WebsiteDomain: &domain, RelativeURL: "/one-piece"},
+		{WebsiteDomain: &domain, RelativeURL: "/one-piece/1"},
+	}}
+	if err := svc.AddComic(context.Background(), data, new(model.Comic)); err != nil {
+		t.Fatal(err)
+	}
+	if len(db.comicLinks) != 2 {
+		t.Errorf("added comic links %v, want the 2 distinct ones", db.comicLinks)
+	}
+	if db.include.LimitOf(model.ComicLinkPaginationDef, model.ComicLinkPaginationMax) != 0 {
+		t.Errorf("embedded with include %+v, want every row", db.include)
+	}
+}
+
+// testComicLinkUpsertDatabase holds a comic link that was never updated, an
+// upsert of it changes nothing and gives it back as it is.
+type testComicLinkUpsertDatabase struct {
+	testComicDatabase
+	exists bool
+}
+
+func (db *testComicLinkUpsertDatabase) GetComicLink(ctx context.Context, conds any) (*model.ComicLink, error) {
+	if !db.exists {
+		return nil, model.NotFoundError(errors.New("no comic link"))
+	}
+	return &model.ComicLink{ComicID: 1, LinkID: 1}, nil
+}
+
+func (db *testComicLinkUpsertDatabase) UpsertComicLink(ctx context.Context, data model.AddComicLink, v *model.ComicLink) error {
+	*v = model.ComicLink{ComicID: *data.ComicID, LinkID: *data.LinkID}
+	return nil
+}
+
+func TestUpsertComicLinkCreated(t *testing.T) {
+	for _, exists := range []bool{false, true} {
+		svc, err := New(&testComicLinkUpsertDatabase{exists: exists}, testOAuth{}, Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		id := uint(1)
+		created, err := svc.UpsertComicLink(context.Background(), model.AddComicLink{ComicID: &id, LinkID: &id}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created == exists {
+			t.Errorf("upsert comic link existing %t created = %t, want %t", exists, created, !exists)
+		}
+	}
+}
+
+// testComicVersionDatabase holds a comic at a version, a race moves it to
+// another version as a concurrent write would right before the update.
+type testComicVersionDatabase struct {
+	testComicDatabase
+	exists  bool
+	race    bool
+	version time.Time
+}
+
+// at reports whether the comic is there and at one of the versions of conds if
+// any.
+func (db *testComicVersionDatabase) at(conds any) bool {
+	if !db.exists {
+		return false
+	}
+	conds0, ok := conds.([]any)
+	if !ok {
+		return true
+	}
+	for _, cond := range conds0 {
+		if cond, ok := cond.(model.DBConditionalKV); ok && cond.Key == model.DBGenericVersion {
+			return slices.Contains(cond.Value.(model.DBIn).Values.([]time.Time), db.version)
+		}
+	}
+	return true
+}
+
+func (db *testComicVersionDatabase) ExistsComic(ctx context.Context, conds any) (bool, error) {
+	return db.at(conds), nil
+}
+
+func (db *testComicVersionDatabase) GetComic(ctx context.Context, conds any) (*model.Comic, error) {
+	if !db.at(conds) {
+		return nil, model.NotFoundError(errors.New("no comic"))
+	}
+	return &model.Comic{Code: "op000001"}, nil
+}
+
+func (db *testComicVersionDatabase) UpdateComic(ctx context.Context, data model.SetComic, conds any, v *model.Comic) error {
+	if db.race {
+		db.version = db.version.Add(time.Second)
+	}
+	if !db.at(conds) {
+		return model.NotFoundError(errors.New("no comic"))
+	}
+	v.Code = "op000001"
+	return nil
+}
+
+func TestUpdateComicIfMatch(t *testing.T) {
+	version := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := model.ContextWithIfMatch(context.Background(), []time.Time{version})
+	status := model.ComicStatusOngoing
+
+	tests := []struct {
+		name   string
+		exists bool
+		race   bool
+		want   func(err error) bool
+	}{
+		{"match", true, false, func(err error) bool { return err == nil }},
+		{"changed in between", true, true, func(err error) bool { return errors.As(err, &model.ErrPrecondition) }},
+		{"gone", false, false, func(err error) bool { return errors.As(err, &model.ErrNotFound) }},
+	}
+	for _, tt := range tests {
+		db := &testComicVersionDatabase{exists: tt.exists, race: tt.race, version: version}
+		svc, err := New(db, testOAuth{}, Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = svc.UpdateComicByCode(ctx, "op000001", model.SetComic{Status: &status}, model.ComicInclude{}, nil)
+		if !tt.want(err) {
+			t.Errorf("update comic %s: got %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,357 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+//
+// Creator
+//
+
+func (svc Service) AddCreator(ctx context.Context, data model.AddCreator, v *model.Creator) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to add creator")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	if v != nil {
+		v.Links = []*model.Link{}
+	}
+
+	return audit(ctx, svc, model.AuditEntityCreator, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.Creator) error {
+		return svc.database.AddCreator(ctx, data, v)
+	}, v)
+}
+
+func (svc Service) GetCreatorBySlug(ctx context.Context, slug string) (*model.Creator, error) {
+	result, err := svc.database.GetCreator(ctx, model.DBConditionalKV{
+		Key:   model.DBCreatorSlug,
+		Value: slug,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	links0, err := svc.listCreatorLink(ctx, model.ListParams{
+		Conditions: model.DBConditionalKV{Key: model.DBCreatorGenericCreatorID, Value: result.ID},
+		Pagination: &model.Pagination{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Links = []*model.Link{}
+	if len(links0) > 0 {
+		linkIDs := make([]uint, 0, len(links0))
+		for _, link := range links0 {
+			linkIDs = append(linkIDs, link.LinkID)
+		}
+		conditions := model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: linkIDs}}
+		links1, err := svc.database.ListLink(ctx, model.ListParams{
+			Conditions: conditions,
+			Pagination: &model.Pagination{},
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.Links = links1
+	}
+
+	return result, nil
+}
+
+func (svc Service) UpdateCreatorBySlug(ctx context.Context, slug string, data model.SetCreator, v *model.Creator) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to update creator")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	conds := model.DBConditionalKV{
+		Key:   model.DBCreatorSlug,
+		Value: slug,
+	}
+	if err := audit(ctx, svc, model.AuditEntityCreator, model.AuditOperationUpdate, func(ctx context.Context) (*model.Creator, error) {
+		return svc.database.GetCreator(ctx, conds)
+	}, func(ctx context.Context, v *model.Creator) error {
+		return svc.database.UpdateCreator(ctx, data, conds, v)
+	}, v); err != nil {
+		return err
+	}
+
+	if v != nil {
+		links0, err := svc.listCreatorLink(ctx, model.ListParams{
+			Conditions: model.DBConditionalKV{Key: model.DBCreatorGenericCreatorID, Value: v.ID},
+			Pagination: &model.Pagination{},
+		})
+		if err != nil {
+			return err
+		}
+		v.Links = []*model.Link{}
+		if len(links0) > 0 {
+			linkIDs := make([]uint, 0, len(links0))
+			for _, link := range links0 {
+				linkIDs = append(linkIDs, link.LinkID)
+			}
+			conditions := model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: linkIDs}}
+			links1, err := svc.database.ListLink(ctx, model.ListParams{
+				Conditions: conditions,
+				Pagination: &model.Pagination{},
+			})
+			if err != nil {
+				return err
+			}
+			v.Links = links1
+		}
+	}
+
+	return nil
+}
+
+func (svc Service) DeleteCreatorBySlug(ctx context.Context, slug string) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to delete creator")
+	}
+
+	return audit(ctx, svc, model.AuditEntityCreator, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.Creator) error {
+		return svc.database.DeleteCreator(ctx, model.DBConditionalKV{
+			Key:   model.DBCreatorSlug,
+			Value: slug,
+		}, v)
+	}, nil)
+}
+
+func (svc Service) ListCreator(ctx context.Context, params model.ListParams) ([]*model.Creator, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
+		switch field := ob.Field.(type) {
+		case string:
+			return !slices.Contains(model.CreatorOrderByAllow, field)
+		}
+		return true
+	})
+	if len(params.OrderBys) > model.CreatorOrderBysMax {
+		params.OrderBys = params.OrderBys[:model.CreatorOrderBysMax]
+	}
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.CreatorPaginationMax {
+			pagination.Limit = model.CreatorPaginationMax
+		}
+	}
+
+	result, err := svc.database.ListCreator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) > 0 {
+		ids := make([]uint, 0, len(result))
+		for _, r := range result {
+			ids = append(ids, r.ID)
+		}
+		conds := model.DBConditionalKV{Key: model.DBCreatorGenericCreatorID, Value: model.DBIn{Values: ids}}
+		links0, err := svc.listCreatorLink(ctx, model.ListParams{
+			Conditions: conds,
+			Pagination: &model.Pagination{},
+		})
+		if err != nil {
+			return nil, err
+		}
+		links := map[uint]*model.Link{}
+		for _, link := range links0 {
+			links[link.LinkID] = nil
+		}
+		linkIDs := make([]uint, 0, len(links0))
+		for id := range links {
+			linkIDs = append(linkIDs, id)
+		}
+		conditions := model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: linkIDs}}
+		links1, err := svc.database.ListLink(ctx, model.ListParams{
+			Conditions: conditions,
+			Pagination: &model.Pagination{},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, link := range links1 {
+			links[link.ID] = link
+		}
+		for _, r := range result {
+			r.Links = []*model.Link{}
+		}
+		for _, link := range links0 {
+			for _, r := range result {
+				if r.ID == link.CreatorID {
+					r.Links = append(r.Links, links[link.LinkID])
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (svc Service) CountCreator(ctx context.Context, conds any) (int, error) {
+	return svc.database.CountCreator(ctx, conds)
+}
+
+func (svc Service) ExistsCreatorBySlug(ctx context.Context, slug string) (bool, error) {
+	return svc.database.ExistsCreator(ctx, model.DBConditionalKV{
+		Key:   model.DBCreatorSlug,
+		Value: slug,
+	})
+}
+
+// Creator Link
+
+func (svc Service) AddCreatorLink(ctx context.Context, data model.AddCreatorLink, v *model.CreatorLink) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to add creator link")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return audit(ctx, svc, model.AuditEntityCreatorLink, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.CreatorLink) error {
+		return svc.database.AddCreatorLink(ctx, data, v)
+	}, v)
+}
+
+func (svc Service) GetCreatorLinkBySID(ctx context.Context, sid model.CreatorLinkSID) (*model.CreatorLink, error) {
+	var creatorID any
+	switch {
+	case sid.CreatorID != nil:
+		creatorID = sid.CreatorID
+	case sid.CreatorSlug != nil:
+		creatorID = model.DBCreatorSlugToID(*sid.CreatorSlug)
+	}
+	var linkID any
+	switch {
+	case sid.LinkID != nil:
+		linkID = sid.LinkID
+	case sid.LinkSID != nil:
+		linkID = model.DBLinkSIDToID(*sid.LinkSID)
+	}
+	result, err := svc.database.GetCreatorLink(ctx, map[string]any{
+		model.DBCreatorGenericCreatorID: creatorID,
+		model.DBLinkGenericLinkID:       linkID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (svc Service) UpdateCreatorLinkBySID(ctx context.Context, sid model.CreatorLinkSID, data model.SetCreatorLink, v *model.CreatorLink) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to update creator link")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	var creatorID any
+	switch {
+	case sid.CreatorID != nil:
+		creatorID = sid.CreatorID
+	case sid.CreatorSlug != nil:
+		creatorID = model.DBCreatorSlugToID(*sid.CreatorSlug)
+	}
+	var linkID any
+	switch {
+	case sid.LinkID != nil:
+		linkID = sid.LinkID
+	case sid.LinkSID != nil:
+		linkID = model.DBLinkSIDToID(*sid.LinkSID)
+	}
+	conds := map[string]any{
+		model.DBCreatorGenericCreatorID: creatorID,
+		model.DBLinkGenericLinkID:       linkID,
+	}
+	if err := audit(ctx, svc, model.AuditEntityCreatorLink, model.AuditOperationUpdate, func(ctx context.Context) (*model.CreatorLink, error) {
+		return svc.database.GetCreatorLink(ctx, conds)
+	}, func(ctx context.Context, v *model.CreatorLink) error {
+		return svc.database.UpdateCreatorLink(ctx, data, conds, v)
+	}, v); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (svc Service) DeleteCreatorLinkBySID(ctx context.Context, sid model.CreatorLinkSID) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to delete creator link")
+	}
+
+	var creatorID any
+	switch {
+	case sid.CreatorID != nil:
+		creatorID = sid.CreatorID
+	case sid.CreatorSlug != nil:
+		creatorID = model.DBCreatorSlugToID(*sid.CreatorSlug)
+	}
+	var linkID any
+	switch {
+	case sid.LinkID != nil:
+		linkID = sid.LinkID
+	case sid.LinkSID != nil:
+		linkID = model.DBLinkSIDToID(*sid.LinkSID)
+	}
+	return audit(ctx, svc, model.AuditEntityCreatorLink, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.CreatorLink) error {
+		return svc.database.DeleteCreatorLink(ctx, map[string]any{
+			model.DBCreatorGenericCreatorID: creatorID,
+			model.DBLinkGenericLinkID:       linkID,
+		}, v)
+	}, nil)
+}
+
+func (svc Service) listCreatorLink(ctx context.Context, params model.ListParams) ([]*model.CreatorLink, error) {
+	result, err := svc.database.ListCreatorLink(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (svc Service) ListCreatorLink(ctx context.Context, params model.ListParams) ([]*model.CreatorLink, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
+		switch field := ob.Field.(type) {
+		case string:
+			return !slices.Contains(model.CreatorLinkOrderByAllow, field)
+		}
+		return true
+	})
+	if len(params.OrderBys) > model.CreatorLinkOrderBysMax {
+		params.OrderBys = params.OrderBys[:model.CreatorLinkOrderBysMax]
+	}
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.CreatorLinkPaginationMax {
+			pagination.Limit = model.CreatorLinkPaginationMax
+		}
+	}
+
+	return svc.database.ListCreatorLink(ctx, params)
+}
+
+func (svc Service) CountCreatorLink(ctx context.Context, conds any) (int, error) {
+	return svc.database.CountCreatorLink(ctx, conds)
+}
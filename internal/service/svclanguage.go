@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"slices"
 
 	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
@@ -16,7 +17,9 @@ func (svc Service) AddLanguage(ctx context.Context, data model.AddLanguage, v *m
 		return err
 	}
 
-	return svc.database.AddLanguage(ctx, data, v)
+	return audit(ctx, svc, model.AuditEntityLanguage, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.Language) error {
+		return svc.database.AddLanguage(ctx, data, v)
+	}, v)
 }
 
 func (svc Service) GetLanguageByID(ctx context.Context, id uint) (*model.Language, error) {
@@ -42,10 +45,16 @@ func (svc Service) UpdateLanguageByIETF(ctx context.Context, ietf string, data m
 		return err
 	}
 
-	return svc.database.UpdateLanguage(ctx, data, model.DBConditionalKV{
+	return svc.ifMatch(ctx, svc.database.ExistsLanguage, model.DBConditionalKV{
 		Key:   model.DBLanguageIETF,
 		Value: ietf,
-	}, v)
+	}, func(ctx context.Context, conds any) error {
+		return audit(ctx, svc, model.AuditEntityLanguage, model.AuditOperationUpdate, func(ctx context.Context) (*model.Language, error) {
+			return svc.database.GetLanguage(ctx, conds)
+		}, func(ctx context.Context, v *model.Language) error {
+			return svc.database.UpdateLanguage(ctx, data, conds, v)
+		}, v)
+	})
 }
 
 func (svc Service) DeleteLanguageByIETF(ctx context.Context, ietf string) error {
@@ -53,9 +62,46 @@ func (svc Service) DeleteLanguageByIETF(ctx context.Context, ietf string) error
 		return model.GenericError("missing admin permission to delete language")
 	}
 
-	return svc.database.DeleteLanguage(ctx, model.DBConditionalKV{
+	return svc.ifMatch(ctx, svc.database.ExistsLanguage, model.DBConditionalKV{
 		Key:   model.DBLanguageIETF,
 		Value: ietf,
+	}, func(ctx context.Context, conds any) error {
+		return audit(ctx, svc, model.AuditEntityLanguage, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.Language) error {
+			return svc.database.DeleteLanguage(ctx, conds, v)
+		}, nil)
+	})
+}
+
+// PreviewDeleteLanguageByIETF counts the rows that go to the trash along with
+// the language once it is deleted and those that go for good once it is purged,
+// without deleting anything.
+func (svc Service) PreviewDeleteLanguageByIETF(ctx context.Context, ietf string) (*model.DeleteImpact, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return nil, model.GenericError("missing admin permission to delete language")
+	}
+
+	conds := model.DBConditionalKV{Key: model.DBLanguageIETF, Value: ietf}
+	exists, err := svc.database.ExistsLanguage(ctx, conds)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, model.NotFoundError(errors.New("language " + ietf + " does not exist"))
+	}
+
+	return svc.database.DeleteImpact(ctx, model.DBLanguage, conds)
+}
+
+func (svc Service) RestoreLanguageByIETF(ctx context.Context, ietf string) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to restore language")
+	}
+
+	return audit(ctx, svc, model.AuditEntityLanguage, model.AuditOperationRestore, nil, func(ctx context.Context, v *model.Language) error {
+		return svc.database.RestoreLanguage(ctx, model.DBConditionalKV{
+			Key:   model.DBLanguageIETF,
+			Value: ietf,
+		}, v)
 	}, nil)
 }
 
@@ -2,9 +2,13 @@ package service
 
 import (
 	"context"
+	"errors"
 	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+	"github.com/mahmudindes/orenocomic-bagicore/internal/utila"
 )
 
 func (svc Service) AddLink(ctx context.Context, data model.AddLink, v *model.Link) error {
@@ -12,6 +16,10 @@ func (svc Service) AddLink(ctx context.Context, data model.AddLink, v *model.Lin
 		return model.GenericError("missing admin permission to add link")
 	}
 
+	if err := svc.linkURL(ctx, &data); err != nil {
+		return err
+	}
+
 	if err := data.Validate(); err != nil {
 		return err
 	}
@@ -20,7 +28,137 @@ func (svc Service) AddLink(ctx context.Context, data model.AddLink, v *model.Lin
 		v.TLLanguages = []*model.Language{}
 	}
 
-	return svc.database.AddLink(ctx, data, v)
+	return audit(ctx, svc, model.AuditEntityLink, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.Link) error {
+		return svc.database.AddLink(ctx, data, v)
+	}, v)
+}
+
+// UpsertLink adds the link or updates the existing one, it reports whether
+// the link was created.
+func (svc Service) UpsertLink(ctx context.Context, data model.AddLink, v *model.Link) (bool, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return false, model.GenericError("missing admin permission to upsert link")
+	}
+
+	if err := svc.linkURL(ctx, &data); err != nil {
+		return false, err
+	}
+
+	if err := data.Validate(); err != nil {
+		return false, err
+	}
+
+	result := v
+	if result == nil {
+		result = new(model.Link)
+	}
+	created := false
+	if err := audit(ctx, svc, model.AuditEntityLink, model.AuditOperationUpdate, func(ctx context.Context) (*model.Link, error) {
+		before, err := svc.database.GetLink(ctx, model.DBConditionalKV{
+			Key: model.DBGenericID,
+			Value: model.DBLinkSIDToID(model.LinkSID{
+				WebsiteID:     data.WebsiteID,
+				WebsiteDomain: data.WebsiteDomain,
+				RelativeURL:   data.RelativeURL,
+			}),
+		})
+		created = errors.As(err, &model.ErrNotFound)
+		return before, err
+	}, func(ctx context.Context, v *model.Link) error {
+		return svc.database.UpsertLink(ctx, data, v)
+	}, result); err != nil {
+		return false, err
+	}
+
+	if v != nil {
+		if err := svc.database.EmbedLinkTLLanguages(ctx, []*model.Link{v}, 0); err != nil {
+			return false, err
+		}
+	}
+
+	return created, nil
+}
+
+// ResolveLinkURL gets the link of the full url, a link that does not exist yet
+// is not found and is left to AddLink with the url to add.
+func (svc Service) ResolveLinkURL(ctx context.Context, rawURL string) (*model.Link, error) {
+	domain, relativeURL, err := svc.resolveURL(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.GetLinkBySID(ctx, model.LinkSID{
+		WebsiteDomain: &domain,
+		RelativeURL:   relativeURL,
+	})
+}
+
+// linkURL splits the url of data if any into its website and relative url.
+func (svc Service) linkURL(ctx context.Context, data *model.AddLink) error {
+	if data.URL == nil {
+		return nil
+	}
+
+	if data.WebsiteID != nil || data.WebsiteDomain != nil || data.RelativeURL != "" {
+		return model.GenericError("url cannot be used along with website or relative url")
+	}
+
+	domain, relativeURL, err := svc.resolveURL(ctx, *data.URL)
+	if err != nil {
+		return err
+	}
+	data.WebsiteDomain, data.RelativeURL, data.URL = &domain, relativeURL, nil
+	return nil
+}
+
+// resolveURL normalizes the full url rawURL into the domain of its website and
+// its relative url, the website is found by its domain or a former one.
+func (svc Service) resolveURL(ctx context.Context, rawURL string) (string, string, error) {
+	host, relativeURL, err := utila.NormalizeURL(rawURL)
+	if err != nil {
+		return "", "", model.GenericError("url " + err.Error())
+	}
+
+	if len(relativeURL) > model.LinkRelativeURLMax {
+		max := strconv.FormatInt(model.LinkRelativeURLMax, 10)
+		return "", "", model.GenericError("relative url must be at most " + max + " characters long")
+	}
+
+	// The www. of the host is left out only when the website has none.
+	hosts := []string{host}
+	if host0, ok := strings.CutPrefix(host, "www."); ok {
+		hosts = append(hosts, host0)
+	}
+	for _, host := range hosts {
+		if host0 := utila.UnicodeDomain(host); host0 != host {
+			hosts = append(hosts, host0)
+		}
+	}
+
+	for _, host := range hosts {
+		exists, err := svc.database.ExistsWebsite(ctx, model.DBConditionalKV{
+			Key:   model.DBWebsiteDomain,
+			Value: host,
+		})
+		if err != nil {
+			return "", "", err
+		}
+		if exists {
+			return host, relativeURL, nil
+		}
+	}
+
+	for _, host := range hosts {
+		domain, err := svc.ResolveWebsiteDomainAlias(ctx, host)
+		if err != nil {
+			return "", "", err
+		}
+		if domain != "" {
+			return domain, relativeURL, nil
+		}
+	}
+
+	return "", "", model.GenericError("website " + host + " does not exist")
 }
 
 func (svc Service) GetLinkBySID(ctx context.Context, sid model.LinkSID) (*model.Link, error) {
@@ -47,14 +185,11 @@ func (svc Service) GetLinkBySID(ctx context.Context, sid model.LinkSID) (*model.
 		return nil, err
 	}
 	if len(tlLanguages0) > 0 {
-		conditions := make([]any, len(tlLanguages0)+2)
-		conditions = append(conditions, model.DBLogicalOR{})
+		languageIDs := make([]uint, 0, len(tlLanguages0))
 		for _, language := range tlLanguages0 {
-			conditions = append(conditions, model.DBConditionalKV{
-				Key:   model.DBGenericID,
-				Value: language.LanguageID,
-			})
+			languageIDs = append(languageIDs, language.LanguageID)
 		}
+		conditions := model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: languageIDs}}
 		tlLanguages1, err := svc.database.ListLanguage(ctx, model.ListParams{
 			Conditions: conditions,
 			Pagination: &model.Pagination{},
@@ -84,10 +219,16 @@ func (svc Service) UpdateLinkBySID(ctx context.Context, sid model.LinkSID, data
 	case sid.WebsiteDomain != nil:
 		websiteID = model.DBWebsiteDomainToID(*sid.WebsiteDomain)
 	}
-	if err := svc.database.UpdateLink(ctx, data, map[string]any{
+	if err := svc.ifMatch(ctx, svc.database.ExistsLink, map[string]any{
 		model.DBWebsiteGenericWebsiteID: websiteID,
 		model.DBLinkRelativeURL:         sid.RelativeURL,
-	}, v); err != nil {
+	}, func(ctx context.Context, conds any) error {
+		return audit(ctx, svc, model.AuditEntityLink, model.AuditOperationUpdate, func(ctx context.Context) (*model.Link, error) {
+			return svc.database.GetLink(ctx, conds)
+		}, func(ctx context.Context, v *model.Link) error {
+			return svc.database.UpdateLink(ctx, data, conds, v)
+		}, v)
+	}); err != nil {
 		return err
 	}
 
@@ -100,14 +241,11 @@ func (svc Service) UpdateLinkBySID(ctx context.Context, sid model.LinkSID, data
 			return err
 		}
 		if len(tlLanguages0) > 0 {
-			conditions := make([]any, len(tlLanguages0)+2)
-			conditions = append(conditions, model.DBLogicalOR{})
+			languageIDs := make([]uint, 0, len(tlLanguages0))
 			for _, language := range tlLanguages0 {
-				conditions = append(conditions, model.DBConditionalKV{
-					Key:   model.DBGenericID,
-					Value: language.LanguageID,
-				})
+				languageIDs = append(languageIDs, language.LanguageID)
 			}
+			conditions := model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: languageIDs}}
 			tlLanguages1, err := svc.database.ListLanguage(ctx, model.ListParams{
 				Conditions: conditions,
 				Pagination: &model.Pagination{},
@@ -127,6 +265,14 @@ func (svc Service) DeleteLinkBySID(ctx context.Context, sid model.LinkSID) error
 		return model.GenericError("missing admin permission to delete link")
 	}
 
+	return svc.ifMatch(ctx, svc.database.ExistsLink, linkSIDConditions(sid), func(ctx context.Context, conds any) error {
+		return audit(ctx, svc, model.AuditEntityLink, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.Link) error {
+			return svc.database.DeleteLink(ctx, conds, v)
+		}, nil)
+	})
+}
+
+func linkSIDConditions(sid model.LinkSID) map[string]any {
 	var websiteID any
 	switch {
 	case sid.WebsiteID != nil:
@@ -134,9 +280,29 @@ func (svc Service) DeleteLinkBySID(ctx context.Context, sid model.LinkSID) error
 	case sid.WebsiteDomain != nil:
 		websiteID = model.DBWebsiteDomainToID(*sid.WebsiteDomain)
 	}
-	return svc.database.DeleteLink(ctx, map[string]any{
+	return map[string]any{
 		model.DBWebsiteGenericWebsiteID: websiteID,
 		model.DBLinkRelativeURL:         sid.RelativeURL,
+	}
+}
+
+func (svc Service) RestoreLinkBySID(ctx context.Context, sid model.LinkSID) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to restore link")
+	}
+
+	var websiteID any
+	switch {
+	case sid.WebsiteID != nil:
+		websiteID = sid.WebsiteID
+	case sid.WebsiteDomain != nil:
+		websiteID = model.DBWebsiteDomainToID(*sid.WebsiteDomain)
+	}
+	return audit(ctx, svc, model.AuditEntityLink, model.AuditOperationRestore, nil, func(ctx context.Context, v *model.Link) error {
+		return svc.database.RestoreLink(ctx, map[string]any{
+			model.DBWebsiteGenericWebsiteID: websiteID,
+			model.DBLinkRelativeURL:         sid.RelativeURL,
+		}, v)
 	}, nil)
 }
 
@@ -166,54 +332,8 @@ func (svc Service) ListLink(ctx context.Context, params model.ListParams) ([]*mo
 		return nil, err
 	}
 
-	if len(result) > 0 {
-		conds := make([]any, len(result)+1)
-		conds = append(conds, model.DBLogicalOR{})
-		for _, r := range result {
-			conds = append(conds, model.DBConditionalKV{
-				Key:   model.DBLinkGenericLinkID,
-				Value: r.ID,
-			})
-		}
-		tlLanguages0, err := svc.database.ListLinkTLLanguage(ctx, model.ListParams{
-			Conditions: conds,
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return nil, err
-		}
-		tlLanguages := map[uint]*model.Language{}
-		for _, tlLanguage := range tlLanguages0 {
-			tlLanguages[tlLanguage.LanguageID] = nil
-		}
-		conditions := make([]any, len(tlLanguages0)+2)
-		conditions = append(conditions, model.DBLogicalOR{})
-		for id := range tlLanguages {
-			conditions = append(conditions, model.DBConditionalKV{
-				Key:   model.DBGenericID,
-				Value: id,
-			})
-		}
-		tlLanguages1, err := svc.database.ListLanguage(ctx, model.ListParams{
-			Conditions: conditions,
-			Pagination: &model.Pagination{},
-		})
-		if err != nil {
-			return nil, err
-		}
-		for _, tlLanguage := range tlLanguages1 {
-			tlLanguages[tlLanguage.ID] = tlLanguage
-		}
-		for _, r := range result {
-			r.TLLanguages = []*model.Language{}
-		}
-		for _, tlLanguage := range tlLanguages0 {
-			for _, r := range result {
-				if r.ID == tlLanguage.LinkID {
-					r.TLLanguages = append(r.TLLanguages, tlLanguages[tlLanguage.LanguageID])
-				}
-			}
-		}
+	if err := svc.database.EmbedLinkTLLanguages(ctx, result, 0); err != nil {
+		return nil, err
 	}
 
 	return result, nil
@@ -232,7 +352,38 @@ func (svc Service) AddLinkTLLanguage(ctx context.Context, data model.AddLinkTLLa
 		return err
 	}
 
-	return svc.database.AddLinkTLLanguage(ctx, data, v)
+	return audit(ctx, svc, model.AuditEntityLinkTLLanguage, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.LinkTLLanguage) error {
+		return svc.database.AddLinkTLLanguage(ctx, data, v)
+	}, v)
+}
+
+// UpsertLinkTLLanguage adds the link tl language or gets the existing one as
+// it is, it reports whether the link tl language was created.
+func (svc Service) UpsertLinkTLLanguage(ctx context.Context, data model.AddLinkTLLanguage, v *model.LinkTLLanguage) (bool, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return false, model.GenericError("missing admin permission to upsert link tl language")
+	}
+
+	if err := data.Validate(); err != nil {
+		return false, err
+	}
+
+	result := v
+	if result == nil {
+		result = new(model.LinkTLLanguage)
+	}
+	created := false
+	if err := audit(ctx, svc, model.AuditEntityLinkTLLanguage, model.AuditOperationUpdate, func(ctx context.Context) (*model.LinkTLLanguage, error) {
+		before, err := svc.GetLinkTLLanguageBySID(ctx, model.LinkTLLanguageSID{LinkID: data.LinkID, LinkSID: data.LinkSID, LanguageID: data.LanguageID, LanguageIETF: data.LanguageIETF})
+		created = errors.As(err, &model.ErrNotFound)
+		return before, err
+	}, func(ctx context.Context, v *model.LinkTLLanguage) error {
+		return svc.database.UpsertLinkTLLanguage(ctx, data, v)
+	}, result); err != nil {
+		return false, err
+	}
+
+	return created, nil
 }
 
 func (svc Service) GetLinkTLLanguageBySID(ctx context.Context, sid model.LinkTLLanguageSID) (*model.LinkTLLanguage, error) {
@@ -279,9 +430,14 @@ func (svc Service) UpdateLinkTLLanguageBySID(ctx context.Context, sid model.Link
 	case sid.LanguageIETF != nil:
 		languageID = model.DBLanguageIETFToID(*sid.LanguageIETF)
 	}
-	return svc.database.UpdateLinkTLLanguage(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBLinkGenericLinkID:         websiteID,
 		model.DBLanguageGenericLanguageID: languageID,
+	}
+	return audit(ctx, svc, model.AuditEntityLinkTLLanguage, model.AuditOperationUpdate, func(ctx context.Context) (*model.LinkTLLanguage, error) {
+		return svc.database.GetLinkTLLanguage(ctx, conds)
+	}, func(ctx context.Context, v *model.LinkTLLanguage) error {
+		return svc.database.UpdateLinkTLLanguage(ctx, data, conds, v)
 	}, v)
 }
 
@@ -304,9 +460,11 @@ func (svc Service) DeleteLinkTLLanguageBySID(ctx context.Context, sid model.Link
 	case sid.LanguageIETF != nil:
 		languageID = model.DBLanguageIETFToID(*sid.LanguageIETF)
 	}
-	return svc.database.DeleteLinkTLLanguage(ctx, map[string]any{
-		model.DBLinkGenericLinkID:         websiteID,
-		model.DBLanguageGenericLanguageID: languageID,
+	return audit(ctx, svc, model.AuditEntityLinkTLLanguage, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.LinkTLLanguage) error {
+		return svc.database.DeleteLinkTLLanguage(ctx, map[string]any{
+			model.DBLinkGenericLinkID:         websiteID,
+			model.DBLanguageGenericLanguageID: languageID,
+		}, v)
 	}, nil)
 }
 
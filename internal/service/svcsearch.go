@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func (svc Service) Search(ctx context.Context, params model.SearchParams) ([]*model.SearchResult, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.SearchPaginationMax {
+			pagination.Limit = model.SearchPaginationMax
+		}
+	}
+
+	return svc.database.Search(ctx, params.Query, model.ListParams{
+		Conditions: searchConditions(params),
+		Pagination: params.Pagination,
+	})
+}
+
+func (svc Service) CountSearch(ctx context.Context, params model.SearchParams) (int, error) {
+	if err := params.Validate(); err != nil {
+		return -1, err
+	}
+
+	return svc.database.CountSearch(ctx, params.Query, searchConditions(params))
+}
+
+func searchConditions(params model.SearchParams) any {
+	if len(params.Kinds) < 1 {
+		return nil
+	}
+	return model.DBConditionalKV{Key: model.DBSearchKind, Value: model.DBIn{Values: params.Kinds}}
+}
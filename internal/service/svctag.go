@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func (svc Service) AddTag(ctx context.Context, data model.AddTag, v *model.Tag) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to add tag")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	return audit(ctx, svc, model.AuditEntityTag, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.Tag) error {
+		return svc.database.AddTag(ctx, data, v)
+	}, v)
+}
+
+func (svc Service) GetTagBySID(ctx context.Context, sid model.TagSID) (*model.Tag, error) {
+	return svc.database.GetTag(ctx, map[string]any{
+		model.DBTagNamespace: sid.Namespace,
+		model.DBTagSlug:      sid.Slug,
+	})
+}
+
+func (svc Service) UpdateTagBySID(ctx context.Context, sid model.TagSID, data model.SetTag, v *model.Tag) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to update tag")
+	}
+
+	if err := data.Validate(); err != nil {
+		return err
+	}
+
+	conds := map[string]any{
+		model.DBTagNamespace: sid.Namespace,
+		model.DBTagSlug:      sid.Slug,
+	}
+	return audit(ctx, svc, model.AuditEntityTag, model.AuditOperationUpdate, func(ctx context.Context) (*model.Tag, error) {
+		return svc.database.GetTag(ctx, conds)
+	}, func(ctx context.Context, v *model.Tag) error {
+		return svc.database.UpdateTag(ctx, data, conds, v)
+	}, v)
+}
+
+func (svc Service) DeleteTagBySID(ctx context.Context, sid model.TagSID) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to delete tag")
+	}
+
+	return audit(ctx, svc, model.AuditEntityTag, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.Tag) error {
+		return svc.database.DeleteTag(ctx, map[string]any{
+			model.DBTagNamespace: sid.Namespace,
+			model.DBTagSlug:      sid.Slug,
+		}, v)
+	}, nil)
+}
+
+func (svc Service) ListTag(ctx context.Context, params model.ListParams) ([]*model.Tag, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
+		switch field := ob.Field.(type) {
+		case string:
+			return !slices.Contains(model.TagOrderByAllow, field)
+		}
+		return true
+	})
+	if len(params.OrderBys) > model.TagOrderBysMax {
+		params.OrderBys = params.OrderBys[:model.TagOrderBysMax]
+	}
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.TagPaginationMax {
+			pagination.Limit = model.TagPaginationMax
+		}
+	}
+
+	return svc.database.ListTag(ctx, params)
+}
+
+func (svc Service) CountTag(ctx context.Context, conds any) (int, error) {
+	return svc.database.CountTag(ctx, conds)
+}
+
+func (svc Service) ListTagFacet(ctx context.Context, comicConds any, params model.ListParams) ([]*model.TagFacet, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	params.OrderBys = slices.DeleteFunc(params.OrderBys, func(ob model.OrderBy) bool {
+		switch field := ob.Field.(type) {
+		case string:
+			return !slices.Contains(model.TagFacetOrderByAllow, field)
+		}
+		return true
+	})
+	if len(params.OrderBys) > model.TagOrderBysMax {
+		params.OrderBys = params.OrderBys[:model.TagOrderBysMax]
+	}
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.TagFacetPaginationMax {
+			pagination.Limit = model.TagFacetPaginationMax
+		}
+	}
+
+	return svc.database.ListTagFacet(ctx, comicConds, params)
+}
+
+func (svc Service) CountTagFacet(ctx context.Context, comicConds any, conds any) (int, error) {
+	return svc.database.CountTagFacet(ctx, comicConds, conds)
+}
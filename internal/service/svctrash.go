@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
+)
+
+func (svc Service) ListTrash(ctx context.Context, params model.TrashParams) ([]*model.TrashItem, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return nil, model.GenericError("missing admin permission to list trash")
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if pagination := params.Pagination; pagination != nil {
+		if pagination.Limit > model.TrashPaginationMax {
+			pagination.Limit = model.TrashPaginationMax
+		}
+	}
+
+	return svc.database.ListTrash(ctx, model.ListParams{
+		Conditions: trashConditions(params),
+		Pagination: params.Pagination,
+	})
+}
+
+func (svc Service) CountTrash(ctx context.Context, params model.TrashParams) (int, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return -1, model.GenericError("missing admin permission to count trash")
+	}
+
+	if err := params.Validate(); err != nil {
+		return -1, err
+	}
+
+	return svc.database.CountTrash(ctx, trashConditions(params))
+}
+
+func trashConditions(params model.TrashParams) any {
+	if len(params.Kinds) < 1 {
+		return nil
+	}
+	return model.DBConditionalKV{Key: model.DBTrashKind, Value: model.DBIn{Values: params.Kinds}}
+}
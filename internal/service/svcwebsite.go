@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"slices"
 
 	"github.com/mahmudindes/orenocomic-bagicore/internal/model"
@@ -20,7 +21,9 @@ func (svc Service) AddWebsite(ctx context.Context, data model.AddWebsite, v *mod
 		v.TLLanguages = []*model.Language{}
 	}
 
-	return svc.database.AddWebsite(ctx, data, v)
+	return audit(ctx, svc, model.AuditEntityWebsite, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.Website) error {
+		return svc.database.AddWebsite(ctx, data, v)
+	}, v)
 }
 
 func (svc Service) GetWebsiteByDomain(ctx context.Context, domain string) (*model.Website, error) {
@@ -40,14 +43,11 @@ func (svc Service) GetWebsiteByDomain(ctx context.Context, domain string) (*mode
 		return nil, err
 	}
 	if len(tlLanguages0) > 0 {
-		conditions := make([]any, len(tlLanguages0)+2)
-		conditions = append(conditions, model.DBLogicalOR{})
+		languageIDs := make([]uint, 0, len(tlLanguages0))
 		for _, language := range tlLanguages0 {
-			conditions = append(conditions, model.DBConditionalKV{
-				Key:   model.DBGenericID,
-				Value: language.LanguageID,
-			})
+			languageIDs = append(languageIDs, language.LanguageID)
 		}
+		conditions := model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: languageIDs}}
 		tlLanguages1, err := svc.database.ListLanguage(ctx, model.ListParams{
 			Conditions: conditions,
 			Pagination: &model.Pagination{},
@@ -70,10 +70,26 @@ func (svc Service) UpdateWebsiteByDomain(ctx context.Context, domain string, dat
 		return err
 	}
 
-	if err := svc.database.UpdateWebsite(ctx, data, model.DBConditionalKV{
+	if err := svc.ifMatch(ctx, svc.database.ExistsWebsite, model.DBConditionalKV{
 		Key:   model.DBWebsiteDomain,
 		Value: domain,
-	}, v); err != nil {
+	}, func(ctx context.Context, conds any) error {
+		return audit(ctx, svc, model.AuditEntityWebsite, model.AuditOperationUpdate, func(ctx context.Context) (*model.Website, error) {
+			return svc.database.GetWebsite(ctx, conds)
+		}, func(ctx context.Context, v *model.Website) error {
+			if err := svc.database.UpdateWebsite(ctx, data, conds, v); err != nil {
+				return err
+			}
+
+			if v.Domain == domain {
+				return nil
+			}
+			if err := svc.database.AddWebsiteDomainAlias(ctx, domain, v.ID); err != nil {
+				return err
+			}
+			return svc.database.DeleteWebsiteDomainAlias(ctx, v.Domain)
+		}, v)
+	}); err != nil {
 		return err
 	}
 
@@ -86,14 +102,11 @@ func (svc Service) UpdateWebsiteByDomain(ctx context.Context, domain string, dat
 			return err
 		}
 		if len(tlLanguages0) > 0 {
-			conditions := make([]any, len(tlLanguages0)+2)
-			conditions = append(conditions, model.DBLogicalOR{})
+			languageIDs := make([]uint, 0, len(tlLanguages0))
 			for _, language := range tlLanguages0 {
-				conditions = append(conditions, model.DBConditionalKV{
-					Key:   model.DBGenericID,
-					Value: language.LanguageID,
-				})
+				languageIDs = append(languageIDs, language.LanguageID)
 			}
+			conditions := model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: languageIDs}}
 			tlLanguages1, err := svc.database.ListLanguage(ctx, model.ListParams{
 				Conditions: conditions,
 				Pagination: &model.Pagination{},
@@ -113,12 +126,62 @@ func (svc Service) DeleteWebsiteByDomain(ctx context.Context, domain string) err
 		return model.GenericError("missing admin permission to delete website")
 	}
 
-	return svc.database.DeleteWebsite(ctx, model.DBConditionalKV{
+	return svc.ifMatch(ctx, svc.database.ExistsWebsite, model.DBConditionalKV{
 		Key:   model.DBWebsiteDomain,
 		Value: domain,
+	}, func(ctx context.Context, conds any) error {
+		return audit(ctx, svc, model.AuditEntityWebsite, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.Website) error {
+			return svc.database.DeleteWebsite(ctx, conds, v)
+		}, nil)
+	})
+}
+
+// PreviewDeleteWebsiteByDomain counts the rows that go to the trash along with
+// the website once it is deleted and those that go for good once it is purged,
+// without deleting anything.
+func (svc Service) PreviewDeleteWebsiteByDomain(ctx context.Context, domain string) (*model.DeleteImpact, error) {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return nil, model.GenericError("missing admin permission to delete website")
+	}
+
+	conds := model.DBConditionalKV{Key: model.DBWebsiteDomain, Value: domain}
+	exists, err := svc.database.ExistsWebsite(ctx, conds)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, model.NotFoundError(errors.New("website " + domain + " does not exist"))
+	}
+
+	return svc.database.DeleteImpact(ctx, model.DBWebsite, conds)
+}
+
+func (svc Service) RestoreWebsiteByDomain(ctx context.Context, domain string) error {
+	if !svc.oauth.HasPermissionContext(ctx, svc.oauth.TokenPermissionKey("write")) {
+		return model.GenericError("missing admin permission to restore website")
+	}
+
+	return audit(ctx, svc, model.AuditEntityWebsite, model.AuditOperationRestore, nil, func(ctx context.Context, v *model.Website) error {
+		return svc.database.RestoreWebsite(ctx, model.DBConditionalKV{
+			Key:   model.DBWebsiteDomain,
+			Value: domain,
+		}, v)
 	}, nil)
 }
 
+// ResolveWebsiteDomainAlias gives the domain of the website that used to go by domain, it is empty
+// when domain is not a former domain of any website.
+func (svc Service) ResolveWebsiteDomainAlias(ctx context.Context, domain string) (string, error) {
+	result, err := svc.database.ResolveWebsiteDomainAlias(ctx, domain)
+	if err != nil {
+		if errors.As(err, &model.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return result, nil
+}
+
 func (svc Service) ListWebsite(ctx context.Context, params model.ListParams) ([]*model.Website, error) {
 	if err := params.Validate(); err != nil {
 		return nil, err
@@ -146,14 +209,11 @@ func (svc Service) ListWebsite(ctx context.Context, params model.ListParams) ([]
 	}
 
 	if len(result) > 0 {
-		conds := make([]any, len(result)+1)
-		conds = append(conds, model.DBLogicalOR{})
+		ids := make([]uint, 0, len(result))
 		for _, r := range result {
-			conds = append(conds, model.DBConditionalKV{
-				Key:   model.DBWebsiteGenericWebsiteID,
-				Value: r.ID,
-			})
+			ids = append(ids, r.ID)
 		}
+		conds := model.DBConditionalKV{Key: model.DBWebsiteGenericWebsiteID, Value: model.DBIn{Values: ids}}
 		tlLanguages0, err := svc.database.ListWebsiteTLLanguage(ctx, model.ListParams{
 			Conditions: conds,
 			Pagination: &model.Pagination{},
@@ -165,14 +225,11 @@ func (svc Service) ListWebsite(ctx context.Context, params model.ListParams) ([]
 		for _, tlLanguage := range tlLanguages0 {
 			tlLanguages[tlLanguage.LanguageID] = nil
 		}
-		conditions := make([]any, len(tlLanguages0)+2)
-		conditions = append(conditions, model.DBLogicalOR{})
+		languageIDs := make([]uint, 0, len(tlLanguages0))
 		for id := range tlLanguages {
-			conditions = append(conditions, model.DBConditionalKV{
-				Key:   model.DBGenericID,
-				Value: id,
-			})
+			languageIDs = append(languageIDs, id)
 		}
+		conditions := model.DBConditionalKV{Key: model.DBGenericID, Value: model.DBIn{Values: languageIDs}}
 		tlLanguages1, err := svc.database.ListLanguage(ctx, model.ListParams{
 			Conditions: conditions,
 			Pagination: &model.Pagination{},
@@ -211,7 +268,9 @@ func (svc Service) AddWebsiteTLLanguage(ctx context.Context, data model.AddWebsi
 		return err
 	}
 
-	return svc.database.AddWebsiteTLLanguage(ctx, data, v)
+	return audit(ctx, svc, model.AuditEntityWebsiteTLLanguage, model.AuditOperationAdd, nil, func(ctx context.Context, v *model.WebsiteTLLanguage) error {
+		return svc.database.AddWebsiteTLLanguage(ctx, data, v)
+	}, v)
 }
 
 func (svc Service) GetWebsiteTLLanguageBySID(ctx context.Context, sid model.WebsiteTLLanguageSID) (*model.WebsiteTLLanguage, error) {
@@ -258,9 +317,14 @@ func (svc Service) UpdateWebsiteTLLanguageBySID(ctx context.Context, sid model.W
 	case sid.LanguageIETF != nil:
 		languageID = model.DBLanguageIETFToID(*sid.LanguageIETF)
 	}
-	return svc.database.UpdateWebsiteTLLanguage(ctx, data, map[string]any{
+	conds := map[string]any{
 		model.DBWebsiteGenericWebsiteID:   websiteID,
 		model.DBLanguageGenericLanguageID: languageID,
+	}
+	return audit(ctx, svc, model.AuditEntityWebsiteTLLanguage, model.AuditOperationUpdate, func(ctx context.Context) (*model.WebsiteTLLanguage, error) {
+		return svc.database.GetWebsiteTLLanguage(ctx, conds)
+	}, func(ctx context.Context, v *model.WebsiteTLLanguage) error {
+		return svc.database.UpdateWebsiteTLLanguage(ctx, data, conds, v)
 	}, v)
 }
 
@@ -283,9 +347,11 @@ func (svc Service) DeleteWebsiteTLLanguageBySID(ctx context.Context, sid model.W
 	case sid.LanguageIETF != nil:
 		languageID = model.DBLanguageIETFToID(*sid.LanguageIETF)
 	}
-	return svc.database.DeleteWebsiteTLLanguage(ctx, map[string]any{
-		model.DBWebsiteGenericWebsiteID:   websiteID,
-		model.DBLanguageGenericLanguageID: languageID,
+	return audit(ctx, svc, model.AuditEntityWebsiteTLLanguage, model.AuditOperationDelete, nil, func(ctx context.Context, v *model.WebsiteTLLanguage) error {
+		return svc.database.DeleteWebsiteTLLanguage(ctx, map[string]any{
+			model.DBWebsiteGenericWebsiteID:   websiteID,
+			model.DBLanguageGenericLanguageID: languageID,
+		}, v)
 	}, nil)
 }
 
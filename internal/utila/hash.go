@@ -0,0 +1,28 @@
+package utila
+
+import "crypto/sha256"
+
+const (
+	HashStringLengthMax  = sha256.Size
+	HashStringCharsetMax = 256
+)
+
+// HashString derives a string of length characters from charset out of s, the
+// same s always gives the same string. Every character takes a byte of the
+// digest, so length is at most HashStringLengthMax and charset at most
+// HashStringCharsetMax characters long, it panics otherwise.
+func HashString(charset string, length int, s string) string {
+	if length > HashStringLengthMax {
+		panic("utila: hash string length exceeds the digest")
+	}
+	if len(charset) < 1 || len(charset) > HashStringCharsetMax {
+		panic("utila: hash string charset does not fit a byte")
+	}
+
+	sum := sha256.Sum256([]byte(s))
+	buf := make([]byte, length)
+	for i := range buf {
+		buf[i] = charset[int(sum[i])%len(charset)]
+	}
+	return string(buf)
+}
@@ -0,0 +1,51 @@
+package utila
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHashString(t *testing.T) {
+	const charset = "23456789abcdefghjkmnpqrstuvwxyz"
+
+	got := HashString(charset, 8, "One Piece")
+	if len(got) != 8 {
+		t.Fatalf("HashString length = %d, want 8", len(got))
+	}
+	for _, c := range got {
+		if !strings.ContainsRune(charset, c) {
+			t.Fatalf("HashString(%q) has %q out of the charset", got, c)
+		}
+	}
+	if again := HashString(charset, 8, "One Piece"); again != got {
+		t.Errorf("HashString gave %q then %q for the same string", got, again)
+	}
+	if other := HashString(charset, 8, "One Piece#1"); other == got {
+		t.Errorf("HashString gave %q for another string", other)
+	}
+	if full := HashString(charset, HashStringLengthMax, "One Piece"); len(full) != HashStringLengthMax {
+		t.Errorf("HashString length = %d, want %d", len(full), HashStringLengthMax)
+	}
+}
+
+func TestHashStringPanic(t *testing.T) {
+	tests := []struct {
+		name    string
+		charset string
+		length  int
+	}{
+		{"length over the digest", "ab", HashStringLengthMax + 1},
+		{"charset over a byte", strings.Repeat("a", HashStringCharsetMax+1), 8},
+		{"empty charset", "", 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("HashString did not panic")
+				}
+			}()
+			HashString(tt.charset, tt.length, "One Piece")
+		})
+	}
+}
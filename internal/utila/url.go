@@ -0,0 +1,91 @@
+package utila
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// URLTrackingParams are the query params that only tell where a visit came
+// from, along with every param prefixed by utm_.
+var URLTrackingParams = []string{
+	"fbclid",
+	"gclid",
+	"dclid",
+	"msclkid",
+	"yclid",
+	"igshid",
+	"mc_cid",
+	"mc_eid",
+	"ref_src",
+	"_ga",
+}
+
+// urlSchemeRegexp matches a scheme not followed by //, such as that of mailto:
+// or javascript:, but not a host followed by its port.
+var urlSchemeRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]*:(?:[^0-9]|$)`)
+
+// NormalizeURL splits the http or https url s into its host and the relative
+// url of it. The host is lowercased in punycode without port, the trailing
+// slash of the path, the tracking query params and the fragment are left out
+// and the other query params are sorted. The scheme may be missing, any other
+// than http or https is refused.
+func NormalizeURL(s string) (string, string, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "://") {
+		if urlSchemeRegexp.MatchString(s) {
+			return "", "", errors.New("scheme must be http or https")
+		}
+		s = "https://" + s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", "", errors.New("is not valid")
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+	default:
+		return "", "", errors.New("scheme must be http or https")
+	}
+
+	host, err := idna.Lookup.ToASCII(strings.TrimSuffix(u.Hostname(), "."))
+	if err != nil || host == "" {
+		return "", "", errors.New("host is not valid")
+	}
+	host = strings.ToLower(host)
+
+	relativeURL := strings.TrimRight(u.EscapedPath(), "/")
+	if relativeURL == "" {
+		relativeURL = "/"
+	}
+
+	query := u.Query()
+	for key := range query {
+		if strings.HasPrefix(strings.ToLower(key), "utm_") {
+			query.Del(key)
+		}
+	}
+	for _, key := range URLTrackingParams {
+		query.Del(key)
+	}
+	if len(query) > 0 {
+		relativeURL += "?" + query.Encode()
+	}
+
+	return host, relativeURL, nil
+}
+
+// UnicodeDomain gives the unicode form of the punycode domain s, or s itself
+// if it has none.
+func UnicodeDomain(s string) string {
+	result, err := idna.Lookup.ToUnicode(s)
+	if err != nil {
+		return s
+	}
+	return result
+}
@@ -0,0 +1,40 @@
+package utila
+
+import "testing"
+
+func TestNormalizeURL(t *testing.T) {
+	tests := []struct {
+		url         string
+		host        string
+		relativeURL string
+		err         bool
+	}{
+		{url: "https://example.com/comic/1", host: "example.com", relativeURL: "/comic/1"},
+		{url: "example.com/comic/1/", host: "example.com", relativeURL: "/comic/1"},
+		{url: "  HTTP://Example.COM.:8080  ", host: "example.com", relativeURL: "/"},
+		{url: "example.com:8080/comic", host: "example.com", relativeURL: "/comic"},
+		{url: "https://www.example.com/comic", host: "www.example.com", relativeURL: "/comic"},
+		{url: "https://bücher.example/a", host: "xn--bcher-kva.example", relativeURL: "/a"},
+		{
+			url:         "https://example.com/comic?b=2&utm_source=x&a=1&fbclid=y#top",
+			host:        "example.com",
+			relativeURL: "/comic?a=1&b=2",
+		},
+		{url: "mailto:foo@bar.com", err: true},
+		{url: "javascript:alert(1)", err: true},
+		{url: "ftp://example.com/file", err: true},
+		{url: "https://", err: true},
+		{url: "", err: true},
+	}
+	for _, tt := range tests {
+		host, relativeURL, err := NormalizeURL(tt.url)
+		switch {
+		case tt.err && err == nil:
+			t.Errorf("NormalizeURL(%q) = %q, %q, want an error", tt.url, host, relativeURL)
+		case !tt.err && err != nil:
+			t.Errorf("NormalizeURL(%q) error = %v", tt.url, err)
+		case host != tt.host || relativeURL != tt.relativeURL:
+			t.Errorf("NormalizeURL(%q) = %q, %q, want %q, %q", tt.url, host, relativeURL, tt.host, tt.relativeURL)
+		}
+	}
+}
@@ -9,4 +9,5 @@ import (
 var (
 	Validate    = validator.New(validator.WithRequiredStructEnabled())
 	ValidDomain = regexp.MustCompile(`^(?:[0-9\p{L}](?:[0-9\p{L}-]{0,61}[0-9\p{L}])?\.)+[0-9\p{L}][0-9\p{L}-]{0,61}[0-9\p{L}]$`).MatchString
+	ValidSlug   = regexp.MustCompile(`^[0-9a-z]+(?:-[0-9a-z]+)*$`).MatchString
 )